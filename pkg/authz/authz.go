@@ -0,0 +1,125 @@
+// Package authz enforces fine-grained permissions inside use cases.
+//
+// Permissions use the auth-service format service:resource:action. The caller
+// is read from the request context, where transport middleware stores the
+// claims of the validated access token.
+package authz
+
+import (
+	"context"
+	"strings"
+
+	pkgErrors "github.com/giia/giia-core-engine/pkg/errors"
+)
+
+// Principal is the authenticated caller taken from access token claims.
+// Permissions is optional; when empty every check is delegated to the
+// PermissionChecker. API key callers set APIKeyID and are limited to the
+// scopes in Permissions. Impersonation tokens set ImpersonatorID to the admin
+// acting as UserID; ReadOnly ones must not reach mutating operations.
+type Principal struct {
+	UserID          string
+	OrganizationID  string
+	Roles           []string
+	Permissions     []string
+	APIKeyID        string
+	ImpersonatorID  string
+	ImpersonationID string
+	ReadOnly        bool
+}
+
+type principalKey struct{}
+
+func WithPrincipal(ctx context.Context, principal *Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, principal)
+}
+
+func PrincipalFromContext(ctx context.Context) (*Principal, bool) {
+	principal, ok := ctx.Value(principalKey{}).(*Principal)
+	return principal, ok && principal != nil
+}
+
+// Authorizer is the provider use cases depend on.
+type Authorizer interface {
+	// Authorize returns nil when the caller holds permission, an UNAUTHORIZED
+	// error when there is no caller and a PERMISSION_DENIED error otherwise.
+	Authorize(ctx context.Context, permission string) error
+}
+
+// PermissionChecker resolves permissions that are not carried in the claims,
+// typically through the auth-service CheckPermission RPC. orgID scopes the
+// check to the roles held in that organization; empty means the user's home
+// organization.
+type PermissionChecker interface {
+	CheckPermission(ctx context.Context, userID, orgID, permission string) (bool, error)
+}
+
+type authorizer struct {
+	checker PermissionChecker
+}
+
+// NewAuthorizer returns an Authorizer that trusts permissions in the claims
+// first and falls back to checker. checker may be nil.
+func NewAuthorizer(checker PermissionChecker) Authorizer {
+	return &authorizer{checker: checker}
+}
+
+func (a *authorizer) Authorize(ctx context.Context, permission string) error {
+	principal, ok := PrincipalFromContext(ctx)
+	if !ok || principal.UserID == "" {
+		return pkgErrors.NewUnauthorized("user not authenticated")
+	}
+
+	if Match(principal.Permissions, permission) {
+		return nil
+	}
+
+	if a.checker == nil || principal.APIKeyID != "" {
+		return pkgErrors.NewPermissionDenied(permission)
+	}
+
+	allowed, err := a.checker.CheckPermission(ctx, principal.UserID, principal.OrganizationID, permission)
+	if err != nil {
+		return pkgErrors.Wrap(err, "permission check failed")
+	}
+
+	if !allowed {
+		return pkgErrors.NewPermissionDenied(permission)
+	}
+
+	return nil
+}
+
+// Match reports whether any granted permission covers required. Each segment
+// of a granted permission may be "*".
+func Match(granted []string, required string) bool {
+	requiredParts := strings.Split(required, ":")
+	if len(requiredParts) != 3 {
+		return false
+	}
+
+	for _, perm := range granted {
+		if perm == required {
+			return true
+		}
+
+		parts := strings.Split(perm, ":")
+		if len(parts) != 3 {
+			continue
+		}
+
+		matched := true
+		for i := 0; i < 3; i++ {
+			if parts[i] != "*" && parts[i] != requiredParts[i] {
+				matched = false
+				break
+			}
+		}
+
+		if matched {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,125 @@
+package authz
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	pkgErrors "github.com/giia/giia-core-engine/pkg/errors"
+)
+
+type fakeChecker struct {
+	allowed bool
+	err     error
+	calls   int
+	orgID   string
+}
+
+func (f *fakeChecker) CheckPermission(ctx context.Context, userID, orgID, permission string) (bool, error) {
+	f.calls++
+	f.orgID = orgID
+	return f.allowed, f.err
+}
+
+func TestAuthorize_WithoutPrincipal_ReturnsUnauthorized(t *testing.T) {
+	err := NewAuthorizer(nil).Authorize(context.Background(), PermissionBuffersRecalculate)
+
+	var customErr *pkgErrors.CustomError
+	if !errors.As(err, &customErr) || customErr.ErrorCode != pkgErrors.CodeUnauthorized {
+		t.Errorf("expected UNAUTHORIZED, got %v", err)
+	}
+}
+
+func TestAuthorize_WithPermissionInClaims_SkipsChecker(t *testing.T) {
+	checker := &fakeChecker{}
+	ctx := WithPrincipal(context.Background(), &Principal{
+		UserID:      "user-1",
+		Permissions: []string{"ddmrp:buffers:*"},
+	})
+
+	if err := NewAuthorizer(checker).Authorize(ctx, PermissionBuffersRecalculate); err != nil {
+		t.Errorf("expected permission granted, got %v", err)
+	}
+	if checker.calls != 0 {
+		t.Errorf("expected checker not to be called, got %d calls", checker.calls)
+	}
+}
+
+func TestAuthorize_WithCheckerDenying_ReturnsPermissionDenied(t *testing.T) {
+	ctx := WithPrincipal(context.Background(), &Principal{UserID: "user-1"})
+
+	err := NewAuthorizer(&fakeChecker{allowed: false}).Authorize(ctx, PermissionPurchaseOrderConfirm)
+
+	var customErr *pkgErrors.CustomError
+	if !errors.As(err, &customErr) || customErr.ErrorCode != pkgErrors.CodePermissionDenied {
+		t.Fatalf("expected PERMISSION_DENIED, got %v", err)
+	}
+	if customErr.Details["permission"] != PermissionPurchaseOrderConfirm {
+		t.Errorf("expected permission detail %s, got %v", PermissionPurchaseOrderConfirm, customErr.Details)
+	}
+}
+
+func TestAuthorize_WithChecker_PassesPrincipalOrganization(t *testing.T) {
+	checker := &fakeChecker{allowed: true}
+	ctx := WithPrincipal(context.Background(), &Principal{UserID: "user-1", OrganizationID: "org-1"})
+
+	if err := NewAuthorizer(checker).Authorize(ctx, PermissionPurchaseOrderConfirm); err != nil {
+		t.Fatalf("expected permission granted, got %v", err)
+	}
+	if checker.orgID != "org-1" {
+		t.Errorf("expected check scoped to org-1, got %q", checker.orgID)
+	}
+}
+
+func TestAuthorize_WithAPIKeyOutsideScopes_SkipsChecker(t *testing.T) {
+	checker := &fakeChecker{allowed: true}
+	ctx := WithPrincipal(context.Background(), &Principal{
+		UserID:      "user-1",
+		APIKeyID:    "key-1",
+		Permissions: []string{"analytics:kpis:read"},
+	})
+
+	err := NewAuthorizer(checker).Authorize(ctx, PermissionBuffersRecalculate)
+
+	var customErr *pkgErrors.CustomError
+	if !errors.As(err, &customErr) || customErr.ErrorCode != pkgErrors.CodePermissionDenied {
+		t.Errorf("expected PERMISSION_DENIED, got %v", err)
+	}
+	if checker.calls != 0 {
+		t.Errorf("expected checker not to be called for API keys, got %d calls", checker.calls)
+	}
+}
+
+func TestAuthorize_WithCheckerError_ReturnsError(t *testing.T) {
+	ctx := WithPrincipal(context.Background(), &Principal{UserID: "user-1"})
+
+	err := NewAuthorizer(&fakeChecker{err: errors.New("unavailable")}).Authorize(ctx, PermissionFADCreate)
+
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+}
+
+func TestMatch(t *testing.T) {
+	tests := []struct {
+		name     string
+		granted  []string
+		required string
+		want     bool
+	}{
+		{"exact", []string{"ddmrp:fad:create"}, "ddmrp:fad:create", true},
+		{"global wildcard", []string{"*:*:*"}, "execution:po:confirm", true},
+		{"action wildcard", []string{"execution:po:*"}, "execution:po:confirm", true},
+		{"other resource", []string{"execution:so:*"}, "execution:po:confirm", false},
+		{"malformed required", []string{"*:*:*"}, "po:confirm", false},
+		{"none granted", nil, "ddmrp:fad:create", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Match(tt.granted, tt.required); got != tt.want {
+				t.Errorf("Match(%v, %q) = %v, want %v", tt.granted, tt.required, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,54 @@
+package authz
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	pkgErrors "github.com/giia/giia-core-engine/pkg/errors"
+)
+
+// RequirePermission returns net/http middleware that rejects the request with
+// the standard error body unless the caller holds permission. Authentication
+// middleware must run first and store the Principal in the request context.
+func RequirePermission(authorizer Authorizer, permission string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if err := authorizer.Authorize(r.Context(), permission); err != nil {
+				writeError(w, err)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	response := pkgErrors.ToHTTPResponse(err)
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+	_ = json.NewEncoder(w).Encode(response)
+}
+
+// MethodPermissions maps RPC full method names (/package.Service/Method) to
+// the permission they require. Methods mapped to "" are public. Methods
+// missing from the map are denied, so a new RPC cannot ship without a
+// decision about its permission.
+type MethodPermissions map[string]string
+
+// Authorize checks the permission required by fullMethod. gRPC interceptors
+// call it with info.FullMethod and convert the error to a status.
+func (m MethodPermissions) Authorize(ctx context.Context, authorizer Authorizer, fullMethod string) error {
+	permission, ok := m[fullMethod]
+	if !ok {
+		return pkgErrors.NewForbidden("no permission is configured for method " + fullMethod)
+	}
+
+	if permission == "" {
+		return nil
+	}
+
+	return authorizer.Authorize(ctx, permission)
+}
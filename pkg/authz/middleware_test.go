@@ -0,0 +1,64 @@
+package authz
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	pkgErrors "github.com/giia/giia-core-engine/pkg/errors"
+)
+
+func TestRequirePermission_WithMissingPermission_RespondsForbidden(t *testing.T) {
+	called := false
+	handler := RequirePermission(NewAuthorizer(nil), PermissionPurchaseOrderApprove)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	}))
+
+	req := httptest.NewRequest(http.MethodPost, "/purchase-orders/1/approve", nil)
+	req = req.WithContext(WithPrincipal(req.Context(), &Principal{UserID: "user-1", Permissions: []string{"execution:po:read"}}))
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusForbidden {
+		t.Errorf("expected status 403, got %d", rec.Code)
+	}
+	if called {
+		t.Error("expected next handler not to be called")
+	}
+}
+
+func TestRequirePermission_WithPermissionInClaims_CallsNext(t *testing.T) {
+	called := false
+	handler := RequirePermission(NewAuthorizer(nil), PermissionPurchaseOrderApprove)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	}))
+
+	req := httptest.NewRequest(http.MethodPost, "/purchase-orders/1/approve", nil)
+	req = req.WithContext(WithPrincipal(req.Context(), &Principal{UserID: "user-1", Permissions: []string{"execution:po:*"}}))
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	if !called {
+		t.Errorf("expected next handler to be called, got status %d", rec.Code)
+	}
+}
+
+func TestMethodPermissions_WithUnlistedMethod_Denies(t *testing.T) {
+	methods := MethodPermissions{"/execution.v1.ExecutionService/Health": ""}
+	ctx := WithPrincipal(context.Background(), &Principal{UserID: "user-1", Permissions: []string{"*:*:*"}})
+
+	if err := methods.Authorize(ctx, NewAuthorizer(nil), "/execution.v1.ExecutionService/Health"); err != nil {
+		t.Errorf("expected public method to pass, got %v", err)
+	}
+
+	err := methods.Authorize(ctx, NewAuthorizer(nil), "/execution.v1.ExecutionService/ApprovePurchaseOrder")
+
+	var customErr *pkgErrors.CustomError
+	if !errors.As(err, &customErr) || customErr.HTTPStatus != http.StatusForbidden {
+		t.Errorf("expected forbidden for unlisted method, got %v", err)
+	}
+}
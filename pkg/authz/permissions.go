@@ -0,0 +1,10 @@
+package authz
+
+// Permissions checked by use cases outside auth-service. They must also be
+// seeded in the auth-service permissions table.
+const (
+	PermissionBuffersRecalculate   = "ddmrp:buffers:recalculate"
+	PermissionFADCreate            = "ddmrp:fad:create"
+	PermissionPurchaseOrderConfirm = "execution:po:confirm"
+	PermissionPurchaseOrderApprove = "execution:po:approve"
+)
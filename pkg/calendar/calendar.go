@@ -0,0 +1,185 @@
+// Package calendar provides working-day arithmetic over an organization's
+// calendar: which weekdays are worked, holidays and plant shutdowns. Lead
+// times, ADU windows, promise dates and past-due checks count working days
+// instead of calendar days.
+package calendar
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// DateLayout is the layout used for calendar dates in configuration.
+const DateLayout = "2006-01-02"
+
+// maxScanDays bounds searches for the next working day so a calendar that is
+// closed for years cannot loop forever.
+const maxScanDays = 3660
+
+var ErrNoWorkdays = errors.New("calendar: at least one weekday must be a working day")
+
+// Period is an inclusive range of closed dates, e.g. a plant shutdown.
+type Period struct {
+	Start time.Time
+	End   time.Time
+}
+
+// Calendar answers working-day questions. Dates are compared by their
+// year-month-day in the location of the time passed in, so callers should
+// convert to the organization's timezone first.
+type Calendar struct {
+	workdays [7]bool
+	closed   map[date]struct{}
+	periods  []dateRange
+}
+
+type date struct {
+	year  int
+	month time.Month
+	day   int
+}
+
+type dateRange struct {
+	start date
+	end   date
+}
+
+func dateOf(t time.Time) date {
+	y, m, d := t.Date()
+	return date{year: y, month: m, day: d}
+}
+
+func (d date) before(o date) bool {
+	if d.year != o.year {
+		return d.year < o.year
+	}
+	if d.month != o.month {
+		return d.month < o.month
+	}
+	return d.day < o.day
+}
+
+// New builds a calendar. An empty workdays list means Monday to Friday.
+func New(workdays []time.Weekday, holidays []time.Time, shutdowns []Period) (*Calendar, error) {
+	c := &Calendar{closed: make(map[date]struct{}, len(holidays))}
+
+	if len(workdays) == 0 {
+		workdays = []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday}
+	}
+	for _, wd := range workdays {
+		if wd < time.Sunday || wd > time.Saturday {
+			return nil, fmt.Errorf("calendar: invalid weekday %d", wd)
+		}
+		c.workdays[wd] = true
+	}
+
+	for _, h := range holidays {
+		c.closed[dateOf(h)] = struct{}{}
+	}
+
+	for _, p := range shutdowns {
+		r := dateRange{start: dateOf(p.Start), end: dateOf(p.End)}
+		if r.end.before(r.start) {
+			return nil, fmt.Errorf("calendar: shutdown ends (%s) before it starts (%s)",
+				p.End.Format(DateLayout), p.Start.Format(DateLayout))
+		}
+		c.periods = append(c.periods, r)
+	}
+
+	return c, nil
+}
+
+// Default is a Monday to Friday calendar without holidays.
+func Default() *Calendar {
+	c, _ := New(nil, nil, nil)
+	return c
+}
+
+// IsWorkday reports whether t falls on a worked weekday that is neither a
+// holiday nor inside a shutdown.
+func (c *Calendar) IsWorkday(t time.Time) bool {
+	if !c.workdays[t.Weekday()] {
+		return false
+	}
+
+	d := dateOf(t)
+	if _, ok := c.closed[d]; ok {
+		return false
+	}
+	for _, p := range c.periods {
+		if !d.before(p.start) && !p.end.before(d) {
+			return false
+		}
+	}
+	return true
+}
+
+// AddWorkdays moves n working days from t, keeping the time of day. Negative
+// n moves backwards. With n == 0, t is returned unchanged even on a closed
+// day; use NextWorkday to roll forward.
+func (c *Calendar) AddWorkdays(t time.Time, n int) (time.Time, error) {
+	if err := c.validate(); err != nil {
+		return time.Time{}, err
+	}
+
+	step := 1
+	if n < 0 {
+		step, n = -1, -n
+	}
+
+	for scanned := 0; n > 0; scanned++ {
+		if scanned > maxScanDays {
+			return time.Time{}, fmt.Errorf("calendar: no working day within %d days of %s", maxScanDays, t.Format(DateLayout))
+		}
+		t = t.AddDate(0, 0, step)
+		if c.IsWorkday(t) {
+			n--
+		}
+	}
+	return t, nil
+}
+
+// NextWorkday returns t when it is a working day, otherwise the first working
+// day after it.
+func (c *Calendar) NextWorkday(t time.Time) (time.Time, error) {
+	if err := c.validate(); err != nil {
+		return time.Time{}, err
+	}
+
+	for scanned := 0; !c.IsWorkday(t); scanned++ {
+		if scanned > maxScanDays {
+			return time.Time{}, fmt.Errorf("calendar: no working day within %d days of %s", maxScanDays, t.Format(DateLayout))
+		}
+		t = t.AddDate(0, 0, 1)
+	}
+	return t, nil
+}
+
+// WorkdaysBetween counts the working days after from up to and including to.
+// The result is negative when to is before from, so it can express how many
+// working days an order is past due.
+func (c *Calendar) WorkdaysBetween(from, to time.Time) int {
+	sign := 1
+	if dateOf(to).before(dateOf(from)) {
+		from, to, sign = to, from, -1
+	}
+
+	count := 0
+	end := dateOf(to)
+	for t := from.AddDate(0, 0, 1); !end.before(dateOf(t)); t = t.AddDate(0, 0, 1) {
+		if c.IsWorkday(t) {
+			count++
+		}
+	}
+	return sign * count
+}
+
+func (c *Calendar) validate() error {
+	for _, worked := range c.workdays {
+		if worked {
+			return nil
+		}
+	}
+	return ErrNoWorkdays
+}
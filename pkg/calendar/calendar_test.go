@@ -0,0 +1,107 @@
+package calendar
+
+import (
+	"testing"
+	"time"
+)
+
+func day(s string) time.Time {
+	t, err := time.Parse(DateLayout, s)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+func TestCalendar_IsWorkday(t *testing.T) {
+	cal, err := New(nil, []time.Time{day("2026-12-25")}, []Period{{Start: day("2026-08-03"), End: day("2026-08-07")}})
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+
+	tests := []struct {
+		date string
+		want bool
+	}{
+		{"2026-12-24", true},  // Thursday
+		{"2026-12-25", false}, // holiday
+		{"2026-12-26", false}, // Saturday
+		{"2026-08-03", false}, // shutdown start
+		{"2026-08-07", false}, // shutdown end
+		{"2026-08-10", true},  // Monday after shutdown
+	}
+	for _, tt := range tests {
+		if got := cal.IsWorkday(day(tt.date)); got != tt.want {
+			t.Errorf("IsWorkday(%s) = %v, want %v", tt.date, got, tt.want)
+		}
+	}
+}
+
+func TestCalendar_AddWorkdays_SkipsWeekendsAndHolidays(t *testing.T) {
+	cal, _ := New(nil, []time.Time{day("2026-12-25")}, nil)
+
+	got, err := cal.AddWorkdays(day("2026-12-23"), 3) // Wednesday
+	if err != nil {
+		t.Fatalf("AddWorkdays: %v", err)
+	}
+	if want := day("2026-12-29"); !got.Equal(want) {
+		t.Errorf("AddWorkdays = %s, want %s", got.Format(DateLayout), want.Format(DateLayout))
+	}
+
+	got, _ = cal.AddWorkdays(day("2026-12-29"), -3)
+	if want := day("2026-12-23"); !got.Equal(want) {
+		t.Errorf("AddWorkdays(-3) = %s, want %s", got.Format(DateLayout), want.Format(DateLayout))
+	}
+}
+
+func TestCalendar_AddWorkdays_WithSixDayWeek(t *testing.T) {
+	cal, _ := New([]time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday, time.Saturday}, nil, nil)
+
+	got, _ := cal.AddWorkdays(day("2026-03-13"), 1) // Friday
+	if want := day("2026-03-14"); !got.Equal(want) {
+		t.Errorf("AddWorkdays = %s, want %s", got.Format(DateLayout), want.Format(DateLayout))
+	}
+}
+
+func TestCalendar_NextWorkday(t *testing.T) {
+	cal, _ := New(nil, nil, []Period{{Start: day("2026-01-01"), End: day("2026-01-09")}})
+
+	got, err := cal.NextWorkday(day("2026-01-01"))
+	if err != nil {
+		t.Fatalf("NextWorkday: %v", err)
+	}
+	if want := day("2026-01-12"); !got.Equal(want) {
+		t.Errorf("NextWorkday = %s, want %s", got.Format(DateLayout), want.Format(DateLayout))
+	}
+}
+
+func TestCalendar_WorkdaysBetween(t *testing.T) {
+	cal := Default()
+
+	if got := cal.WorkdaysBetween(day("2026-03-06"), day("2026-03-13")); got != 5 {
+		t.Errorf("WorkdaysBetween forward = %d, want 5", got)
+	}
+	if got := cal.WorkdaysBetween(day("2026-03-13"), day("2026-03-06")); got != -5 {
+		t.Errorf("WorkdaysBetween backward = %d, want -5", got)
+	}
+	if got := cal.WorkdaysBetween(day("2026-03-13"), day("2026-03-13")); got != 0 {
+		t.Errorf("WorkdaysBetween same day = %d, want 0", got)
+	}
+}
+
+func TestNew_InvalidInput(t *testing.T) {
+	if _, err := New(nil, nil, []Period{{Start: day("2026-08-07"), End: day("2026-08-03")}}); err == nil {
+		t.Error("expected error for shutdown ending before it starts")
+	}
+	if _, err := New([]time.Weekday{9}, nil, nil); err == nil {
+		t.Error("expected error for invalid weekday")
+	}
+}
+
+func TestCalendar_AddWorkdays_WithClosedCalendar_ReturnsError(t *testing.T) {
+	cal, _ := New([]time.Weekday{time.Monday}, nil, []Period{{Start: day("2026-01-01"), End: day("2040-01-01")}})
+
+	if _, err := cal.AddWorkdays(day("2026-01-01"), 1); err == nil {
+		t.Error("expected error when no working day is reachable")
+	}
+}
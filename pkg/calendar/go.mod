@@ -0,0 +1,3 @@
+module github.com/giia/giia-core-engine/pkg/calendar
+
+go 1.24.0
@@ -3,6 +3,9 @@ package database
 import (
 	"context"
 	"fmt"
+	"net"
+	"strconv"
+	"strings"
 	"time"
 
 	"gorm.io/driver/postgres"
@@ -11,16 +14,27 @@ import (
 )
 
 type Config struct {
-	Host            string
-	Port            int
-	User            string
-	Password        string
-	DatabaseName    string
-	SSLMode         string
+	Host         string
+	Port         int
+	User         string
+	Password     string
+	DatabaseName string
+	SSLMode      string
+	// PoolMode selects the PoolProfiles entry that fills any pool setting
+	// left at zero. Empty means PoolModeSession.
+	PoolMode        string
 	MaxOpenConns    int
 	MaxIdleConns    int
 	ConnMaxLifetime time.Duration
+	ConnMaxIdleTime time.Duration
 	SlowQueryTime   time.Duration
+	// FailoverHosts are tried in order after Host, as "host" or "host:port"
+	// (Port is used when omitted).
+	FailoverHosts []string
+	// TargetSessionAttrs selects which of the hosts is accepted; it defaults
+	// to "read-write" when FailoverHosts is set so a promoted replica is
+	// found after failover. See the libpq target_session_attrs values.
+	TargetSessionAttrs string
 }
 
 type Database interface {
@@ -57,23 +71,7 @@ func (d *GormDatabase) Connect(ctx context.Context, config *Config) (*gorm.DB, e
 			return err
 		}
 
-		if config.MaxOpenConns > 0 {
-			sqlDB.SetMaxOpenConns(config.MaxOpenConns)
-		} else {
-			sqlDB.SetMaxOpenConns(25)
-		}
-
-		if config.MaxIdleConns > 0 {
-			sqlDB.SetMaxIdleConns(config.MaxIdleConns)
-		} else {
-			sqlDB.SetMaxIdleConns(5)
-		}
-
-		if config.ConnMaxLifetime > 0 {
-			sqlDB.SetConnMaxLifetime(config.ConnMaxLifetime)
-		} else {
-			sqlDB.SetConnMaxLifetime(5 * time.Minute)
-		}
+		config.poolProfile().Apply(sqlDB)
 
 		return sqlDB.PingContext(ctx)
 	})
@@ -107,24 +105,76 @@ func (d *GormDatabase) Close(db *gorm.DB) error {
 	return sqlDB.Close()
 }
 
+func (config *Config) poolProfile() PoolProfile {
+	profile := PoolProfileFor(config.PoolMode)
+	if config.MaxOpenConns > 0 {
+		profile.MaxOpenConns = config.MaxOpenConns
+	}
+	if config.MaxIdleConns > 0 {
+		profile.MaxIdleConns = config.MaxIdleConns
+	}
+	if config.ConnMaxLifetime > 0 {
+		profile.ConnMaxLifetime = config.ConnMaxLifetime
+	}
+	if config.ConnMaxIdleTime > 0 {
+		profile.ConnMaxIdleTime = config.ConnMaxIdleTime
+	}
+	return profile
+}
+
 func buildDSN(config *Config) string {
 	sslMode := config.SSLMode
 	if sslMode == "" {
 		sslMode = "disable"
 	}
 
-	return fmt.Sprintf(
-		"host=%s port=%d user=%s password=%s dbname=%s sslmode=%s",
-		config.Host,
-		config.Port,
+	hosts, ports := hostList(config)
+
+	dsn := fmt.Sprintf(
+		"host=%s port=%s user=%s password=%s dbname=%s sslmode=%s",
+		hosts,
+		ports,
 		config.User,
 		config.Password,
 		config.DatabaseName,
 		sslMode,
 	)
+
+	targetSessionAttrs := config.TargetSessionAttrs
+	if targetSessionAttrs == "" && len(config.FailoverHosts) > 0 {
+		targetSessionAttrs = "read-write"
+	}
+	if targetSessionAttrs != "" {
+		dsn += " target_session_attrs=" + targetSessionAttrs
+	}
+
+	return dsn
 }
 
+func hostList(config *Config) (string, string) {
+	hosts := []string{config.Host}
+	ports := []string{strconv.Itoa(config.Port)}
+
+	for _, failover := range config.FailoverHosts {
+		host, port, err := net.SplitHostPort(failover)
+		if err != nil {
+			host, port = failover, strconv.Itoa(config.Port)
+		}
+		hosts = append(hosts, host)
+		ports = append(ports, port)
+	}
+
+	return strings.Join(hosts, ","), strings.Join(ports, ",")
+}
+
+// ConnectWithDSN connects with the session pool profile.
 func ConnectWithDSN(ctx context.Context, dsn string) (*gorm.DB, error) {
+	return ConnectWithDSNAndProfile(ctx, dsn, PoolProfiles[PoolModeSession])
+}
+
+func ConnectWithDSNAndProfile(ctx context.Context, dsn string, profile PoolProfile) (*gorm.DB, error) {
+	profile = profile.withDefaults()
+
 	var db *gorm.DB
 	var err error
 
@@ -144,9 +194,7 @@ func ConnectWithDSN(ctx context.Context, dsn string) (*gorm.DB, error) {
 			return err
 		}
 
-		sqlDB.SetMaxOpenConns(25)
-		sqlDB.SetMaxIdleConns(5)
-		sqlDB.SetConnMaxLifetime(5 * time.Minute)
+		profile.Apply(sqlDB)
 
 		return sqlDB.PingContext(ctx)
 	})
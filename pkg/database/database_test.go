@@ -0,0 +1,38 @@
+package database
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestBuildDSN_WithSingleHost_KeepsFormat(t *testing.T) {
+	dsn := buildDSN(&Config{Host: "db", Port: 5432, User: "u", Password: "p", DatabaseName: "giia"})
+
+	want := "host=db port=5432 user=u password=p dbname=giia sslmode=disable"
+	if dsn != want {
+		t.Errorf("expected %q, got %q", want, dsn)
+	}
+}
+
+func TestBuildDSN_WithFailoverHosts_ListsHostsAndTargetsPrimary(t *testing.T) {
+	dsn := buildDSN(&Config{
+		Host:          "db-a",
+		Port:          5432,
+		DatabaseName:  "giia",
+		FailoverHosts: []string{"db-b", "db-c:6432"},
+	})
+
+	for _, part := range []string{"host=db-a,db-b,db-c", "port=5432,5432,6432", "target_session_attrs=read-write"} {
+		if !strings.Contains(dsn, part) {
+			t.Errorf("expected %q in %q", part, dsn)
+		}
+	}
+}
+
+func TestBuildDSN_WithExplicitTargetSessionAttrs_UsesIt(t *testing.T) {
+	dsn := buildDSN(&Config{Host: "db-a", Port: 5432, FailoverHosts: []string{"db-b"}, TargetSessionAttrs: "prefer-standby"})
+
+	if !strings.HasSuffix(dsn, "target_session_attrs=prefer-standby") {
+		t.Errorf("expected prefer-standby, got %q", dsn)
+	}
+}
@@ -0,0 +1,20 @@
+package database
+
+import (
+	"context"
+	"fmt"
+
+	"gorm.io/gorm"
+)
+
+// IsPrimary reports whether db is connected to a writable primary. Instances
+// in a passive region, whose database is a replica, get false and should not
+// run schedulers or other writers.
+func IsPrimary(ctx context.Context, db *gorm.DB) (bool, error) {
+	var inRecovery bool
+	if err := db.WithContext(ctx).Raw("SELECT pg_is_in_recovery()").Scan(&inRecovery).Error; err != nil {
+		return false, fmt.Errorf("failed to check database role: %w", err)
+	}
+
+	return !inRecovery, nil
+}
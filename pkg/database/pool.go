@@ -0,0 +1,112 @@
+package database
+
+import (
+	"database/sql"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// PoolMode says what sits between the service and Postgres. With a
+// transaction-mode pooler (PgBouncer, Supabase) client connections are cheap,
+// so the pool can be larger than with direct session connections.
+type PoolMode string
+
+const (
+	PoolModeSession     PoolMode = "session"
+	PoolModeTransaction PoolMode = "transaction"
+)
+
+// PoolProfile sizes a connection pool. MaxOpenCeiling above MaxOpenConns
+// lets a PoolTuner grow the pool under contention; zero disables tuning.
+type PoolProfile struct {
+	MaxOpenConns    int
+	MaxIdleConns    int
+	ConnMaxLifetime time.Duration
+	ConnMaxIdleTime time.Duration
+	MaxOpenCeiling  int
+}
+
+// PoolProfiles are the sizing defaults shared by every service.
+var PoolProfiles = map[PoolMode]PoolProfile{
+	PoolModeSession: {
+		MaxOpenConns:    25,
+		MaxIdleConns:    5,
+		ConnMaxLifetime: 5 * time.Minute,
+		ConnMaxIdleTime: 2 * time.Minute,
+	},
+	PoolModeTransaction: {
+		MaxOpenConns:    50,
+		MaxIdleConns:    10,
+		ConnMaxLifetime: 5 * time.Minute,
+		ConnMaxIdleTime: 2 * time.Minute,
+		MaxOpenCeiling:  100,
+	},
+}
+
+// PoolProfileFor returns the profile for mode; unknown or empty modes use
+// the session profile.
+func PoolProfileFor(mode string) PoolProfile {
+	if profile, ok := PoolProfiles[PoolMode(strings.ToLower(strings.TrimSpace(mode)))]; ok {
+		return profile
+	}
+	return PoolProfiles[PoolModeSession]
+}
+
+// PoolProfileFromEnv starts from the profile named by <prefix>_POOL_MODE and
+// applies any of these overrides:
+//
+//	<prefix>_MAX_OPEN_CONNS, <prefix>_MAX_IDLE_CONNS, <prefix>_MAX_OPEN_CONNS_CEILING
+//	<prefix>_CONN_MAX_LIFETIME, <prefix>_CONN_MAX_IDLE_TIME (Go durations, e.g. "5m")
+//
+// Invalid values are ignored.
+func PoolProfileFromEnv(prefix string) PoolProfile {
+	profile := PoolProfileFor(os.Getenv(prefix + "_POOL_MODE"))
+
+	envInt(prefix+"_MAX_OPEN_CONNS", &profile.MaxOpenConns)
+	envInt(prefix+"_MAX_IDLE_CONNS", &profile.MaxIdleConns)
+	envInt(prefix+"_MAX_OPEN_CONNS_CEILING", &profile.MaxOpenCeiling)
+	envDuration(prefix+"_CONN_MAX_LIFETIME", &profile.ConnMaxLifetime)
+	envDuration(prefix+"_CONN_MAX_IDLE_TIME", &profile.ConnMaxIdleTime)
+
+	return profile
+}
+
+func envInt(key string, target *int) {
+	if value, err := strconv.Atoi(os.Getenv(key)); err == nil && value >= 0 {
+		*target = value
+	}
+}
+
+func envDuration(key string, target *time.Duration) {
+	if value, err := time.ParseDuration(os.Getenv(key)); err == nil && value >= 0 {
+		*target = value
+	}
+}
+
+// Apply configures db with the profile.
+func (p PoolProfile) Apply(db *sql.DB) {
+	db.SetMaxOpenConns(p.MaxOpenConns)
+	db.SetMaxIdleConns(p.MaxIdleConns)
+	db.SetConnMaxLifetime(p.ConnMaxLifetime)
+	db.SetConnMaxIdleTime(p.ConnMaxIdleTime)
+}
+
+// withDefaults fills zero fields from the session profile.
+func (p PoolProfile) withDefaults() PoolProfile {
+	defaults := PoolProfiles[PoolModeSession]
+	if p.MaxOpenConns <= 0 {
+		p.MaxOpenConns = defaults.MaxOpenConns
+	}
+	if p.MaxIdleConns <= 0 {
+		p.MaxIdleConns = defaults.MaxIdleConns
+	}
+	if p.ConnMaxLifetime <= 0 {
+		p.ConnMaxLifetime = defaults.ConnMaxLifetime
+	}
+	if p.ConnMaxIdleTime <= 0 {
+		p.ConnMaxIdleTime = defaults.ConnMaxIdleTime
+	}
+	return p
+}
@@ -0,0 +1,126 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"sync"
+	"time"
+)
+
+// PoolStats is a snapshot of a connection pool for metrics. WaitCount and
+// WaitDuration are cumulative since the pool was opened.
+type PoolStats struct {
+	MaxOpen           int
+	Open              int
+	InUse             int
+	Idle              int
+	WaitCount         int64
+	WaitDuration      time.Duration
+	MaxIdleClosed     int64
+	MaxIdleTimeClosed int64
+	MaxLifetimeClosed int64
+}
+
+func ReadPoolStats(db *sql.DB) PoolStats {
+	s := db.Stats()
+	return PoolStats{
+		MaxOpen:           s.MaxOpenConnections,
+		Open:              s.OpenConnections,
+		InUse:             s.InUse,
+		Idle:              s.Idle,
+		WaitCount:         s.WaitCount,
+		WaitDuration:      s.WaitDuration,
+		MaxIdleClosed:     s.MaxIdleClosed,
+		MaxIdleTimeClosed: s.MaxIdleTimeClosed,
+		MaxLifetimeClosed: s.MaxLifetimeClosed,
+	}
+}
+
+// PoolTuner grows MaxOpenConns while callers keep waiting for a connection,
+// up to the profile's MaxOpenCeiling, and shrinks it back towards
+// MaxOpenConns once the pool has been mostly idle for a few intervals.
+type PoolTuner struct {
+	db       *sql.DB
+	profile  PoolProfile
+	interval time.Duration
+	onResize func(from, to int)
+
+	mu        sync.Mutex
+	maxOpen   int
+	lastWaits int64
+	quiet     int
+}
+
+const (
+	tunerStepDivisor  = 5 // grow/shrink by a fifth of the base size
+	tunerQuietPeriods = 5 // idle intervals before shrinking
+)
+
+// NewPoolTuner returns a tuner for db. onResize, when not nil, is called
+// after every change so services can log it.
+func NewPoolTuner(db *sql.DB, profile PoolProfile, interval time.Duration, onResize func(from, to int)) *PoolTuner {
+	profile = profile.withDefaults()
+	return &PoolTuner{
+		db:       db,
+		profile:  profile,
+		interval: interval,
+		onResize: onResize,
+		maxOpen:  profile.MaxOpenConns,
+	}
+}
+
+// Run samples the pool every interval until ctx is done. It returns
+// immediately when the profile has no ceiling above MaxOpenConns.
+func (t *PoolTuner) Run(ctx context.Context) {
+	if t.profile.MaxOpenCeiling <= t.profile.MaxOpenConns {
+		return
+	}
+
+	ticker := time.NewTicker(t.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if from, to, changed := t.adjust(t.db.Stats()); changed {
+				t.db.SetMaxOpenConns(to)
+				if t.onResize != nil {
+					t.onResize(from, to)
+				}
+			}
+		}
+	}
+}
+
+// adjust decides the new pool size from one sample.
+func (t *PoolTuner) adjust(stats sql.DBStats) (from, to int, changed bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	waits := stats.WaitCount - t.lastWaits
+	t.lastWaits = stats.WaitCount
+	from = t.maxOpen
+
+	step := t.profile.MaxOpenConns / tunerStepDivisor
+	if step < 1 {
+		step = 1
+	}
+
+	switch {
+	case waits > 0 && t.maxOpen < t.profile.MaxOpenCeiling:
+		t.quiet = 0
+		t.maxOpen = min(t.maxOpen+step, t.profile.MaxOpenCeiling)
+	case waits == 0 && stats.InUse*2 <= t.maxOpen && t.maxOpen > t.profile.MaxOpenConns:
+		t.quiet++
+		if t.quiet >= tunerQuietPeriods {
+			t.quiet = 0
+			t.maxOpen = max(t.maxOpen-step, t.profile.MaxOpenConns)
+		}
+	default:
+		t.quiet = 0
+	}
+
+	return from, t.maxOpen, from != t.maxOpen
+}
@@ -0,0 +1,67 @@
+package database
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+)
+
+func TestPoolProfileFor_WithUnknownMode_UsesSessionProfile(t *testing.T) {
+	if got := PoolProfileFor("transaction"); got.MaxOpenConns != 50 || got.MaxIdleConns != 10 {
+		t.Errorf("unexpected transaction profile %+v", got)
+	}
+	if got := PoolProfileFor(""); got != PoolProfiles[PoolModeSession] {
+		t.Errorf("expected session profile for empty mode, got %+v", got)
+	}
+	if got := PoolProfileFor("bogus"); got != PoolProfiles[PoolModeSession] {
+		t.Errorf("expected session profile for unknown mode, got %+v", got)
+	}
+}
+
+func TestPoolProfileFromEnv_AppliesOverrides(t *testing.T) {
+	t.Setenv("TESTDB_POOL_MODE", "Transaction")
+	t.Setenv("TESTDB_MAX_OPEN_CONNS", "80")
+	t.Setenv("TESTDB_CONN_MAX_LIFETIME", "10m")
+	t.Setenv("TESTDB_MAX_IDLE_CONNS", "not-a-number")
+
+	profile := PoolProfileFromEnv("TESTDB")
+
+	if profile.MaxOpenConns != 80 {
+		t.Errorf("expected MaxOpenConns 80, got %d", profile.MaxOpenConns)
+	}
+	if profile.MaxIdleConns != 10 {
+		t.Errorf("expected transaction MaxIdleConns 10, got %d", profile.MaxIdleConns)
+	}
+	if profile.ConnMaxLifetime != 10*time.Minute {
+		t.Errorf("expected ConnMaxLifetime 10m, got %s", profile.ConnMaxLifetime)
+	}
+}
+
+func TestPoolTuner_Adjust_GrowsOnWaitsUpToCeiling(t *testing.T) {
+	tuner := NewPoolTuner(nil, PoolProfile{MaxOpenConns: 10, MaxOpenCeiling: 14}, time.Second, nil)
+
+	if _, to, changed := tuner.adjust(sql.DBStats{WaitCount: 3, InUse: 10}); !changed || to != 12 {
+		t.Fatalf("expected growth to 12, got %d (changed=%v)", to, changed)
+	}
+	if _, to, _ := tuner.adjust(sql.DBStats{WaitCount: 9, InUse: 12}); to != 14 {
+		t.Fatalf("expected growth to 14, got %d", to)
+	}
+	if _, to, changed := tuner.adjust(sql.DBStats{WaitCount: 20, InUse: 14}); changed || to != 14 {
+		t.Fatalf("expected to stay at ceiling 14, got %d (changed=%v)", to, changed)
+	}
+}
+
+func TestPoolTuner_Adjust_ShrinksAfterQuietPeriods(t *testing.T) {
+	tuner := NewPoolTuner(nil, PoolProfile{MaxOpenConns: 10, MaxOpenCeiling: 20}, time.Second, nil)
+	tuner.adjust(sql.DBStats{WaitCount: 1, InUse: 10})
+
+	for i := 1; i < tunerQuietPeriods; i++ {
+		if _, _, changed := tuner.adjust(sql.DBStats{WaitCount: 1, InUse: 2}); changed {
+			t.Fatalf("shrank after %d quiet periods", i)
+		}
+	}
+
+	if _, to, changed := tuner.adjust(sql.DBStats{WaitCount: 1, InUse: 2}); !changed || to != 10 {
+		t.Fatalf("expected shrink back to 10, got %d (changed=%v)", to, changed)
+	}
+}
@@ -0,0 +1,247 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"sync"
+	"time"
+
+	"gorm.io/gorm"
+
+	pkgLogger "github.com/giia/giia-core-engine/pkg/logger"
+)
+
+const (
+	slowQueryStartKey = "giia:slow_query_start"
+
+	defaultExplainInterval = time.Hour
+	defaultExplainTimeout  = 5 * time.Second
+
+	// maxExplainedQueries bounds the queries remembered for ExplainInterval.
+	// Once full, stale entries are pruned and new queries are skipped until
+	// one ages out.
+	maxExplainedQueries = 1000
+)
+
+// SlowQueryConfig configures SlowQueryPlugin.
+type SlowQueryConfig struct {
+	// Threshold above which a query is reported.
+	Threshold time.Duration
+	Logger    pkgLogger.Logger
+	// CaptureExplain stores EXPLAIN (FORMAT JSON) plans of slow SELECTs in the
+	// query_diagnostics table.
+	CaptureExplain bool
+	// ExplainInterval is the minimum time between two captures of the same
+	// query. Defaults to one hour.
+	ExplainInterval time.Duration
+	// ExplainTimeout bounds each EXPLAIN and insert. Defaults to 5 seconds.
+	ExplainTimeout time.Duration
+}
+
+// SlowQuery describes a query that exceeded the threshold. SQL keeps its
+// placeholders; bound parameters are never logged or stored.
+type SlowQuery struct {
+	SQL          string
+	Table        string
+	Duration     time.Duration
+	RowsAffected int64
+	ParamCount   int
+}
+
+// SlowQueryPlugin is a GORM plugin that logs queries slower than a threshold
+// and optionally captures their plans:
+//
+//	db.Use(database.NewSlowQueryPlugin(database.SlowQueryConfig{Threshold: 200 * time.Millisecond, Logger: logger}))
+type SlowQueryPlugin struct {
+	config    SlowQueryConfig
+	sqlDB     *sql.DB
+	now       func() time.Time
+	mu        sync.Mutex
+	explained map[string]time.Time
+	wg        sync.WaitGroup
+}
+
+func NewSlowQueryPlugin(config SlowQueryConfig) *SlowQueryPlugin {
+	if config.ExplainInterval <= 0 {
+		config.ExplainInterval = defaultExplainInterval
+	}
+	if config.ExplainTimeout <= 0 {
+		config.ExplainTimeout = defaultExplainTimeout
+	}
+
+	return &SlowQueryPlugin{
+		config:    config,
+		now:       time.Now,
+		explained: make(map[string]time.Time),
+	}
+}
+
+func (p *SlowQueryPlugin) Name() string {
+	return "giia:slow_query"
+}
+
+func (p *SlowQueryPlugin) Initialize(db *gorm.DB) error {
+	if p.config.CaptureExplain {
+		sqlDB, err := db.DB()
+		if err != nil {
+			return err
+		}
+		p.sqlDB = sqlDB
+	}
+
+	callbacks := db.Callback()
+	if err := callbacks.Create().Before("gorm:create").Register("giia:slow_query_before_create", p.before); err != nil {
+		return err
+	}
+	if err := callbacks.Create().After("gorm:create").Register("giia:slow_query_after_create", p.after); err != nil {
+		return err
+	}
+	if err := callbacks.Query().Before("gorm:query").Register("giia:slow_query_before_query", p.before); err != nil {
+		return err
+	}
+	if err := callbacks.Query().After("gorm:query").Register("giia:slow_query_after_query", p.after); err != nil {
+		return err
+	}
+	if err := callbacks.Update().Before("gorm:update").Register("giia:slow_query_before_update", p.before); err != nil {
+		return err
+	}
+	if err := callbacks.Update().After("gorm:update").Register("giia:slow_query_after_update", p.after); err != nil {
+		return err
+	}
+	if err := callbacks.Delete().Before("gorm:delete").Register("giia:slow_query_before_delete", p.before); err != nil {
+		return err
+	}
+	if err := callbacks.Delete().After("gorm:delete").Register("giia:slow_query_after_delete", p.after); err != nil {
+		return err
+	}
+	if err := callbacks.Row().Before("gorm:row").Register("giia:slow_query_before_row", p.before); err != nil {
+		return err
+	}
+	if err := callbacks.Row().After("gorm:row").Register("giia:slow_query_after_row", p.after); err != nil {
+		return err
+	}
+	if err := callbacks.Raw().Before("gorm:raw").Register("giia:slow_query_before_raw", p.before); err != nil {
+		return err
+	}
+	return callbacks.Raw().After("gorm:raw").Register("giia:slow_query_after_raw", p.after)
+}
+
+func (p *SlowQueryPlugin) before(db *gorm.DB) {
+	db.InstanceSet(slowQueryStartKey, p.now())
+}
+
+func (p *SlowQueryPlugin) after(db *gorm.DB) {
+	value, ok := db.InstanceGet(slowQueryStartKey)
+	if !ok {
+		return
+	}
+	start, ok := value.(time.Time)
+	if !ok {
+		return
+	}
+
+	elapsed := p.now().Sub(start)
+	if p.config.Threshold <= 0 || elapsed < p.config.Threshold {
+		return
+	}
+
+	query := SlowQuery{
+		SQL:          db.Statement.SQL.String(),
+		Table:        db.Statement.Table,
+		Duration:     elapsed,
+		RowsAffected: db.RowsAffected,
+		ParamCount:   len(db.Statement.Vars),
+	}
+
+	ctx := db.Statement.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	if p.config.Logger != nil {
+		p.config.Logger.Warn(ctx, "Slow query", pkgLogger.Tags{
+			"sql":           query.SQL,
+			"table":         query.Table,
+			"duration_ms":   query.Duration.Milliseconds(),
+			"threshold_ms":  p.config.Threshold.Milliseconds(),
+			"rows_affected": query.RowsAffected,
+			"param_count":   query.ParamCount,
+		})
+	}
+
+	if p.shouldExplain(query.SQL) {
+		vars := append([]interface{}(nil), db.Statement.Vars...)
+		p.wg.Add(1)
+		go func() {
+			defer p.wg.Done()
+			p.explain(query, vars)
+		}()
+	}
+}
+
+// shouldExplain limits captures to SELECTs and to one per query per interval.
+func (p *SlowQueryPlugin) shouldExplain(query string) bool {
+	if !p.config.CaptureExplain || p.sqlDB == nil {
+		return false
+	}
+	if !strings.HasPrefix(strings.ToUpper(strings.TrimSpace(query)), "SELECT") {
+		return false
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := p.now()
+	last, ok := p.explained[query]
+	if ok && now.Sub(last) < p.config.ExplainInterval {
+		return false
+	}
+
+	if !ok && len(p.explained) >= maxExplainedQueries {
+		p.pruneExplained(now)
+		if len(p.explained) >= maxExplainedQueries {
+			return false
+		}
+	}
+
+	p.explained[query] = now
+	return true
+}
+
+// pruneExplained drops queries whose interval has elapsed. Callers hold mu.
+func (p *SlowQueryPlugin) pruneExplained(now time.Time) {
+	for query, last := range p.explained {
+		if now.Sub(last) >= p.config.ExplainInterval {
+			delete(p.explained, query)
+		}
+	}
+}
+
+// explain runs on the underlying *sql.DB so it bypasses GORM callbacks and is
+// not itself reported.
+func (p *SlowQueryPlugin) explain(query SlowQuery, vars []interface{}) {
+	ctx, cancel := context.WithTimeout(context.Background(), p.config.ExplainTimeout)
+	defer cancel()
+
+	var plan string
+	err := p.sqlDB.QueryRowContext(ctx, "EXPLAIN (FORMAT JSON) "+query.SQL, vars...).Scan(&plan)
+	if err == nil {
+		_, err = p.sqlDB.ExecContext(ctx,
+			`INSERT INTO query_diagnostics (query, table_name, duration_ms, plan, captured_at) VALUES ($1, $2, $3, $4, $5)`,
+			query.SQL, query.Table, query.Duration.Milliseconds(), plan, p.now().UTC(),
+		)
+	}
+
+	if err != nil && p.config.Logger != nil {
+		p.config.Logger.Error(ctx, err, "Failed to capture slow query plan", pkgLogger.Tags{
+			"sql": query.SQL,
+		})
+	}
+}
+
+// Wait blocks until pending plan captures finish, e.g. before closing the
+// database on shutdown.
+func (p *SlowQueryPlugin) Wait() {
+	p.wg.Wait()
+}
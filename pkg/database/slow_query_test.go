@@ -0,0 +1,122 @@
+package database
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+
+	pkgLogger "github.com/giia/giia-core-engine/pkg/logger"
+)
+
+// noopDriver satisfies database/sql without a server; tests run GORM in
+// DryRun mode so statements are built but never executed.
+type noopDriver struct{}
+
+func (noopDriver) Open(string) (driver.Conn, error) { return nil, errors.New("not connected") }
+
+func init() {
+	sql.Register("giia-noop", noopDriver{})
+}
+
+type testUser struct {
+	ID    int
+	Email string
+}
+
+func newDryRunDB(t *testing.T, plugin *SlowQueryPlugin) *gorm.DB {
+	sqlDB, err := sql.Open("giia-noop", "")
+	require.NoError(t, err)
+
+	db, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{
+		DryRun:               true,
+		DisableAutomaticPing: true,
+	})
+	require.NoError(t, err)
+	require.NoError(t, db.Use(plugin))
+	return db
+}
+
+// steppingClock advances by step on every call.
+func steppingClock(step time.Duration) func() time.Time {
+	current := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	return func() time.Time {
+		current = current.Add(step)
+		return current
+	}
+}
+
+func TestSlowQueryPlugin_WithQueryOverThreshold_LogsWithoutParameters(t *testing.T) {
+	mockLogger := new(pkgLogger.LoggerMock)
+	plugin := NewSlowQueryPlugin(SlowQueryConfig{Threshold: 200 * time.Millisecond, Logger: mockLogger})
+	plugin.now = steppingClock(300 * time.Millisecond)
+	db := newDryRunDB(t, plugin)
+
+	mockLogger.On("Warn", mock.Anything, "Slow query", mock.MatchedBy(func(tags pkgLogger.Tags) bool {
+		return tags["sql"] == `SELECT * FROM "test_users" WHERE email = $1` &&
+			tags["param_count"] == 1 &&
+			tags["duration_ms"] == int64(300)
+	})).Return().Once()
+
+	var users []testUser
+	db.Where("email = ?", "jane@example.com").Find(&users)
+
+	mockLogger.AssertExpectations(t)
+}
+
+func TestSlowQueryPlugin_WithQueryUnderThreshold_DoesNotLog(t *testing.T) {
+	mockLogger := new(pkgLogger.LoggerMock)
+	plugin := NewSlowQueryPlugin(SlowQueryConfig{Threshold: time.Second, Logger: mockLogger})
+	plugin.now = steppingClock(300 * time.Millisecond)
+	db := newDryRunDB(t, plugin)
+
+	var users []testUser
+	db.Find(&users)
+
+	mockLogger.AssertNotCalled(t, "Warn", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestSlowQueryPlugin_ShouldExplain(t *testing.T) {
+	sqlDB, err := sql.Open("giia-noop", "")
+	require.NoError(t, err)
+
+	plugin := NewSlowQueryPlugin(SlowQueryConfig{Threshold: time.Millisecond, CaptureExplain: true, ExplainInterval: time.Hour})
+	plugin.sqlDB = sqlDB
+	plugin.now = steppingClock(time.Minute)
+
+	query := `SELECT * FROM "kpi_snapshots" WHERE organization_id = $1`
+
+	assert.True(t, plugin.shouldExplain(query))
+	assert.False(t, plugin.shouldExplain(query), "same query within the interval")
+	assert.False(t, plugin.shouldExplain(`UPDATE "users" SET name = $1`), "only SELECTs are explained")
+
+	plugin.now = steppingClock(2 * time.Hour)
+	assert.True(t, plugin.shouldExplain(query), "interval elapsed")
+}
+
+func TestSlowQueryPlugin_ShouldExplain_WithManyDistinctQueries_StaysBounded(t *testing.T) {
+	sqlDB, err := sql.Open("giia-noop", "")
+	require.NoError(t, err)
+
+	plugin := NewSlowQueryPlugin(SlowQueryConfig{Threshold: time.Millisecond, CaptureExplain: true, ExplainInterval: time.Hour})
+	plugin.sqlDB = sqlDB
+	plugin.now = steppingClock(time.Millisecond)
+
+	for i := 0; i < maxExplainedQueries; i++ {
+		assert.True(t, plugin.shouldExplain(fmt.Sprintf("SELECT %d", i)))
+	}
+	assert.False(t, plugin.shouldExplain("SELECT 'overflow'"), "full map skips new queries")
+	assert.Len(t, plugin.explained, maxExplainedQueries)
+
+	plugin.now = steppingClock(2 * time.Hour)
+	assert.True(t, plugin.shouldExplain("SELECT 'overflow'"), "stale entries are pruned")
+	assert.Len(t, plugin.explained, 1)
+}
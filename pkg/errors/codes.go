@@ -4,10 +4,11 @@ const (
 	CodeBadRequest          = "BAD_REQUEST"
 	CodeUnauthorized        = "UNAUTHORIZED"
 	CodeForbidden           = "FORBIDDEN"
+	CodePermissionDenied    = "PERMISSION_DENIED"
 	CodeNotFound            = "NOT_FOUND"
 	CodeInternalServerError = "INTERNAL_SERVER_ERROR"
 	CodeServiceUnavailable  = "SERVICE_UNAVAILABLE"
 	CodeConflict            = "CONFLICT"
 	CodeUnprocessableEntity = "UNPROCESSABLE_ENTITY"
 	CodeTooManyRequests     = "TOO_MANY_REQUESTS"
-)
\ No newline at end of file
+)
@@ -10,6 +10,7 @@ type CustomError struct {
 	Message    string
 	HTTPStatus int
 	Cause      error
+	Details    map[string]string
 }
 
 func (e *CustomError) Error() string {
@@ -47,6 +48,17 @@ func NewForbidden(message string) *CustomError {
 	}
 }
 
+// NewPermissionDenied reports a missing permission. The permission code is
+// exposed in Details so clients can tell which grant is required.
+func NewPermissionDenied(permission string) *CustomError {
+	return &CustomError{
+		ErrorCode:  CodePermissionDenied,
+		Message:    "permission denied: " + permission,
+		HTTPStatus: http.StatusForbidden,
+		Details:    map[string]string{"permission": permission},
+	}
+}
+
 func NewNotFound(message string) *CustomError {
 	return &CustomError{
 		ErrorCode:  "NOT_FOUND",
@@ -115,4 +127,4 @@ func Wrap(err error, message string) *CustomError {
 		HTTPStatus: http.StatusInternalServerError,
 		Cause:      err,
 	}
-}
\ No newline at end of file
+}
@@ -42,6 +42,22 @@ func TestNewForbidden(t *testing.T) {
 	}
 }
 
+func TestNewPermissionDenied(t *testing.T) {
+	err := NewPermissionDenied("ddmrp:buffers:recalculate")
+
+	if err.ErrorCode != CodePermissionDenied {
+		t.Errorf("expected error code %s, got %s", CodePermissionDenied, err.ErrorCode)
+	}
+	if err.HTTPStatus != http.StatusForbidden {
+		t.Errorf("expected HTTP status %d, got %d", http.StatusForbidden, err.HTTPStatus)
+	}
+
+	response := ToHTTPResponse(err)
+	if response.Details["permission"] != "ddmrp:buffers:recalculate" {
+		t.Errorf("expected permission detail, got %v", response.Details)
+	}
+}
+
 func TestNewNotFound(t *testing.T) {
 	err := NewNotFound("resource not found")
 
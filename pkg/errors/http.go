@@ -1,9 +1,10 @@
 package errors
 
 type ErrorResponse struct {
-	StatusCode int    `json:"status_code"`
-	ErrorCode  string `json:"error_code"`
-	Message    string `json:"message"`
+	StatusCode int               `json:"status_code"`
+	ErrorCode  string            `json:"error_code"`
+	Message    string            `json:"message"`
+	Details    map[string]string `json:"details,omitempty"`
 }
 
 func ToHTTPResponse(err error) *ErrorResponse {
@@ -12,6 +13,7 @@ func ToHTTPResponse(err error) *ErrorResponse {
 			StatusCode: customErr.HTTPStatus,
 			ErrorCode:  customErr.ErrorCode,
 			Message:    customErr.Message,
+			Details:    customErr.Details,
 		}
 	}
 
@@ -20,4 +22,4 @@ func ToHTTPResponse(err error) *ErrorResponse {
 		ErrorCode:  CodeInternalServerError,
 		Message:    err.Error(),
 	}
-}
\ No newline at end of file
+}
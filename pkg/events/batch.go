@@ -0,0 +1,144 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/nats-io/nats.go"
+)
+
+// BatchHandler processes a batch of events. Returning an error redelivers
+// the whole batch, so handlers must be idempotent per event.
+type BatchHandler func(ctx context.Context, events []*Event) error
+
+type BatchConfig struct {
+	// MaxSize caps the number of events handed to the handler at once.
+	MaxSize int
+	// MaxLatency is how long the first event of a batch may wait for the
+	// batch to fill before it is flushed anyway.
+	MaxLatency time.Duration
+	// AckWait is how long the server waits for the batch ack before
+	// redelivering. It must cover MaxLatency plus the handler time.
+	AckWait time.Duration
+}
+
+func (c BatchConfig) withDefaults() BatchConfig {
+	if c.MaxSize <= 0 {
+		c.MaxSize = 100
+	}
+	if c.MaxLatency <= 0 {
+		c.MaxLatency = time.Second
+	}
+	if c.AckWait <= 0 {
+		c.AckWait = 30 * time.Second
+	}
+	return c
+}
+
+// SubscribeBatch consumes subject through a durable pull consumer and hands
+// the handler up to MaxSize events at a time, flushing partial batches once
+// MaxLatency has passed since their first event arrived. Acks are batch level: every message is acked once the handler
+// succeeds and nacked when it fails. Replicas sharing durableName split the
+// batches between them.
+func (s *NATSSubscriber) SubscribeBatch(ctx context.Context, subject, durableName string, config BatchConfig, handler BatchHandler) error {
+	config = config.withDefaults()
+
+	sub, err := s.js.PullSubscribe(subject, durableName, nats.ManualAck(), nats.AckWait(config.AckWait))
+	if err != nil {
+		return fmt.Errorf("failed to subscribe to subject %s with batch consumer %s: %w", subject, durableName, err)
+	}
+
+	s.subs = append(s.subs, sub)
+	go s.pullBatches(ctx, sub, config, handler)
+
+	return nil
+}
+
+// fetchFunc matches Subscription.Fetch so batch collection can be driven by
+// a scripted source in tests.
+type fetchFunc func(batch int, opts ...nats.PullOpt) ([]*nats.Msg, error)
+
+func (s *NATSSubscriber) pullBatches(ctx context.Context, sub *nats.Subscription, config BatchConfig, handler BatchHandler) {
+	for ctx.Err() == nil && sub.IsValid() {
+		msgs, err := collectBatch(sub.Fetch, config)
+		if len(msgs) > 0 {
+			s.handleBatch(ctx, msgs, handler)
+			continue
+		}
+		if err == nil || errors.Is(err, nats.ErrTimeout) || errors.Is(err, context.DeadlineExceeded) {
+			continue
+		}
+		if !sub.IsValid() {
+			return
+		}
+		// Transient fetch failure, such as a reconnect in progress
+		time.Sleep(config.MaxLatency)
+	}
+}
+
+// collectBatch waits for the first messages, then keeps fetching until
+// MaxSize messages arrived or MaxLatency passed since the first ones did.
+// A single Fetch returns as soon as anything is pending, so without the
+// second loop a trickle of events would be handed over one at a time.
+func collectBatch(fetch fetchFunc, config BatchConfig) ([]*nats.Msg, error) {
+	msgs, err := fetch(config.MaxSize, nats.MaxWait(config.MaxLatency))
+	if err != nil || len(msgs) == 0 {
+		return nil, err
+	}
+
+	deadline := time.Now().Add(config.MaxLatency)
+	for len(msgs) < config.MaxSize {
+		wait := time.Until(deadline)
+		if wait <= 0 {
+			break
+		}
+		more, err := fetch(config.MaxSize-len(msgs), nats.MaxWait(wait))
+		msgs = append(msgs, more...)
+		if err != nil {
+			// Timeouts mean the window closed; anything else is left to the
+			// next fetch after the partial batch is flushed
+			break
+		}
+	}
+
+	return msgs, nil
+}
+
+func (s *NATSSubscriber) handleBatch(ctx context.Context, msgs []*nats.Msg, handler BatchHandler) {
+	events := make([]*Event, 0, len(msgs))
+	acked := make([]*nats.Msg, 0, len(msgs))
+
+	for _, msg := range msgs {
+		data, err := decodePayload(ctx, s.store, msg)
+		if err != nil {
+			msg.Nak()
+			continue
+		}
+
+		event, err := FromJSON(data)
+		if err != nil {
+			msg.Nak()
+			continue
+		}
+
+		events = append(events, event)
+		acked = append(acked, msg)
+	}
+
+	if len(events) == 0 {
+		return
+	}
+
+	if err := handler(ctx, events); err != nil {
+		for _, msg := range acked {
+			msg.Nak()
+		}
+		return
+	}
+
+	for _, msg := range acked {
+		msg.Ack()
+	}
+}
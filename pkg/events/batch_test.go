@@ -0,0 +1,136 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// scriptedFetch answers each Fetch with the next step and records how many
+// messages every call asked for.
+type scriptedFetch struct {
+	steps     []fetchStep
+	requested []int
+}
+
+type fetchStep struct {
+	count int
+	delay time.Duration
+	err   error
+}
+
+func (f *scriptedFetch) fetch(batch int, opts ...nats.PullOpt) ([]*nats.Msg, error) {
+	f.requested = append(f.requested, batch)
+	if len(f.requested) > len(f.steps) {
+		return nil, nats.ErrTimeout
+	}
+
+	step := f.steps[len(f.requested)-1]
+	time.Sleep(step.delay)
+	msgs := make([]*nats.Msg, step.count)
+	for i := range msgs {
+		msgs[i] = nats.NewMsg("ddmrp.buffers")
+	}
+	return msgs, step.err
+}
+
+func TestCollectBatch(t *testing.T) {
+	config := BatchConfig{MaxSize: 5, MaxLatency: 100 * time.Millisecond}
+
+	tests := []struct {
+		name          string
+		steps         []fetchStep
+		wantMsgs      int
+		wantErr       error
+		wantRequested []int
+	}{
+		{
+			name:          "keeps fetching until the batch is full",
+			steps:         []fetchStep{{count: 2}, {count: 1}, {count: 2}},
+			wantMsgs:      5,
+			wantRequested: []int{5, 3, 2},
+		},
+		{
+			name:          "flushes a partial batch when the latency window closes",
+			steps:         []fetchStep{{count: 2}, {count: 1, delay: 120 * time.Millisecond}},
+			wantMsgs:      3,
+			wantRequested: []int{5, 3},
+		},
+		{
+			name:          "flushes a partial batch when a refill fetch times out",
+			steps:         []fetchStep{{count: 3}, {err: nats.ErrTimeout}},
+			wantMsgs:      3,
+			wantRequested: []int{5, 2},
+		},
+		{
+			name:          "flushes a partial batch when a refill fetch fails",
+			steps:         []fetchStep{{count: 1}, {err: nats.ErrConnectionReconnecting}},
+			wantMsgs:      1,
+			wantRequested: []int{5, 4},
+		},
+		{
+			name:          "returns the idle timeout when nothing arrives",
+			steps:         []fetchStep{{err: nats.ErrTimeout}},
+			wantErr:       nats.ErrTimeout,
+			wantRequested: []int{5},
+		},
+		{
+			name:          "does not refill a batch that arrived full",
+			steps:         []fetchStep{{count: 5}},
+			wantMsgs:      5,
+			wantRequested: []int{5},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			source := &scriptedFetch{steps: tt.steps}
+
+			msgs, err := collectBatch(source.fetch, config)
+
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Len(t, msgs, tt.wantMsgs)
+			assert.Equal(t, tt.wantRequested, source.requested)
+		})
+	}
+}
+
+func TestNATSSubscriber_HandleBatch_SkipsUndecodableMessages(t *testing.T) {
+	event := NewEvent("buffer.updated", "ddmrp-engine", "org-1", nil)
+	data, err := event.ToJSON()
+	require.NoError(t, err)
+
+	valid := nats.NewMsg("ddmrp.buffers")
+	valid.Data = data
+	invalid := nats.NewMsg("ddmrp.buffers")
+	invalid.Data = []byte("not json")
+
+	var handled []*Event
+	subscriber := &NATSSubscriber{}
+	subscriber.handleBatch(context.Background(), []*nats.Msg{valid, invalid}, func(ctx context.Context, events []*Event) error {
+		handled = events
+		return nil
+	})
+
+	require.Len(t, handled, 1)
+	assert.Equal(t, event.ID, handled[0].ID)
+}
+
+func TestNATSSubscriber_HandleBatch_WithOnlyUndecodableMessages_SkipsHandler(t *testing.T) {
+	invalid := nats.NewMsg("ddmrp.buffers")
+	invalid.Data = []byte("not json")
+
+	called := false
+	subscriber := &NATSSubscriber{}
+	subscriber.handleBatch(context.Background(), []*nats.Msg{invalid}, func(ctx context.Context, events []*Event) error {
+		called = true
+		return errors.New("unexpected call")
+	})
+
+	assert.False(t, called)
+}
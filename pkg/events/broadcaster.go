@@ -0,0 +1,79 @@
+package events
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/nats-io/nats.go"
+)
+
+const instanceHeader = "Giia-Instance"
+
+// Broadcaster fans events out to every running instance over core NATS, for
+// state held in a single process such as WebSocket connections. Unlike
+// JetStream subscriptions nothing is persisted: instances that are down miss
+// the message.
+type Broadcaster struct {
+	conn       *nats.Conn
+	subject    string
+	instanceID string
+	sub        *nats.Subscription
+}
+
+func NewBroadcaster(nc *nats.Conn, subject string) *Broadcaster {
+	return &Broadcaster{
+		conn:       nc,
+		subject:    subject,
+		instanceID: uuid.New().String(),
+	}
+}
+
+// Broadcast sends event to all other instances. The sender delivers to its
+// own clients directly; its Listen handler does not receive the echo.
+func (b *Broadcaster) Broadcast(ctx context.Context, event *Event) error {
+	data, err := event.ToJSON()
+	if err != nil {
+		return fmt.Errorf("failed to serialize event: %w", err)
+	}
+
+	msg := nats.NewMsg(b.subject)
+	msg.Header.Set(instanceHeader, b.instanceID)
+	msg.Data = data
+
+	if err := b.conn.PublishMsg(msg); err != nil {
+		return fmt.Errorf("failed to broadcast to subject %s: %w", b.subject, err)
+	}
+
+	return nil
+}
+
+// Listen delivers events broadcast by other instances to handler. Handler
+// errors are dropped since there is no redelivery.
+func (b *Broadcaster) Listen(ctx context.Context, handler EventHandler) error {
+	sub, err := b.conn.Subscribe(b.subject, func(msg *nats.Msg) {
+		if msg.Header.Get(instanceHeader) == b.instanceID {
+			return
+		}
+
+		event, err := FromJSON(msg.Data)
+		if err != nil {
+			return
+		}
+
+		_ = handler(ctx, event)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to listen on subject %s: %w", b.subject, err)
+	}
+
+	b.sub = sub
+	return nil
+}
+
+func (b *Broadcaster) Close() error {
+	if b.sub == nil {
+		return nil
+	}
+	return b.sub.Unsubscribe()
+}
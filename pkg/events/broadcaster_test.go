@@ -0,0 +1,76 @@
+package events
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// listen starts b and returns the events its handler receives.
+func listen(t *testing.T, b *Broadcaster) <-chan *Event {
+	received := make(chan *Event, 10)
+	require.NoError(t, b.Listen(context.Background(), func(ctx context.Context, event *Event) error {
+		received <- event
+		return nil
+	}))
+	require.NoError(t, b.conn.Flush())
+	t.Cleanup(func() { b.Close() })
+	return received
+}
+
+func nextEvent(t *testing.T, received <-chan *Event) *Event {
+	select {
+	case event := <-received:
+		return event
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for broadcast")
+		return nil
+	}
+}
+
+func TestBroadcaster_Broadcast_ReachesOtherInstancesWithoutEcho(t *testing.T) {
+	server := startFakeNATS(t)
+	sender := NewBroadcaster(server.connect(t), "notifications.broadcast")
+	other := NewBroadcaster(server.connect(t), "notifications.broadcast")
+	senderReceived := listen(t, sender)
+	otherReceived := listen(t, other)
+
+	first := NewEvent("alert.created", "notification-service", "org-1", nil)
+	require.NoError(t, sender.Broadcast(context.Background(), first))
+	assert.Equal(t, first.ID, nextEvent(t, otherReceived).ID)
+
+	// The sender would have seen its own echo before this reply
+	second := NewEvent("alert.created", "notification-service", "org-1", nil)
+	require.NoError(t, other.Broadcast(context.Background(), second))
+	assert.Equal(t, second.ID, nextEvent(t, senderReceived).ID)
+	assert.Empty(t, senderReceived)
+	assert.Empty(t, otherReceived)
+}
+
+func TestBroadcaster_Listen_DropsUndecodableMessages(t *testing.T) {
+	server := startFakeNATS(t)
+	nc := server.connect(t)
+	received := listen(t, NewBroadcaster(server.connect(t), "notifications.broadcast"))
+
+	require.NoError(t, nc.Publish("notifications.broadcast", []byte("not json")))
+	event := NewEvent("alert.created", "notification-service", "org-1", nil)
+	require.NoError(t, NewBroadcaster(nc, "notifications.broadcast").Broadcast(context.Background(), event))
+
+	assert.Equal(t, event.ID, nextEvent(t, received).ID)
+}
+
+func TestBroadcaster_Close(t *testing.T) {
+	server := startFakeNATS(t)
+	nc := server.connect(t)
+	broadcaster := NewBroadcaster(nc, "notifications.broadcast")
+
+	require.NoError(t, broadcaster.Close(), "close before listen")
+
+	listen(t, broadcaster)
+	require.Equal(t, 1, nc.NumSubscriptions())
+	require.NoError(t, broadcaster.Close())
+	assert.Equal(t, 0, nc.NumSubscriptions())
+}
@@ -1,20 +1,36 @@
 package events
 
 import (
+	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/nats-io/nats.go"
 )
 
 type ConnectionConfig struct {
-	URL             string
-	MaxReconnects   int
-	ReconnectWait   time.Duration
-	ConnectionName  string
+	URL string
+	// URLs lists the cluster seed servers. The client fails over between them
+	// and learns the rest of the cluster from the server it reaches.
+	URLs           []string
+	MaxReconnects  int
+	ReconnectWait  time.Duration
+	ConnectionName string
+	// MaxReconnectWait caps the exponential backoff between reconnect
+	// attempts. Zero keeps a fixed ReconnectWait.
+	MaxReconnectWait time.Duration
+	// OnStateChange is called on disconnect, reconnect and close with the
+	// new status and the server URL involved (empty when disconnected).
+	OnStateChange func(status nats.Status, url string, err error)
 }
 
 func Connect(config *ConnectionConfig) (*nats.Conn, error) {
+	notify := config.OnStateChange
+	if notify == nil {
+		notify = func(nats.Status, string, error) {}
+	}
+
 	opts := []nats.Option{
 		nats.Name(config.ConnectionName),
 		nats.MaxReconnects(config.MaxReconnects),
@@ -23,13 +39,22 @@ func Connect(config *ConnectionConfig) (*nats.Conn, error) {
 			if err != nil {
 				fmt.Printf("NATS disconnected: %v\n", err)
 			}
+			notify(nc.Status(), "", err)
 		}),
 		nats.ReconnectHandler(func(nc *nats.Conn) {
 			fmt.Printf("NATS reconnected to %s\n", nc.ConnectedUrl())
+			notify(nc.Status(), nc.ConnectedUrl(), nil)
+		}),
+		nats.ClosedHandler(func(nc *nats.Conn) {
+			notify(nats.CLOSED, "", nc.LastError())
 		}),
 	}
 
-	nc, err := nats.Connect(config.URL, opts...)
+	if config.MaxReconnectWait > 0 {
+		opts = append(opts, nats.CustomReconnectDelay(reconnectBackoff(config.ReconnectWait, config.MaxReconnectWait)))
+	}
+
+	nc, err := nats.Connect(serverList(config), opts...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to NATS: %w", err)
 	}
@@ -39,10 +64,10 @@ func Connect(config *ConnectionConfig) (*nats.Conn, error) {
 
 func ConnectWithDefaults(url string) (*nats.Conn, error) {
 	config := &ConnectionConfig{
-		URL:             url,
-		MaxReconnects:   10,
-		ReconnectWait:   2 * time.Second,
-		ConnectionName:  "giia-service",
+		URL:            url,
+		MaxReconnects:  10,
+		ReconnectWait:  2 * time.Second,
+		ConnectionName: "giia-service",
 	}
 
 	return Connect(config)
@@ -58,3 +83,52 @@ func Disconnect(nc *nats.Conn) error {
 
 	return nil
 }
+
+// HealthCheck reports the connection as down while it is not connected,
+// including while it is reconnecting to another cluster member. Its signature
+// matches health.CheckFunc.
+func HealthCheck(nc *nats.Conn) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		if status := nc.Status(); status != nats.CONNECTED {
+			return fmt.Errorf("NATS connection is %s", connectionStatusName(status))
+		}
+		return nil
+	}
+}
+
+func serverList(config *ConnectionConfig) string {
+	if len(config.URLs) == 0 {
+		return config.URL
+	}
+	return strings.Join(config.URLs, ",")
+}
+
+func reconnectBackoff(initial, max time.Duration) func(attempts int) time.Duration {
+	return func(attempts int) time.Duration {
+		wait := initial
+		for i := 1; i < attempts && wait < max; i++ {
+			wait *= 2
+		}
+		if wait > max {
+			wait = max
+		}
+		return wait
+	}
+}
+
+func connectionStatusName(status nats.Status) string {
+	switch status {
+	case nats.DISCONNECTED:
+		return "disconnected"
+	case nats.RECONNECTING:
+		return "reconnecting"
+	case nats.CLOSED:
+		return "closed"
+	case nats.CONNECTING:
+		return "connecting"
+	case nats.DRAINING_SUBS, nats.DRAINING_PUBS:
+		return "draining"
+	default:
+		return "unknown"
+	}
+}
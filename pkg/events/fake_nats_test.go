@@ -0,0 +1,213 @@
+package events
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/nats-io/nats.go"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeNATSServer speaks enough of the core NATS client protocol (headers,
+// queue groups, wildcards and no-responders) for request-reply and fan-out
+// tests without a nats-server binary. JetStream is not supported.
+type fakeNATSServer struct {
+	listener net.Listener
+
+	mu      sync.Mutex
+	clients []*fakeNATSClient
+	subs    []fakeNATSSub
+}
+
+type fakeNATSClient struct {
+	conn net.Conn
+	mu   sync.Mutex
+}
+
+type fakeNATSSub struct {
+	client  *fakeNATSClient
+	sid     string
+	subject string
+	queue   string
+}
+
+func startFakeNATS(t *testing.T) *fakeNATSServer {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	server := &fakeNATSServer{listener: listener}
+	go server.accept()
+	t.Cleanup(server.close)
+
+	return server
+}
+
+// connect opens a client connection that is closed with the test.
+func (s *fakeNATSServer) connect(t *testing.T) *nats.Conn {
+	nc, err := nats.Connect("nats://"+s.listener.Addr().String(), nats.NoReconnect())
+	require.NoError(t, err)
+	t.Cleanup(nc.Close)
+	return nc
+}
+
+func (s *fakeNATSServer) accept() {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+
+		client := &fakeNATSClient{conn: conn}
+		s.mu.Lock()
+		s.clients = append(s.clients, client)
+		s.mu.Unlock()
+
+		go s.serve(client)
+	}
+}
+
+func (s *fakeNATSServer) close() {
+	s.listener.Close()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, client := range s.clients {
+		client.conn.Close()
+	}
+}
+
+func (s *fakeNATSServer) serve(client *fakeNATSClient) {
+	client.write(`INFO {"server_id":"fake","version":"2.10.0","proto":1,"headers":true,"max_payload":1048576}` + "\r\n")
+
+	reader := bufio.NewReader(client.conn)
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			return
+		}
+		args := strings.Fields(line)
+		if len(args) == 0 {
+			continue
+		}
+
+		switch strings.ToUpper(args[0]) {
+		case "PING":
+			client.write("PONG\r\n")
+		case "SUB":
+			sub := fakeNATSSub{client: client, subject: args[1], sid: args[len(args)-1]}
+			if len(args) == 4 {
+				sub.queue = args[2]
+			}
+			s.mu.Lock()
+			s.subs = append(s.subs, sub)
+			s.mu.Unlock()
+		case "UNSUB":
+			s.unsubscribe(client, args[1])
+		case "PUB", "HPUB":
+			if err := s.publish(reader, args); err != nil {
+				return
+			}
+		}
+	}
+}
+
+func (s *fakeNATSServer) unsubscribe(client *fakeNATSClient, sid string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i, sub := range s.subs {
+		if sub.client == client && sub.sid == sid {
+			s.subs = append(s.subs[:i], s.subs[i+1:]...)
+			return
+		}
+	}
+}
+
+// publish reads the payload of a PUB or HPUB and routes it.
+func (s *fakeNATSServer) publish(reader *bufio.Reader, args []string) error {
+	subject, reply := args[1], ""
+	sizes := args[2:]
+	headerSize := 0
+
+	if args[0] == "HPUB" {
+		if len(sizes) == 3 {
+			reply, sizes = sizes[0], sizes[1:]
+		}
+		headerSize, _ = strconv.Atoi(sizes[0])
+		sizes = sizes[1:]
+	} else if len(sizes) == 2 {
+		reply, sizes = sizes[0], sizes[1:]
+	}
+
+	total, _ := strconv.Atoi(sizes[0])
+	body := make([]byte, total+2)
+	if _, err := io.ReadFull(reader, body); err != nil {
+		return err
+	}
+
+	s.route(subject, reply, body[:headerSize], body[headerSize:total])
+	return nil
+}
+
+// route delivers to every plain subscriber and one member of each queue
+// group. Requests nobody listens to get a 503 status, as the real server
+// sends to clients that enable no-responders.
+func (s *fakeNATSServer) route(subject, reply string, header, data []byte) {
+	s.mu.Lock()
+	var targets []fakeNATSSub
+	queues := make(map[string]bool)
+	for _, sub := range s.subs {
+		if !subjectMatches(sub.subject, subject) || (sub.queue != "" && queues[sub.queue]) {
+			continue
+		}
+		if sub.queue != "" {
+			queues[sub.queue] = true
+		}
+		targets = append(targets, sub)
+	}
+	s.mu.Unlock()
+
+	if len(targets) == 0 && reply != "" {
+		s.route(reply, "", []byte("NATS/1.0 503\r\n\r\n"), nil)
+		return
+	}
+
+	for _, sub := range targets {
+		replyArg := ""
+		if reply != "" {
+			replyArg = " " + reply
+		}
+		if len(header) > 0 {
+			sub.client.write(fmt.Sprintf("HMSG %s %s%s %d %d\r\n%s%s\r\n", subject, sub.sid, replyArg, len(header), len(header)+len(data), header, data))
+		} else {
+			sub.client.write(fmt.Sprintf("MSG %s %s%s %d\r\n%s\r\n", subject, sub.sid, replyArg, len(data), data))
+		}
+	}
+}
+
+func (c *fakeNATSClient) write(frame string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	_, _ = io.WriteString(c.conn, frame)
+}
+
+func subjectMatches(pattern, subject string) bool {
+	patternTokens := strings.Split(pattern, ".")
+	subjectTokens := strings.Split(subject, ".")
+
+	for i, token := range patternTokens {
+		if token == ">" {
+			return len(subjectTokens) > i
+		}
+		if i >= len(subjectTokens) || (token != "*" && token != subjectTokens[i]) {
+			return false
+		}
+	}
+	return len(patternTokens) == len(subjectTokens)
+}
@@ -0,0 +1,174 @@
+package events
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// OutboxRecord is an event waiting in the outbox table to be published.
+type OutboxRecord struct {
+	ID       string
+	Subject  string
+	Payload  []byte
+	Attempts int
+}
+
+// OutboxStore persists events next to the business writes that produced them
+// and hands pending rows to the relay.
+type OutboxStore interface {
+	// Enqueue inserts the event in the transaction carried by ctx, or
+	// directly when ctx carries none.
+	Enqueue(ctx context.Context, subject string, event *Event) error
+	// Dispatch locks up to limit pending rows, calls publish for each in
+	// creation order and marks them published or failed. Rows locked by
+	// another replica are skipped. The locks and the transaction holding
+	// them stay open until the whole batch has been published, so publish
+	// must be bounded; OutboxRelay applies PublishTimeout to each call.
+	Dispatch(ctx context.Context, limit, maxAttempts int, publish func(ctx context.Context, record OutboxRecord) error) (int, error)
+	// PurgePublished deletes rows published before the given time.
+	PurgePublished(ctx context.Context, before time.Time) (int64, error)
+}
+
+// OutboxPublisher is a Publisher that writes to the outbox instead of NATS,
+// so the event commits or rolls back with the caller's transaction. An
+// OutboxRelay publishes the rows afterwards.
+type OutboxPublisher struct {
+	store OutboxStore
+}
+
+func NewOutboxPublisher(store OutboxStore) *OutboxPublisher {
+	return &OutboxPublisher{store: store}
+}
+
+func (p *OutboxPublisher) Publish(ctx context.Context, subject string, event *Event) error {
+	if err := p.store.Enqueue(ctx, subject, event); err != nil {
+		return fmt.Errorf("failed to enqueue event in outbox: %w", err)
+	}
+	return nil
+}
+
+// PublishAsync is the same as Publish: the relay is already asynchronous.
+func (p *OutboxPublisher) PublishAsync(ctx context.Context, subject string, event *Event) error {
+	return p.Publish(ctx, subject, event)
+}
+
+// Close is a no-op; the store's database is owned by the caller.
+func (p *OutboxPublisher) Close() error {
+	return nil
+}
+
+type OutboxRelayConfig struct {
+	// Interval is how often pending rows are polled.
+	Interval time.Duration
+	// BatchSize caps the rows published per poll.
+	BatchSize int
+	// MaxAttempts stops retrying a row after this many failed publishes.
+	// The row stays in the table for inspection.
+	MaxAttempts int
+	// Retention is how long published rows are kept; 0 keeps them.
+	Retention time.Duration
+	// PublishTimeout bounds each publish. A batch keeps its rows locked and
+	// its transaction open for up to BatchSize * PublishTimeout when NATS
+	// is slow, so lower BatchSize rather than raise this.
+	PublishTimeout time.Duration
+}
+
+func (c OutboxRelayConfig) withDefaults() OutboxRelayConfig {
+	if c.Interval <= 0 {
+		c.Interval = time.Second
+	}
+	if c.BatchSize <= 0 {
+		c.BatchSize = 100
+	}
+	if c.MaxAttempts <= 0 {
+		c.MaxAttempts = 10
+	}
+	if c.PublishTimeout <= 0 {
+		c.PublishTimeout = 5 * time.Second
+	}
+	return c
+}
+
+// OutboxRelay publishes outbox rows to NATS. Several replicas can run it at
+// once; each row is locked by one of them. Delivery is at-least-once: a crash
+// between the publish and the commit publishes the row again, so consumers
+// must be idempotent on Event.ID.
+type OutboxRelay struct {
+	store     OutboxStore
+	publisher Publisher
+	config    OutboxRelayConfig
+	onError   func(ctx context.Context, err error)
+}
+
+func NewOutboxRelay(store OutboxStore, publisher Publisher, config OutboxRelayConfig) *OutboxRelay {
+	return &OutboxRelay{
+		store:     store,
+		publisher: publisher,
+		config:    config.withDefaults(),
+	}
+}
+
+// OnError is called when a poll or purge fails, e.g. to log it.
+func (r *OutboxRelay) OnError(fn func(ctx context.Context, err error)) {
+	r.onError = fn
+}
+
+// Run polls the outbox until ctx is cancelled. Full batches are followed by
+// another poll right away so a backlog drains without waiting for Interval.
+func (r *OutboxRelay) Run(ctx context.Context) {
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	lastPurge := time.Time{}
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+		}
+
+		published, err := r.Flush(ctx)
+		if err != nil {
+			r.reportError(ctx, err)
+		}
+
+		if r.config.Retention > 0 && time.Since(lastPurge) >= time.Hour {
+			if _, err := r.store.PurgePublished(ctx, time.Now().UTC().Add(-r.config.Retention)); err != nil {
+				r.reportError(ctx, fmt.Errorf("failed to purge outbox: %w", err))
+			}
+			lastPurge = time.Now()
+		}
+
+		if published == r.config.BatchSize {
+			timer.Reset(0)
+		} else {
+			timer.Reset(r.config.Interval)
+		}
+	}
+}
+
+// Flush publishes one batch of pending rows and returns how many were
+// published.
+func (r *OutboxRelay) Flush(ctx context.Context) (int, error) {
+	published, err := r.store.Dispatch(ctx, r.config.BatchSize, r.config.MaxAttempts, func(ctx context.Context, record OutboxRecord) error {
+		event, err := FromJSON(record.Payload)
+		if err != nil {
+			return fmt.Errorf("failed to decode outbox event: %w", err)
+		}
+
+		publishCtx, cancel := context.WithTimeout(ctx, r.config.PublishTimeout)
+		defer cancel()
+		return r.publisher.Publish(publishCtx, record.Subject, event)
+	})
+	if err != nil {
+		return published, fmt.Errorf("failed to dispatch outbox: %w", err)
+	}
+	return published, nil
+}
+
+func (r *OutboxRelay) reportError(ctx context.Context, err error) {
+	if r.onError != nil && ctx.Err() == nil {
+		r.onError(ctx, err)
+	}
+}
@@ -0,0 +1,100 @@
+package events
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type gormTxKey struct{}
+
+// WithGormTx makes GormOutboxStore.Enqueue write in tx, typically the *gorm.DB
+// passed to a db.Transaction callback.
+func WithGormTx(ctx context.Context, tx *gorm.DB) context.Context {
+	return context.WithValue(ctx, gormTxKey{}, tx)
+}
+
+// GormOutboxStore is the GORM OutboxStore for Postgres.
+type GormOutboxStore struct {
+	db    *gorm.DB
+	table string
+}
+
+// NewGormOutboxStore uses table, or DefaultOutboxTable when empty. The name is
+// interpolated into queries and must not come from user input.
+func NewGormOutboxStore(db *gorm.DB, table string) *GormOutboxStore {
+	if table == "" {
+		table = DefaultOutboxTable
+	}
+	return &GormOutboxStore{db: db, table: table}
+}
+
+func (s *GormOutboxStore) Enqueue(ctx context.Context, subject string, event *Event) error {
+	payload, err := event.ToJSON()
+	if err != nil {
+		return fmt.Errorf("failed to serialize event: %w", err)
+	}
+
+	db := s.db
+	if tx, ok := ctx.Value(gormTxKey{}).(*gorm.DB); ok && tx != nil {
+		db = tx
+	}
+
+	query := fmt.Sprintf("INSERT INTO %s (id, subject, payload, created_at) VALUES (?, ?, ?, ?)", s.table)
+	if err := db.WithContext(ctx).Exec(query, event.ID, subject, payload, time.Now().UTC()).Error; err != nil {
+		return fmt.Errorf("failed to insert outbox row: %w", err)
+	}
+
+	return nil
+}
+
+func (s *GormOutboxStore) Dispatch(ctx context.Context, limit, maxAttempts int, publish func(ctx context.Context, record OutboxRecord) error) (int, error) {
+	published := 0
+
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		query := fmt.Sprintf(`SELECT id, subject, payload, attempts FROM %s
+			WHERE published_at IS NULL AND attempts < ?
+			ORDER BY created_at
+			LIMIT ?
+			FOR UPDATE SKIP LOCKED`, s.table)
+
+		var records []OutboxRecord
+		if err := tx.Raw(query, maxAttempts, limit).Scan(&records).Error; err != nil {
+			return fmt.Errorf("failed to select pending outbox rows: %w", err)
+		}
+
+		for _, record := range records {
+			if publishErr := publish(ctx, record); publishErr != nil {
+				query := fmt.Sprintf("UPDATE %s SET attempts = attempts + 1, last_error = ? WHERE id = ?", s.table)
+				if err := tx.Exec(query, publishErr.Error(), record.ID).Error; err != nil {
+					return fmt.Errorf("failed to record outbox failure: %w", err)
+				}
+				continue
+			}
+
+			query := fmt.Sprintf("UPDATE %s SET published_at = ?, attempts = attempts + 1, last_error = NULL WHERE id = ?", s.table)
+			if err := tx.Exec(query, time.Now().UTC(), record.ID).Error; err != nil {
+				return fmt.Errorf("failed to mark outbox row published: %w", err)
+			}
+			published++
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return published, nil
+}
+
+func (s *GormOutboxStore) PurgePublished(ctx context.Context, before time.Time) (int64, error) {
+	query := fmt.Sprintf("DELETE FROM %s WHERE published_at IS NOT NULL AND published_at < ?", s.table)
+	result := s.db.WithContext(ctx).Exec(query, before)
+	if result.Error != nil {
+		return 0, fmt.Errorf("failed to purge outbox: %w", result.Error)
+	}
+	return result.RowsAffected, nil
+}
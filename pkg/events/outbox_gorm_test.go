@@ -0,0 +1,99 @@
+package events
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+func newFakeGormDB(t *testing.T) (*fakeDatabase, *gorm.DB) {
+	fake, sqlDB := newFakeDatabase(t)
+	db, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{
+		DisableAutomaticPing: true,
+	})
+	require.NoError(t, err)
+	return fake, db
+}
+
+func TestGormOutboxStore_Enqueue_WithTx_InsertsInsideTransaction(t *testing.T) {
+	fake, db := newFakeGormDB(t)
+	store := NewGormOutboxStore(db, "")
+	event := NewEvent("purchase_order.received", "execution-service", "org-1", nil)
+
+	err := db.Transaction(func(tx *gorm.DB) error {
+		return store.Enqueue(WithGormTx(context.Background(), tx), "execution.purchase_orders", event)
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"BEGIN", sqlInsertOutbox, "COMMIT"}, fake.queries())
+	args := fake.statement(1).args
+	assert.Equal(t, event.ID, args[0])
+	assert.Equal(t, "execution.purchase_orders", args[1])
+	assert.IsType(t, time.Time{}, args[3])
+}
+
+func TestGormOutboxStore_Dispatch_MarksPublishedAndFailedRowsInOneTransaction(t *testing.T) {
+	fake, db := newFakeGormDB(t)
+	fake.pendingRows = [][]driver.Value{
+		pendingRow("evt-1", "execution.purchase_orders", 0),
+		pendingRow("evt-2", "execution.purchase_orders", 3),
+	}
+
+	var seen []OutboxRecord
+	published, err := NewGormOutboxStore(db, "").Dispatch(context.Background(), 50, 10, func(ctx context.Context, record OutboxRecord) error {
+		seen = append(seen, record)
+		if record.ID == "evt-2" {
+			return errors.New("nats down")
+		}
+		return nil
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, published)
+	assert.Equal(t, []string{
+		"BEGIN",
+		sqlSelectPending,
+		"UPDATE event_outbox SET published_at = $1, attempts = attempts + 1, last_error = NULL WHERE id = $2",
+		"UPDATE event_outbox SET attempts = attempts + 1, last_error = $1 WHERE id = $2",
+		"COMMIT",
+	}, fake.queries())
+	assert.Equal(t, []driver.Value{int64(10), int64(50)}, fake.statement(1).args)
+	assert.Equal(t, "evt-1", fake.statement(2).args[1])
+	assert.Equal(t, []driver.Value{"nats down", "evt-2"}, fake.statement(3).args)
+	require.Len(t, seen, 2)
+	assert.Equal(t, 3, seen[1].Attempts)
+	assert.Equal(t, []byte(`{"id":"evt-1"}`), seen[0].Payload)
+}
+
+func TestGormOutboxStore_Dispatch_WithUpdateError_RollsBack(t *testing.T) {
+	fake, db := newFakeGormDB(t)
+	fake.pendingRows = [][]driver.Value{pendingRow("evt-1", "execution.purchase_orders", 0)}
+	fake.execErr = errors.New("connection reset")
+
+	published, err := NewGormOutboxStore(db, "").Dispatch(context.Background(), 50, 10, func(ctx context.Context, record OutboxRecord) error {
+		return nil
+	})
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to mark outbox row published")
+	assert.Zero(t, published)
+	assert.Equal(t, "ROLLBACK", fake.queries()[len(fake.queries())-1])
+}
+
+func TestGormOutboxStore_PurgePublished_ReturnsDeletedRows(t *testing.T) {
+	fake, db := newFakeGormDB(t)
+	fake.rowsAffected = 7
+
+	purged, err := NewGormOutboxStore(db, "").PurgePublished(context.Background(), time.Now().UTC())
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(7), purged)
+	assert.Equal(t, []string{sqlPurgePublished}, fake.queries())
+}
@@ -0,0 +1,128 @@
+package events
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+)
+
+// DefaultOutboxTable is the table used when a store is created with an empty
+// table name.
+const DefaultOutboxTable = "event_outbox"
+
+type sqlTxKey struct{}
+
+// WithSQLTx makes SQLOutboxStore.Enqueue write in tx, so the event commits
+// with the business writes of the same transaction.
+func WithSQLTx(ctx context.Context, tx *sql.Tx) context.Context {
+	return context.WithValue(ctx, sqlTxKey{}, tx)
+}
+
+type sqlExecer interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+}
+
+// SQLOutboxStore is the database/sql OutboxStore for Postgres.
+type SQLOutboxStore struct {
+	db    *sql.DB
+	table string
+}
+
+// NewSQLOutboxStore uses table, or DefaultOutboxTable when empty. The name is
+// interpolated into queries and must not come from user input.
+func NewSQLOutboxStore(db *sql.DB, table string) *SQLOutboxStore {
+	if table == "" {
+		table = DefaultOutboxTable
+	}
+	return &SQLOutboxStore{db: db, table: table}
+}
+
+func (s *SQLOutboxStore) Enqueue(ctx context.Context, subject string, event *Event) error {
+	payload, err := event.ToJSON()
+	if err != nil {
+		return fmt.Errorf("failed to serialize event: %w", err)
+	}
+
+	var execer sqlExecer = s.db
+	if tx, ok := ctx.Value(sqlTxKey{}).(*sql.Tx); ok && tx != nil {
+		execer = tx
+	}
+
+	query := fmt.Sprintf("INSERT INTO %s (id, subject, payload, created_at) VALUES ($1, $2, $3, $4)", s.table)
+	if _, err := execer.ExecContext(ctx, query, event.ID, subject, payload, time.Now().UTC()); err != nil {
+		return fmt.Errorf("failed to insert outbox row: %w", err)
+	}
+
+	return nil
+}
+
+func (s *SQLOutboxStore) Dispatch(ctx context.Context, limit, maxAttempts int, publish func(ctx context.Context, record OutboxRecord) error) (int, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	records, err := s.lockPending(ctx, tx, limit, maxAttempts)
+	if err != nil {
+		return 0, err
+	}
+
+	published := 0
+	for _, record := range records {
+		if publishErr := publish(ctx, record); publishErr != nil {
+			query := fmt.Sprintf("UPDATE %s SET attempts = attempts + 1, last_error = $2 WHERE id = $1", s.table)
+			if _, err := tx.ExecContext(ctx, query, record.ID, publishErr.Error()); err != nil {
+				return 0, fmt.Errorf("failed to record outbox failure: %w", err)
+			}
+			continue
+		}
+
+		query := fmt.Sprintf("UPDATE %s SET published_at = $2, attempts = attempts + 1, last_error = NULL WHERE id = $1", s.table)
+		if _, err := tx.ExecContext(ctx, query, record.ID, time.Now().UTC()); err != nil {
+			return 0, fmt.Errorf("failed to mark outbox row published: %w", err)
+		}
+		published++
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit outbox batch: %w", err)
+	}
+
+	return published, nil
+}
+
+func (s *SQLOutboxStore) lockPending(ctx context.Context, tx *sql.Tx, limit, maxAttempts int) ([]OutboxRecord, error) {
+	query := fmt.Sprintf(`SELECT id, subject, payload, attempts FROM %s
+		WHERE published_at IS NULL AND attempts < $1
+		ORDER BY created_at
+		LIMIT $2
+		FOR UPDATE SKIP LOCKED`, s.table)
+
+	rows, err := tx.QueryContext(ctx, query, maxAttempts, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to select pending outbox rows: %w", err)
+	}
+	defer rows.Close()
+
+	var records []OutboxRecord
+	for rows.Next() {
+		var record OutboxRecord
+		if err := rows.Scan(&record.ID, &record.Subject, &record.Payload, &record.Attempts); err != nil {
+			return nil, fmt.Errorf("failed to scan outbox row: %w", err)
+		}
+		records = append(records, record)
+	}
+
+	return records, rows.Err()
+}
+
+func (s *SQLOutboxStore) PurgePublished(ctx context.Context, before time.Time) (int64, error) {
+	query := fmt.Sprintf("DELETE FROM %s WHERE published_at IS NOT NULL AND published_at < $1", s.table)
+	result, err := s.db.ExecContext(ctx, query, before)
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge outbox: %w", err)
+	}
+	return result.RowsAffected()
+}
@@ -0,0 +1,226 @@
+package events
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeStatement is one call the store made against the database. BEGIN,
+// COMMIT and ROLLBACK are recorded as statements without arguments.
+type fakeStatement struct {
+	query string
+	args  []driver.Value
+}
+
+// fakeDatabase is a scripted database/sql driver: it records every statement
+// and answers queries with pendingRows, so the stores run their real SQL
+// without a Postgres server.
+type fakeDatabase struct {
+	mu           sync.Mutex
+	statements   []fakeStatement
+	pendingRows  [][]driver.Value
+	rowsAffected int64
+	execErr      error
+}
+
+func newFakeDatabase(t *testing.T) (*fakeDatabase, *sql.DB) {
+	fake := &fakeDatabase{}
+	db := sql.OpenDB(fake)
+	t.Cleanup(func() { db.Close() })
+	return fake, db
+}
+
+func (f *fakeDatabase) Connect(context.Context) (driver.Conn, error) { return &fakeConn{db: f}, nil }
+func (f *fakeDatabase) Driver() driver.Driver                        { return nil }
+
+func (f *fakeDatabase) record(query string, args []driver.NamedValue) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	values := make([]driver.Value, len(args))
+	for i, arg := range args {
+		values[i] = arg.Value
+	}
+	f.statements = append(f.statements, fakeStatement{query: strings.Join(strings.Fields(query), " "), args: values})
+}
+
+func (f *fakeDatabase) queries() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	queries := make([]string, len(f.statements))
+	for i, statement := range f.statements {
+		queries[i] = statement.query
+	}
+	return queries
+}
+
+func (f *fakeDatabase) statement(i int) fakeStatement {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.statements[i]
+}
+
+type fakeConn struct {
+	db *fakeDatabase
+}
+
+func (c *fakeConn) Prepare(string) (driver.Stmt, error) {
+	return nil, errors.New("prepared statements are not supported")
+}
+func (c *fakeConn) Close() error { return nil }
+func (c *fakeConn) Begin() (driver.Tx, error) {
+	return c.BeginTx(context.Background(), driver.TxOptions{})
+}
+
+func (c *fakeConn) BeginTx(context.Context, driver.TxOptions) (driver.Tx, error) {
+	c.db.record("BEGIN", nil)
+	return &fakeTx{db: c.db}, nil
+}
+
+func (c *fakeConn) ExecContext(_ context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	c.db.record(query, args)
+	if c.db.execErr != nil {
+		return nil, c.db.execErr
+	}
+	return driver.RowsAffected(c.db.rowsAffected), nil
+}
+
+func (c *fakeConn) QueryContext(_ context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	c.db.record(query, args)
+	return &fakeRows{values: c.db.pendingRows}, nil
+}
+
+type fakeTx struct {
+	db *fakeDatabase
+}
+
+func (t *fakeTx) Commit() error {
+	t.db.record("COMMIT", nil)
+	return nil
+}
+
+func (t *fakeTx) Rollback() error {
+	t.db.record("ROLLBACK", nil)
+	return nil
+}
+
+type fakeRows struct {
+	values [][]driver.Value
+	next   int
+}
+
+func (r *fakeRows) Columns() []string { return []string{"id", "subject", "payload", "attempts"} }
+func (r *fakeRows) Close() error      { return nil }
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.next >= len(r.values) {
+		return io.EOF
+	}
+	copy(dest, r.values[r.next])
+	r.next++
+	return nil
+}
+
+func pendingRow(id, subject string, attempts int64) []driver.Value {
+	return []driver.Value{id, subject, []byte(`{"id":"` + id + `"}`), attempts}
+}
+
+const (
+	sqlInsertOutbox   = "INSERT INTO event_outbox (id, subject, payload, created_at) VALUES ($1, $2, $3, $4)"
+	sqlSelectPending  = "SELECT id, subject, payload, attempts FROM event_outbox WHERE published_at IS NULL AND attempts < $1 ORDER BY created_at LIMIT $2 FOR UPDATE SKIP LOCKED"
+	sqlMarkPublished  = "UPDATE event_outbox SET published_at = $2, attempts = attempts + 1, last_error = NULL WHERE id = $1"
+	sqlRecordFailure  = "UPDATE event_outbox SET attempts = attempts + 1, last_error = $2 WHERE id = $1"
+	sqlPurgePublished = "DELETE FROM event_outbox WHERE published_at IS NOT NULL AND published_at < $1"
+)
+
+func TestSQLOutboxStore_Enqueue_WithoutTx_InsertsRow(t *testing.T) {
+	fake, db := newFakeDatabase(t)
+	event := NewEvent("purchase_order.received", "execution-service", "org-1", nil)
+
+	err := NewSQLOutboxStore(db, "").Enqueue(context.Background(), "execution.purchase_orders", event)
+
+	require.NoError(t, err)
+	require.Equal(t, []string{sqlInsertOutbox}, fake.queries())
+	args := fake.statement(0).args
+	assert.Equal(t, event.ID, args[0])
+	assert.Equal(t, "execution.purchase_orders", args[1])
+	assert.IsType(t, time.Time{}, args[3])
+}
+
+func TestSQLOutboxStore_Enqueue_WithTx_InsertsInsideTransaction(t *testing.T) {
+	fake, db := newFakeDatabase(t)
+	event := NewEvent("purchase_order.received", "execution-service", "org-1", nil)
+
+	tx, err := db.BeginTx(context.Background(), nil)
+	require.NoError(t, err)
+	require.NoError(t, NewSQLOutboxStore(db, "").Enqueue(WithSQLTx(context.Background(), tx), "execution.purchase_orders", event))
+	require.NoError(t, tx.Commit())
+
+	assert.Equal(t, []string{"BEGIN", sqlInsertOutbox, "COMMIT"}, fake.queries())
+}
+
+func TestSQLOutboxStore_Dispatch_MarksPublishedAndFailedRowsInOneTransaction(t *testing.T) {
+	fake, db := newFakeDatabase(t)
+	fake.pendingRows = [][]driver.Value{
+		pendingRow("evt-1", "execution.purchase_orders", 0),
+		pendingRow("evt-2", "execution.purchase_orders", 3),
+	}
+
+	var seen []OutboxRecord
+	published, err := NewSQLOutboxStore(db, "").Dispatch(context.Background(), 50, 10, func(ctx context.Context, record OutboxRecord) error {
+		seen = append(seen, record)
+		if record.ID == "evt-2" {
+			return errors.New("nats down")
+		}
+		return nil
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, published)
+	assert.Equal(t, []string{"BEGIN", sqlSelectPending, sqlMarkPublished, sqlRecordFailure, "COMMIT"}, fake.queries())
+	assert.Equal(t, []driver.Value{int64(10), int64(50)}, fake.statement(1).args)
+	assert.Equal(t, "evt-1", fake.statement(2).args[0])
+	assert.Equal(t, []driver.Value{"evt-2", "nats down"}, fake.statement(3).args)
+	require.Len(t, seen, 2)
+	assert.Equal(t, 3, seen[1].Attempts)
+	assert.Equal(t, []byte(`{"id":"evt-1"}`), seen[0].Payload)
+}
+
+func TestSQLOutboxStore_Dispatch_WithUpdateError_RollsBack(t *testing.T) {
+	fake, db := newFakeDatabase(t)
+	fake.pendingRows = [][]driver.Value{pendingRow("evt-1", "execution.purchase_orders", 0)}
+	fake.execErr = errors.New("connection reset")
+
+	published, err := NewSQLOutboxStore(db, "").Dispatch(context.Background(), 50, 10, func(ctx context.Context, record OutboxRecord) error {
+		return nil
+	})
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to mark outbox row published")
+	assert.Zero(t, published)
+	assert.Equal(t, []string{"BEGIN", sqlSelectPending, sqlMarkPublished, "ROLLBACK"}, fake.queries())
+}
+
+func TestSQLOutboxStore_PurgePublished_ReturnsDeletedRows(t *testing.T) {
+	fake, db := newFakeDatabase(t)
+	fake.rowsAffected = 7
+	before := time.Now().UTC().Add(-24 * time.Hour)
+
+	purged, err := NewSQLOutboxStore(db, "").PurgePublished(context.Background(), before)
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(7), purged)
+	assert.Equal(t, []string{sqlPurgePublished}, fake.queries())
+	assert.Equal(t, []driver.Value{before}, fake.statement(0).args)
+}
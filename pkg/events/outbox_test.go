@@ -0,0 +1,103 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// memoryOutbox keeps rows in insertion order, like ORDER BY created_at.
+type memoryOutbox struct {
+	records   []OutboxRecord
+	published map[string]bool
+}
+
+func (s *memoryOutbox) Enqueue(ctx context.Context, subject string, event *Event) error {
+	payload, err := event.ToJSON()
+	if err != nil {
+		return err
+	}
+	s.records = append(s.records, OutboxRecord{ID: event.ID, Subject: subject, Payload: payload})
+	return nil
+}
+
+func (s *memoryOutbox) Dispatch(ctx context.Context, limit, maxAttempts int, publish func(ctx context.Context, record OutboxRecord) error) (int, error) {
+	published := 0
+	for i := range s.records {
+		record := &s.records[i]
+		if s.published[record.ID] || record.Attempts >= maxAttempts || limit == 0 {
+			continue
+		}
+		limit--
+
+		record.Attempts++
+		if err := publish(ctx, *record); err == nil {
+			s.published[record.ID] = true
+			published++
+		}
+	}
+	return published, nil
+}
+
+func (s *memoryOutbox) PurgePublished(ctx context.Context, before time.Time) (int64, error) {
+	return 0, nil
+}
+
+func TestOutboxRelay_Flush_PublishesEnqueuedEvents(t *testing.T) {
+	store := &memoryOutbox{published: map[string]bool{}}
+	event := NewEvent("purchase_order.received", "execution-service", "org-1", map[string]interface{}{"po_id": "po-1"})
+	require.NoError(t, NewOutboxPublisher(store).Publish(context.Background(), "execution.purchase_orders", event))
+
+	publisher := new(PublisherMock)
+	publisher.On("Publish", mock.Anything, "execution.purchase_orders", mock.MatchedBy(func(e *Event) bool {
+		return e.ID == event.ID && e.Data["po_id"] == "po-1"
+	})).Return(nil).Once()
+
+	published, err := NewOutboxRelay(store, publisher, OutboxRelayConfig{}).Flush(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, published)
+	publisher.AssertExpectations(t)
+}
+
+func TestOutboxRelay_Flush_RetriesFailedRowsUpToMaxAttempts(t *testing.T) {
+	store := &memoryOutbox{published: map[string]bool{}}
+	event := NewEvent("purchase_order.received", "execution-service", "org-1", nil)
+	require.NoError(t, store.Enqueue(context.Background(), "execution.purchase_orders", event))
+
+	publisher := new(PublisherMock)
+	publisher.On("Publish", mock.Anything, mock.Anything, mock.Anything).Return(errors.New("nats down")).Twice()
+	relay := NewOutboxRelay(store, publisher, OutboxRelayConfig{MaxAttempts: 2})
+
+	for i := 0; i < 3; i++ {
+		published, err := relay.Flush(context.Background())
+		require.NoError(t, err)
+		assert.Zero(t, published)
+	}
+
+	assert.Equal(t, 2, store.records[0].Attempts)
+	publisher.AssertExpectations(t)
+}
+
+func TestOutboxRelay_Flush_BoundsEachPublishWithPublishTimeout(t *testing.T) {
+	store := &memoryOutbox{published: map[string]bool{}}
+	event := NewEvent("purchase_order.received", "execution-service", "org-1", nil)
+	require.NoError(t, store.Enqueue(context.Background(), "execution.purchase_orders", event))
+
+	publisher := new(PublisherMock)
+	publisher.On("Publish", mock.MatchedBy(func(ctx context.Context) bool {
+		deadline, ok := ctx.Deadline()
+		return ok && time.Until(deadline) <= 50*time.Millisecond
+	}), mock.Anything, mock.Anything).Return(nil).Once()
+
+	published, err := NewOutboxRelay(store, publisher, OutboxRelayConfig{PublishTimeout: 50 * time.Millisecond}).Flush(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, published)
+	publisher.AssertExpectations(t)
+}
@@ -0,0 +1,158 @@
+package events
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nats-io/nats.go"
+)
+
+const (
+	contentEncodingHeader = "Content-Encoding"
+	claimCheckHeader      = "Giia-Claim-Check"
+	gzipEncoding          = "gzip"
+
+	// DefaultCompressionThreshold leaves small events uncompressed, where
+	// gzip saves little and costs CPU on every consumer.
+	DefaultCompressionThreshold = 64 * 1024
+	// DefaultClaimCheckThreshold stays below the 1MB default NATS max_payload.
+	DefaultClaimCheckThreshold = 900 * 1024
+)
+
+// PayloadStore holds event bodies too large to travel in a NATS message.
+// Entries must outlive the slowest consumer; expiry is left to the store.
+type PayloadStore interface {
+	Put(ctx context.Context, key string, data []byte) error
+	Get(ctx context.Context, key string) ([]byte, error)
+}
+
+// NATSPayloadStore keeps claim-checked payloads in a JetStream object store
+// bucket whose objects expire after the configured TTL.
+type NATSPayloadStore struct {
+	store nats.ObjectStore
+}
+
+func NewNATSPayloadStore(nc *nats.Conn, bucket string, ttl time.Duration) (*NATSPayloadStore, error) {
+	js, err := nc.JetStream()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create JetStream context: %w", err)
+	}
+
+	store, err := js.ObjectStore(bucket)
+	if errors.Is(err, nats.ErrStreamNotFound) || errors.Is(err, nats.ErrBucketNotFound) {
+		store, err = js.CreateObjectStore(&nats.ObjectStoreConfig{
+			Bucket:      bucket,
+			Description: "Large event payloads referenced by claim checks",
+			TTL:         ttl,
+		})
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to open object store %s: %w", bucket, err)
+	}
+
+	return &NATSPayloadStore{store: store}, nil
+}
+
+func (s *NATSPayloadStore) Put(ctx context.Context, key string, data []byte) error {
+	if _, err := s.store.PutBytes(key, data); err != nil {
+		return fmt.Errorf("failed to store payload %s: %w", key, err)
+	}
+	return nil
+}
+
+func (s *NATSPayloadStore) Get(ctx context.Context, key string) ([]byte, error) {
+	data, err := s.store.GetBytes(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load payload %s: %w", key, err)
+	}
+	return data, nil
+}
+
+// payloadCodec turns serialized events into NATS messages and back. The zero
+// value sends bodies as-is, which keeps the wire format unchanged for
+// publishers that do not opt in.
+type payloadCodec struct {
+	compressionThreshold int
+	store                PayloadStore
+	claimCheckThreshold  int
+}
+
+func (c payloadCodec) encode(ctx context.Context, subject string, data []byte) (*nats.Msg, error) {
+	msg := nats.NewMsg(subject)
+
+	if c.compressionThreshold > 0 && len(data) >= c.compressionThreshold {
+		compressed, err := gzipBytes(data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to compress event: %w", err)
+		}
+		data = compressed
+		msg.Header.Set(contentEncodingHeader, gzipEncoding)
+	}
+
+	if c.store != nil && len(data) >= c.claimCheckThreshold {
+		key := uuid.New().String()
+		if err := c.store.Put(ctx, key, data); err != nil {
+			return nil, err
+		}
+		msg.Header.Set(claimCheckHeader, key)
+		data = nil
+	}
+
+	msg.Data = data
+	return msg, nil
+}
+
+// decodePayload returns the serialized event carried by msg, fetching
+// claim-checked bodies from store. Messages without headers pass through.
+func decodePayload(ctx context.Context, store PayloadStore, msg *nats.Msg) ([]byte, error) {
+	data := msg.Data
+
+	if key := msg.Header.Get(claimCheckHeader); key != "" {
+		if store == nil {
+			return nil, fmt.Errorf("message references payload %s but no payload store is configured", key)
+		}
+		stored, err := store.Get(ctx, key)
+		if err != nil {
+			return nil, err
+		}
+		data = stored
+	}
+
+	if msg.Header.Get(contentEncodingHeader) == gzipEncoding {
+		decompressed, err := gunzipBytes(data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decompress event: %w", err)
+		}
+		data = decompressed
+	}
+
+	return data, nil
+}
+
+func gzipBytes(data []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	if _, err := w.Write(data); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func gunzipBytes(data []byte) ([]byte, error) {
+	r, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	return io.ReadAll(r)
+}
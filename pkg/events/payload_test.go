@@ -0,0 +1,189 @@
+package events
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/nats-io/nats.go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// memoryPayloadStore stands in for the object store bucket. Deleting a key
+// simulates an object that expired before the consumer read it.
+type memoryPayloadStore struct {
+	objects map[string][]byte
+	putErr  error
+}
+
+func newMemoryPayloadStore() *memoryPayloadStore {
+	return &memoryPayloadStore{objects: make(map[string][]byte)}
+}
+
+func (s *memoryPayloadStore) Put(ctx context.Context, key string, data []byte) error {
+	if s.putErr != nil {
+		return s.putErr
+	}
+	s.objects[key] = data
+	return nil
+}
+
+func (s *memoryPayloadStore) Get(ctx context.Context, key string) ([]byte, error) {
+	data, ok := s.objects[key]
+	if !ok {
+		return nil, errors.New("object not found")
+	}
+	return data, nil
+}
+
+func TestPayloadCodec_RoundTrip(t *testing.T) {
+	small := []byte(`{"id":"evt-1"}`)
+	large := bytes.Repeat([]byte(`{"sku":"A-100","qty":1}`), 100)
+
+	tests := []struct {
+		name           string
+		codec          func(store PayloadStore) payloadCodec
+		data           []byte
+		wantCompressed bool
+		wantClaimCheck bool
+	}{
+		{
+			name:  "zero codec sends bodies as-is",
+			codec: func(PayloadStore) payloadCodec { return payloadCodec{} },
+			data:  large,
+		},
+		{
+			name:  "body below the compression threshold is not compressed",
+			codec: func(PayloadStore) payloadCodec { return payloadCodec{compressionThreshold: 1024} },
+			data:  small,
+		},
+		{
+			name:           "body at the compression threshold is gzipped",
+			codec:          func(PayloadStore) payloadCodec { return payloadCodec{compressionThreshold: len(large)} },
+			data:           large,
+			wantCompressed: true,
+		},
+		{
+			name: "body below the claim-check threshold travels inline",
+			codec: func(store PayloadStore) payloadCodec {
+				return payloadCodec{store: store, claimCheckThreshold: len(large) + 1}
+			},
+			data: large,
+		},
+		{
+			name: "body at the claim-check threshold is stored",
+			codec: func(store PayloadStore) payloadCodec {
+				return payloadCodec{store: store, claimCheckThreshold: len(large)}
+			},
+			data:           large,
+			wantClaimCheck: true,
+		},
+		{
+			name: "claim-check threshold applies to the compressed size",
+			codec: func(store PayloadStore) payloadCodec {
+				return payloadCodec{compressionThreshold: 1, store: store, claimCheckThreshold: len(large)}
+			},
+			data:           large,
+			wantCompressed: true,
+		},
+		{
+			name: "compressed body above the claim-check threshold is stored compressed",
+			codec: func(store PayloadStore) payloadCodec {
+				return payloadCodec{compressionThreshold: 1, store: store, claimCheckThreshold: 1}
+			},
+			data:           large,
+			wantCompressed: true,
+			wantClaimCheck: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := newMemoryPayloadStore()
+
+			msg, err := tt.codec(store).encode(context.Background(), "ddmrp.buffers", tt.data)
+			require.NoError(t, err)
+
+			assert.Equal(t, "ddmrp.buffers", msg.Subject)
+			assert.Equal(t, tt.wantCompressed, msg.Header.Get(contentEncodingHeader) == gzipEncoding)
+			key := msg.Header.Get(claimCheckHeader)
+			assert.Equal(t, tt.wantClaimCheck, key != "")
+			if tt.wantClaimCheck {
+				assert.Empty(t, msg.Data)
+				assert.Contains(t, store.objects, key)
+			} else {
+				assert.Empty(t, store.objects)
+			}
+
+			decoded, err := decodePayload(context.Background(), store, msg)
+			require.NoError(t, err)
+			assert.Equal(t, tt.data, decoded)
+		})
+	}
+}
+
+func TestPayloadCodec_Encode_WithStoreError_ReturnsError(t *testing.T) {
+	store := newMemoryPayloadStore()
+	store.putErr = errors.New("bucket unavailable")
+	codec := payloadCodec{store: store, claimCheckThreshold: 1}
+
+	msg, err := codec.encode(context.Background(), "ddmrp.buffers", []byte(`{"id":"evt-1"}`))
+
+	assert.Nil(t, msg)
+	assert.ErrorIs(t, err, store.putErr)
+}
+
+func TestDecodePayload_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		store   PayloadStore
+		headers map[string]string
+		data    []byte
+		wantErr string
+	}{
+		{
+			name:    "claim check without a payload store",
+			headers: map[string]string{claimCheckHeader: "key-1"},
+			wantErr: "no payload store is configured",
+		},
+		{
+			name:    "claim check for an expired object",
+			store:   newMemoryPayloadStore(),
+			headers: map[string]string{claimCheckHeader: "key-1"},
+			wantErr: "object not found",
+		},
+		{
+			name:    "gzip header on a body that is not gzipped",
+			headers: map[string]string{contentEncodingHeader: gzipEncoding},
+			data:    []byte(`{"id":"evt-1"}`),
+			wantErr: "failed to decompress event",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg := nats.NewMsg("ddmrp.buffers")
+			for key, value := range tt.headers {
+				msg.Header.Set(key, value)
+			}
+			msg.Data = tt.data
+
+			data, err := decodePayload(context.Background(), tt.store, msg)
+
+			assert.Nil(t, data)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestDecodePayload_WithoutHeaders_PassesBodyThrough(t *testing.T) {
+	msg := &nats.Msg{Subject: "ddmrp.buffers", Data: []byte(`{"id":"evt-1"}`)}
+
+	data, err := decodePayload(context.Background(), nil, msg)
+
+	require.NoError(t, err)
+	assert.Equal(t, msg.Data, data)
+}
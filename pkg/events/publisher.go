@@ -6,6 +6,8 @@ import (
 	"time"
 
 	"github.com/nats-io/nats.go"
+
+	"github.com/giia/giia-core-engine/pkg/redact"
 )
 
 type Publisher interface {
@@ -15,30 +17,90 @@ type Publisher interface {
 }
 
 type NATSPublisher struct {
-	js   nats.JetStreamContext
-	conn *nats.Conn
+	js       nats.JetStreamContext
+	conn     *nats.Conn
+	redactor *redact.Redactor
+	codec    payloadCodec
+}
+
+type PublisherOption func(*NATSPublisher)
+
+// WithRedactor masks sensitive fields in Event.Data before publishing. Only
+// use it on subjects whose consumers do not need the masked values.
+func WithRedactor(redactor *redact.Redactor) PublisherOption {
+	return func(p *NATSPublisher) {
+		p.redactor = redactor
+	}
+}
+
+// WithCompression gzips events whose JSON is at least threshold bytes (0
+// uses DefaultCompressionThreshold). Subscribers decompress transparently.
+func WithCompression(threshold int) PublisherOption {
+	return func(p *NATSPublisher) {
+		if threshold <= 0 {
+			threshold = DefaultCompressionThreshold
+		}
+		p.codec.compressionThreshold = threshold
+	}
 }
 
-func NewPublisher(nc *nats.Conn) (*NATSPublisher, error) {
+// WithClaimCheck offloads events still at least threshold bytes after
+// compression to store and publishes only a reference (0 uses
+// DefaultClaimCheckThreshold). Subscribers need the same store.
+func WithClaimCheck(store PayloadStore, threshold int) PublisherOption {
+	return func(p *NATSPublisher) {
+		if threshold <= 0 {
+			threshold = DefaultClaimCheckThreshold
+		}
+		p.codec.store = store
+		p.codec.claimCheckThreshold = threshold
+	}
+}
+
+func NewPublisher(nc *nats.Conn, opts ...PublisherOption) (*NATSPublisher, error) {
 	js, err := nc.JetStream()
 	if err != nil {
 		return nil, fmt.Errorf("failed to create JetStream context: %w", err)
 	}
 
-	return &NATSPublisher{
+	p := &NATSPublisher{
 		js:   js,
 		conn: nc,
-	}, nil
+	}
+	for _, opt := range opts {
+		opt(p)
+	}
+
+	return p, nil
+}
+
+func (p *NATSPublisher) serialize(event *Event) ([]byte, error) {
+	if p.redactor == nil {
+		return event.ToJSON()
+	}
+
+	redacted := *event
+	redacted.Data = p.redactor.Map(event.Data)
+	return redacted.ToJSON()
+}
+
+func (p *NATSPublisher) encode(ctx context.Context, subject string, event *Event) (*nats.Msg, error) {
+	data, err := p.serialize(event)
+	if err != nil {
+		return nil, fmt.Errorf("failed to serialize event: %w", err)
+	}
+
+	return p.codec.encode(ctx, subject, data)
 }
 
 func (p *NATSPublisher) Publish(ctx context.Context, subject string, event *Event) error {
-	data, err := event.ToJSON()
+	msg, err := p.encode(ctx, subject, event)
 	if err != nil {
-		return fmt.Errorf("failed to serialize event: %w", err)
+		return err
 	}
 
 	err = retryPublish(ctx, func() error {
-		_, err := p.js.Publish(subject, data)
+		_, err := p.js.PublishMsg(msg)
 		return err
 	})
 
@@ -50,12 +112,12 @@ func (p *NATSPublisher) Publish(ctx context.Context, subject string, event *Even
 }
 
 func (p *NATSPublisher) PublishAsync(ctx context.Context, subject string, event *Event) error {
-	data, err := event.ToJSON()
+	msg, err := p.encode(ctx, subject, event)
 	if err != nil {
-		return fmt.Errorf("failed to serialize event: %w", err)
+		return err
 	}
 
-	_, err = p.js.PublishAsync(subject, data)
+	_, err = p.js.PublishMsgAsync(msg)
 	if err != nil {
 		return fmt.Errorf("failed to publish event async: %w", err)
 	}
@@ -0,0 +1,181 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nats-io/nats.go"
+
+	pkgLogger "github.com/giia/giia-core-engine/pkg/logger"
+)
+
+const (
+	requestIDHeader = "X-Request-Id"
+	errorHeader     = "Giia-Error"
+
+	DefaultRequestTimeout = 5 * time.Second
+)
+
+var (
+	ErrNoResponders   = errors.New("no responders available for request")
+	ErrRequestTimeout = errors.New("request timed out")
+)
+
+// RemoteError is returned by Request when the responder's handler failed.
+type RemoteError struct {
+	Subject string
+	Message string
+}
+
+func (e *RemoteError) Error() string {
+	return fmt.Sprintf("request to %s failed: %s", e.Subject, e.Message)
+}
+
+type Requester interface {
+	Request(ctx context.Context, subject string, req, resp interface{}) error
+}
+
+// NATSRequester performs synchronous queries over core NATS request-reply.
+// Nothing is persisted: if no instance is listening the request fails fast
+// with ErrNoResponders.
+type NATSRequester struct {
+	conn    *nats.Conn
+	timeout time.Duration
+}
+
+type RequesterOption func(*NATSRequester)
+
+// WithRequestTimeout bounds requests whose context has no earlier deadline.
+func WithRequestTimeout(timeout time.Duration) RequesterOption {
+	return func(r *NATSRequester) {
+		r.timeout = timeout
+	}
+}
+
+func NewRequester(nc *nats.Conn, opts ...RequesterOption) *NATSRequester {
+	r := &NATSRequester{
+		conn:    nc,
+		timeout: DefaultRequestTimeout,
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	return r
+}
+
+// Request sends req as JSON to subject and decodes the reply into resp. The
+// request ID in ctx (see pkg/logger) travels in a header so both sides log
+// under the same ID; a new one is generated when ctx has none.
+func (r *NATSRequester) Request(ctx context.Context, subject string, req, resp interface{}) error {
+	data, err := json.Marshal(req)
+	if err != nil {
+		return fmt.Errorf("failed to serialize request: %w", err)
+	}
+
+	requestID := pkgLogger.ExtractRequestID(ctx)
+	if requestID == "" {
+		requestID = uuid.New().String()
+	}
+
+	msg := nats.NewMsg(subject)
+	msg.Header.Set(requestIDHeader, requestID)
+	msg.Data = data
+
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	reply, err := r.conn.RequestMsgWithContext(ctx, msg)
+	switch {
+	case errors.Is(err, nats.ErrNoResponders):
+		return fmt.Errorf("%w on subject %s", ErrNoResponders, subject)
+	case errors.Is(err, context.DeadlineExceeded), errors.Is(err, nats.ErrTimeout):
+		return fmt.Errorf("%w on subject %s", ErrRequestTimeout, subject)
+	case err != nil:
+		return fmt.Errorf("failed to send request to subject %s: %w", subject, err)
+	}
+
+	if message := reply.Header.Get(errorHeader); message != "" {
+		return &RemoteError{Subject: subject, Message: message}
+	}
+
+	if resp == nil {
+		return nil
+	}
+	if err := json.Unmarshal(reply.Data, resp); err != nil {
+		return fmt.Errorf("failed to deserialize reply from subject %s: %w", subject, err)
+	}
+
+	return nil
+}
+
+// RequestHandler answers a request. The returned value is sent back as JSON;
+// a returned error is sent as a RemoteError.
+type RequestHandler func(ctx context.Context, data []byte) (interface{}, error)
+
+// Typed adapts a handler with concrete request and reply types.
+func Typed[Req, Resp any](handler func(ctx context.Context, req *Req) (*Resp, error)) RequestHandler {
+	return func(ctx context.Context, data []byte) (interface{}, error) {
+		var req Req
+		if err := json.Unmarshal(data, &req); err != nil {
+			return nil, fmt.Errorf("invalid request payload: %w", err)
+		}
+		return handler(ctx, &req)
+	}
+}
+
+// Responder serves requests sent with a Requester.
+type Responder struct {
+	conn *nats.Conn
+	subs []*nats.Subscription
+}
+
+func NewResponder(nc *nats.Conn) *Responder {
+	return &Responder{
+		conn: nc,
+		subs: make([]*nats.Subscription, 0),
+	}
+}
+
+// Handle answers requests on subject. Replicas using the same queue split the
+// requests between them so each one is answered exactly once.
+func (r *Responder) Handle(ctx context.Context, subject, queue string, handler RequestHandler) error {
+	sub, err := r.conn.QueueSubscribe(subject, queue, func(msg *nats.Msg) {
+		reqCtx := ctx
+		if requestID := msg.Header.Get(requestIDHeader); requestID != "" {
+			reqCtx = pkgLogger.WithRequestID(ctx, requestID)
+		}
+
+		reply := nats.NewMsg(msg.Reply)
+		result, err := handler(reqCtx, msg.Data)
+		if err == nil {
+			reply.Data, err = json.Marshal(result)
+		}
+		if err != nil {
+			reply.Header.Set(errorHeader, err.Error())
+			reply.Data = nil
+		}
+
+		_ = msg.RespondMsg(reply)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to handle requests on subject %s: %w", subject, err)
+	}
+
+	r.subs = append(r.subs, sub)
+	return nil
+}
+
+func (r *Responder) Close() error {
+	for _, sub := range r.subs {
+		if err := sub.Drain(); err != nil {
+			return fmt.Errorf("failed to drain subscription: %w", err)
+		}
+	}
+
+	return nil
+}
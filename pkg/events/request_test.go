@@ -0,0 +1,183 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	pkgLogger "github.com/giia/giia-core-engine/pkg/logger"
+)
+
+type bufferQuery struct {
+	ProductID string `json:"product_id"`
+}
+
+type bufferReply struct {
+	ProductID string `json:"product_id"`
+	Zone      string `json:"zone"`
+}
+
+// startResponder serves handler on subject and waits until the server has
+// registered the subscription.
+func startResponder(t *testing.T, server *fakeNATSServer, subject string, handler RequestHandler) {
+	nc := server.connect(t)
+	require.NoError(t, NewResponder(nc).Handle(context.Background(), subject, "ddmrp-engine", handler))
+	require.NoError(t, nc.Flush())
+}
+
+func TestNATSRequester_Request(t *testing.T) {
+	tests := []struct {
+		name      string
+		handler   RequestHandler
+		timeout   time.Duration
+		wantReply *bufferReply
+		wantErr   error
+		wantMsg   string
+	}{
+		{
+			name: "decodes the reply",
+			handler: Typed(func(ctx context.Context, req *bufferQuery) (*bufferReply, error) {
+				return &bufferReply{ProductID: req.ProductID, Zone: "green"}, nil
+			}),
+			wantReply: &bufferReply{ProductID: "sku-1", Zone: "green"},
+		},
+		{
+			name: "returns the handler error as a remote error",
+			handler: func(ctx context.Context, data []byte) (interface{}, error) {
+				return nil, errors.New("buffer not found")
+			},
+			wantMsg: "request to ddmrp.buffers.get failed: buffer not found",
+		},
+		{
+			name: "returns the decode error of a typed handler as a remote error",
+			handler: Typed(func(ctx context.Context, req *struct {
+				ProductID int `json:"product_id"`
+			}) (*bufferReply, error) {
+				return &bufferReply{}, nil
+			}),
+			wantMsg: "invalid request payload",
+		},
+		{
+			name: "times out on a slow responder",
+			handler: func(ctx context.Context, data []byte) (interface{}, error) {
+				time.Sleep(200 * time.Millisecond)
+				return &bufferReply{}, nil
+			},
+			timeout: 50 * time.Millisecond,
+			wantErr: ErrRequestTimeout,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := startFakeNATS(t)
+			startResponder(t, server, "ddmrp.buffers.get", tt.handler)
+
+			var opts []RequesterOption
+			if tt.timeout > 0 {
+				opts = append(opts, WithRequestTimeout(tt.timeout))
+			}
+			requester := NewRequester(server.connect(t), opts...)
+
+			var reply bufferReply
+			err := requester.Request(context.Background(), "ddmrp.buffers.get", &bufferQuery{ProductID: "sku-1"}, &reply)
+
+			switch {
+			case tt.wantErr != nil:
+				assert.ErrorIs(t, err, tt.wantErr)
+			case tt.wantMsg != "":
+				var remote *RemoteError
+				require.ErrorAs(t, err, &remote)
+				assert.Equal(t, "ddmrp.buffers.get", remote.Subject)
+				assert.Contains(t, remote.Error(), tt.wantMsg)
+			default:
+				require.NoError(t, err)
+				assert.Equal(t, *tt.wantReply, reply)
+			}
+		})
+	}
+}
+
+func TestNATSRequester_Request_WithoutResponders_ReturnsErrNoResponders(t *testing.T) {
+	server := startFakeNATS(t)
+
+	err := NewRequester(server.connect(t)).Request(context.Background(), "ddmrp.buffers.get", &bufferQuery{}, nil)
+
+	assert.ErrorIs(t, err, ErrNoResponders)
+	assert.Contains(t, err.Error(), "ddmrp.buffers.get")
+}
+
+func TestNATSRequester_Request_PropagatesRequestID(t *testing.T) {
+	tests := []struct {
+		name      string
+		requestID string
+	}{
+		{name: "forwards the request ID from the context", requestID: "req-123"},
+		{name: "generates a request ID when the context has none"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := startFakeNATS(t)
+			received := make(chan string, 1)
+			startResponder(t, server, "ddmrp.buffers.get", func(ctx context.Context, data []byte) (interface{}, error) {
+				received <- pkgLogger.ExtractRequestID(ctx)
+				return struct{}{}, nil
+			})
+
+			ctx := context.Background()
+			if tt.requestID != "" {
+				ctx = pkgLogger.WithRequestID(ctx, tt.requestID)
+			}
+			require.NoError(t, NewRequester(server.connect(t)).Request(ctx, "ddmrp.buffers.get", &bufferQuery{}, nil))
+
+			requestID := <-received
+			if tt.requestID != "" {
+				assert.Equal(t, tt.requestID, requestID)
+			} else {
+				assert.NotEmpty(t, requestID)
+			}
+		})
+	}
+}
+
+func TestResponder_Handle_WithSharedQueue_AnswersEachRequestOnce(t *testing.T) {
+	server := startFakeNATS(t)
+	var calls atomic.Int32
+	handler := func(ctx context.Context, data []byte) (interface{}, error) {
+		calls.Add(1)
+		return struct{}{}, nil
+	}
+	startResponder(t, server, "ddmrp.buffers.get", handler)
+	startResponder(t, server, "ddmrp.buffers.get", handler)
+
+	requester := NewRequester(server.connect(t))
+	for i := 0; i < 3; i++ {
+		require.NoError(t, requester.Request(context.Background(), "ddmrp.buffers.get", &bufferQuery{}, nil))
+	}
+
+	assert.Equal(t, int32(3), calls.Load())
+}
+
+func TestResponder_Close_StopsAnswering(t *testing.T) {
+	server := startFakeNATS(t)
+	nc := server.connect(t)
+	responder := NewResponder(nc)
+	require.NoError(t, responder.Handle(context.Background(), "ddmrp.buffers.get", "ddmrp-engine", func(ctx context.Context, data []byte) (interface{}, error) {
+		return struct{}{}, nil
+	}))
+
+	require.NoError(t, responder.Close())
+	require.Eventually(t, func() bool {
+		return nc.NumSubscriptions() == 0
+	}, time.Second, 10*time.Millisecond)
+	require.NoError(t, nc.Flush())
+
+	err := NewRequester(server.connect(t)).Request(context.Background(), "ddmrp.buffers.get", &bufferQuery{}, nil)
+	assert.ErrorIs(t, err, ErrNoResponders)
+}
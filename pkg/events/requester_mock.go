@@ -0,0 +1,16 @@
+package events
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type RequesterMock struct {
+	mock.Mock
+}
+
+func (m *RequesterMock) Request(ctx context.Context, subject string, req, resp interface{}) error {
+	args := m.Called(ctx, subject, req, resp)
+	return args.Error(0)
+}
@@ -0,0 +1,185 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/nats-io/nats.go"
+)
+
+// ReconcileAction reports what EnsureStream or EnsureConsumer did.
+type ReconcileAction string
+
+const (
+	ReconcileCreated   ReconcileAction = "created"
+	ReconcileUpdated   ReconcileAction = "updated"
+	ReconcileUnchanged ReconcileAction = "unchanged"
+)
+
+// StreamConfig declares a JetStream stream owned by a service. Zero values
+// mean limits retention, file storage, one replica and no age or size limit.
+type StreamConfig struct {
+	Name       string
+	Subjects   []string
+	Retention  nats.RetentionPolicy
+	Storage    nats.StorageType
+	Replicas   int
+	MaxAge     time.Duration
+	MaxBytes   int64
+	Duplicates time.Duration
+}
+
+func (c StreamConfig) toNATS() *nats.StreamConfig {
+	replicas := c.Replicas
+	if replicas <= 0 {
+		replicas = 1
+	}
+
+	maxBytes := c.MaxBytes
+	if maxBytes <= 0 {
+		maxBytes = -1
+	}
+
+	return &nats.StreamConfig{
+		Name:       c.Name,
+		Subjects:   c.Subjects,
+		Retention:  c.Retention,
+		Storage:    c.Storage,
+		Replicas:   replicas,
+		MaxAge:     c.MaxAge,
+		MaxBytes:   maxBytes,
+		Duplicates: c.Duplicates,
+	}
+}
+
+// StreamManager creates and updates streams and consumers from their
+// declared config, so services own their JetStream setup in code.
+type StreamManager struct {
+	js nats.JetStreamContext
+}
+
+func NewStreamManager(nc *nats.Conn) (*StreamManager, error) {
+	js, err := nc.JetStream()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create JetStream context: %w", err)
+	}
+
+	return &StreamManager{js: js}, nil
+}
+
+// EnsureStreams reconciles every stream in order and stops at the first
+// failure.
+func (m *StreamManager) EnsureStreams(ctx context.Context, configs ...StreamConfig) error {
+	for _, config := range configs {
+		if _, err := m.EnsureStream(ctx, config); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// EnsureStream creates the stream if it is missing and updates it when the
+// declared subjects or limits differ. Retention and storage cannot change on
+// a live stream, so a mismatch there is an error rather than a silent
+// recreate that would drop messages.
+func (m *StreamManager) EnsureStream(ctx context.Context, config StreamConfig) (ReconcileAction, error) {
+	if config.Name == "" {
+		return "", errors.New("stream name is required")
+	}
+
+	desired := config.toNATS()
+
+	info, err := m.js.StreamInfo(config.Name, nats.Context(ctx))
+	if errors.Is(err, nats.ErrStreamNotFound) {
+		if _, err := m.js.AddStream(desired, nats.Context(ctx)); err != nil {
+			return "", fmt.Errorf("failed to create stream %s: %w", config.Name, err)
+		}
+		return ReconcileCreated, nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to get stream %s: %w", config.Name, err)
+	}
+
+	current := info.Config
+	if current.Retention != desired.Retention {
+		return "", fmt.Errorf("stream %s has retention %s, declared %s; recreate it to change", config.Name, current.Retention, desired.Retention)
+	}
+	if current.Storage != desired.Storage {
+		return "", fmt.Errorf("stream %s has storage %s, declared %s; recreate it to change", config.Name, current.Storage, desired.Storage)
+	}
+
+	if streamMatches(current, *desired) {
+		return ReconcileUnchanged, nil
+	}
+
+	// Start from the live config so server-side settings we do not declare
+	// are kept
+	current.Subjects = desired.Subjects
+	current.Replicas = desired.Replicas
+	current.MaxAge = desired.MaxAge
+	current.MaxBytes = desired.MaxBytes
+	if desired.Duplicates > 0 {
+		current.Duplicates = desired.Duplicates
+	}
+
+	if _, err := m.js.UpdateStream(&current, nats.Context(ctx)); err != nil {
+		return "", fmt.Errorf("failed to update stream %s: %w", config.Name, err)
+	}
+	return ReconcileUpdated, nil
+}
+
+func streamMatches(current, desired nats.StreamConfig) bool {
+	currentSubjects := slices.Clone(current.Subjects)
+	desiredSubjects := slices.Clone(desired.Subjects)
+	slices.Sort(currentSubjects)
+	slices.Sort(desiredSubjects)
+
+	return slices.Equal(currentSubjects, desiredSubjects) &&
+		current.Replicas == desired.Replicas &&
+		current.MaxAge == desired.MaxAge &&
+		current.MaxBytes == desired.MaxBytes &&
+		(desired.Duplicates == 0 || current.Duplicates == desired.Duplicates)
+}
+
+// EnsureConsumer creates a durable consumer on stream if it is missing and
+// updates it when one of the editable settings differs. The server rejects
+// changes to immutable settings such as the deliver policy.
+func (m *StreamManager) EnsureConsumer(ctx context.Context, stream string, config *nats.ConsumerConfig) (ReconcileAction, error) {
+	if config.Durable == "" {
+		return "", errors.New("consumer durable name is required")
+	}
+
+	info, err := m.js.ConsumerInfo(stream, config.Durable, nats.Context(ctx))
+	if errors.Is(err, nats.ErrConsumerNotFound) {
+		if _, err := m.js.AddConsumer(stream, config, nats.Context(ctx)); err != nil {
+			return "", fmt.Errorf("failed to create consumer %s on stream %s: %w", config.Durable, stream, err)
+		}
+		return ReconcileCreated, nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to get consumer %s on stream %s: %w", config.Durable, stream, err)
+	}
+
+	if consumerMatches(info.Config, *config) {
+		return ReconcileUnchanged, nil
+	}
+
+	if _, err := m.js.UpdateConsumer(stream, config, nats.Context(ctx)); err != nil {
+		return "", fmt.Errorf("failed to update consumer %s on stream %s: %w", config.Durable, stream, err)
+	}
+	return ReconcileUpdated, nil
+}
+
+// consumerMatches compares the settings a consumer update may change. Zero
+// values in the declaration leave the server default in place.
+func consumerMatches(current, desired nats.ConsumerConfig) bool {
+	return current.FilterSubject == desired.FilterSubject &&
+		current.Description == desired.Description &&
+		(desired.AckWait == 0 || current.AckWait == desired.AckWait) &&
+		(desired.MaxDeliver == 0 || current.MaxDeliver == desired.MaxDeliver) &&
+		(desired.MaxAckPending == 0 || current.MaxAckPending == desired.MaxAckPending) &&
+		slices.Equal(current.BackOff, desired.BackOff)
+}
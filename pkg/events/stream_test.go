@@ -0,0 +1,333 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeJetStream keeps stream and consumer configs in memory and records the
+// management calls EnsureStream and EnsureConsumer make. Methods it does not
+// override panic through the nil embedded interface.
+type fakeJetStream struct {
+	nats.JetStreamContext
+
+	streams   map[string]nats.StreamConfig
+	consumers map[string]nats.ConsumerConfig
+	calls     []string
+}
+
+func newFakeJetStream() *fakeJetStream {
+	return &fakeJetStream{
+		streams:   make(map[string]nats.StreamConfig),
+		consumers: make(map[string]nats.ConsumerConfig),
+	}
+}
+
+func (f *fakeJetStream) StreamInfo(name string, opts ...nats.JSOpt) (*nats.StreamInfo, error) {
+	config, ok := f.streams[name]
+	if !ok {
+		return nil, nats.ErrStreamNotFound
+	}
+	return &nats.StreamInfo{Config: config}, nil
+}
+
+func (f *fakeJetStream) AddStream(config *nats.StreamConfig, opts ...nats.JSOpt) (*nats.StreamInfo, error) {
+	f.calls = append(f.calls, "AddStream")
+	f.streams[config.Name] = *config
+	return &nats.StreamInfo{Config: *config}, nil
+}
+
+func (f *fakeJetStream) UpdateStream(config *nats.StreamConfig, opts ...nats.JSOpt) (*nats.StreamInfo, error) {
+	f.calls = append(f.calls, "UpdateStream")
+	f.streams[config.Name] = *config
+	return &nats.StreamInfo{Config: *config}, nil
+}
+
+func (f *fakeJetStream) ConsumerInfo(stream, name string, opts ...nats.JSOpt) (*nats.ConsumerInfo, error) {
+	config, ok := f.consumers[stream+"/"+name]
+	if !ok {
+		return nil, nats.ErrConsumerNotFound
+	}
+	return &nats.ConsumerInfo{Config: config}, nil
+}
+
+func (f *fakeJetStream) AddConsumer(stream string, config *nats.ConsumerConfig, opts ...nats.JSOpt) (*nats.ConsumerInfo, error) {
+	f.calls = append(f.calls, "AddConsumer")
+	f.consumers[stream+"/"+config.Durable] = *config
+	return &nats.ConsumerInfo{Config: *config}, nil
+}
+
+func (f *fakeJetStream) UpdateConsumer(stream string, config *nats.ConsumerConfig, opts ...nats.JSOpt) (*nats.ConsumerInfo, error) {
+	f.calls = append(f.calls, "UpdateConsumer")
+	f.consumers[stream+"/"+config.Durable] = *config
+	return &nats.ConsumerInfo{Config: *config}, nil
+}
+
+func ddmrpStream() StreamConfig {
+	return StreamConfig{
+		Name:     "DDMRP",
+		Subjects: []string{"ddmrp.buffers.>", "ddmrp.adu.>"},
+		MaxAge:   7 * 24 * time.Hour,
+	}
+}
+
+func TestStreamManager_EnsureStream(t *testing.T) {
+	tests := []struct {
+		name       string
+		live       *nats.StreamConfig
+		declared   func(StreamConfig) StreamConfig
+		wantAction ReconcileAction
+		wantCalls  []string
+		wantErr    string
+	}{
+		{
+			name:       "creates a missing stream",
+			wantAction: ReconcileCreated,
+			wantCalls:  []string{"AddStream"},
+		},
+		{
+			name:       "leaves a matching stream alone",
+			live:       ddmrpStream().toNATS(),
+			wantAction: ReconcileUnchanged,
+		},
+		{
+			name: "ignores subject order",
+			live: func() *nats.StreamConfig {
+				live := ddmrpStream().toNATS()
+				live.Subjects = []string{"ddmrp.adu.>", "ddmrp.buffers.>"}
+				return live
+			}(),
+			wantAction: ReconcileUnchanged,
+		},
+		{
+			name: "keeps the server duplicate window when none is declared",
+			live: func() *nats.StreamConfig {
+				live := ddmrpStream().toNATS()
+				live.Duplicates = 2 * time.Minute
+				return live
+			}(),
+			wantAction: ReconcileUnchanged,
+		},
+		{
+			name: "updates changed subjects",
+			live: ddmrpStream().toNATS(),
+			declared: func(config StreamConfig) StreamConfig {
+				config.Subjects = append(config.Subjects, "ddmrp.zones.>")
+				return config
+			},
+			wantAction: ReconcileUpdated,
+			wantCalls:  []string{"UpdateStream"},
+		},
+		{
+			name: "updates changed limits",
+			live: ddmrpStream().toNATS(),
+			declared: func(config StreamConfig) StreamConfig {
+				config.MaxAge = 24 * time.Hour
+				config.MaxBytes = 1 << 30
+				config.Replicas = 3
+				return config
+			},
+			wantAction: ReconcileUpdated,
+			wantCalls:  []string{"UpdateStream"},
+		},
+		{
+			name: "refuses to change retention",
+			live: ddmrpStream().toNATS(),
+			declared: func(config StreamConfig) StreamConfig {
+				config.Retention = nats.WorkQueuePolicy
+				return config
+			},
+			wantErr: "recreate it to change",
+		},
+		{
+			name: "refuses to change storage",
+			live: ddmrpStream().toNATS(),
+			declared: func(config StreamConfig) StreamConfig {
+				config.Storage = nats.MemoryStorage
+				return config
+			},
+			wantErr: "recreate it to change",
+		},
+		{
+			name: "requires a name",
+			declared: func(config StreamConfig) StreamConfig {
+				config.Name = ""
+				return config
+			},
+			wantErr: "stream name is required",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			js := newFakeJetStream()
+			if tt.live != nil {
+				js.streams[tt.live.Name] = *tt.live
+			}
+			declared := ddmrpStream()
+			if tt.declared != nil {
+				declared = tt.declared(declared)
+			}
+
+			action, err := (&StreamManager{js: js}).EnsureStream(context.Background(), declared)
+
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				assert.Empty(t, js.calls)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantAction, action)
+			assert.Equal(t, tt.wantCalls, js.calls)
+			assert.ElementsMatch(t, declared.Subjects, js.streams[declared.Name].Subjects)
+		})
+	}
+}
+
+func TestStreamManager_EnsureStream_UpdateKeepsUndeclaredServerSettings(t *testing.T) {
+	js := newFakeJetStream()
+	live := ddmrpStream().toNATS()
+	live.Description = "set by an operator"
+	live.Duplicates = 2 * time.Minute
+	js.streams[live.Name] = *live
+	declared := ddmrpStream()
+	declared.MaxAge = 24 * time.Hour
+
+	action, err := (&StreamManager{js: js}).EnsureStream(context.Background(), declared)
+
+	require.NoError(t, err)
+	assert.Equal(t, ReconcileUpdated, action)
+	updated := js.streams[live.Name]
+	assert.Equal(t, 24*time.Hour, updated.MaxAge)
+	assert.Equal(t, "set by an operator", updated.Description)
+	assert.Equal(t, 2*time.Minute, updated.Duplicates)
+}
+
+func TestStreamManager_EnsureStreams_StopsAtFirstFailure(t *testing.T) {
+	js := newFakeJetStream()
+	invalid := StreamConfig{Subjects: []string{"orders.>"}}
+
+	err := (&StreamManager{js: js}).EnsureStreams(context.Background(), invalid, ddmrpStream())
+
+	require.Error(t, err)
+	assert.Empty(t, js.calls)
+}
+
+func bufferConsumer() *nats.ConsumerConfig {
+	return &nats.ConsumerConfig{
+		Durable:       "buffer-projector",
+		FilterSubject: "ddmrp.buffers.>",
+		AckPolicy:     nats.AckExplicitPolicy,
+		AckWait:       30 * time.Second,
+		MaxDeliver:    5,
+	}
+}
+
+func TestStreamManager_EnsureConsumer(t *testing.T) {
+	tests := []struct {
+		name       string
+		live       *nats.ConsumerConfig
+		declared   func(*nats.ConsumerConfig)
+		wantAction ReconcileAction
+		wantCalls  []string
+		wantErr    string
+	}{
+		{
+			name:       "creates a missing consumer",
+			wantAction: ReconcileCreated,
+			wantCalls:  []string{"AddConsumer"},
+		},
+		{
+			name:       "leaves a matching consumer alone",
+			live:       bufferConsumer(),
+			wantAction: ReconcileUnchanged,
+		},
+		{
+			name: "keeps server defaults for undeclared limits",
+			live: func() *nats.ConsumerConfig {
+				live := bufferConsumer()
+				live.MaxAckPending = 1000
+				return live
+			}(),
+			wantAction: ReconcileUnchanged,
+		},
+		{
+			name:       "updates a changed filter subject",
+			live:       bufferConsumer(),
+			declared:   func(config *nats.ConsumerConfig) { config.FilterSubject = "ddmrp.buffers.zone.>" },
+			wantAction: ReconcileUpdated,
+			wantCalls:  []string{"UpdateConsumer"},
+		},
+		{
+			name:       "updates a changed ack wait",
+			live:       bufferConsumer(),
+			declared:   func(config *nats.ConsumerConfig) { config.AckWait = time.Minute },
+			wantAction: ReconcileUpdated,
+			wantCalls:  []string{"UpdateConsumer"},
+		},
+		{
+			name:       "updates a changed backoff",
+			live:       bufferConsumer(),
+			declared:   func(config *nats.ConsumerConfig) { config.BackOff = []time.Duration{time.Second, 5 * time.Second} },
+			wantAction: ReconcileUpdated,
+			wantCalls:  []string{"UpdateConsumer"},
+		},
+		{
+			name:     "requires a durable name",
+			declared: func(config *nats.ConsumerConfig) { config.Durable = "" },
+			wantErr:  "consumer durable name is required",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			js := newFakeJetStream()
+			if tt.live != nil {
+				js.consumers["DDMRP/"+tt.live.Durable] = *tt.live
+			}
+			declared := bufferConsumer()
+			if tt.declared != nil {
+				tt.declared(declared)
+			}
+
+			action, err := (&StreamManager{js: js}).EnsureConsumer(context.Background(), "DDMRP", declared)
+
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				assert.Empty(t, js.calls)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantAction, action)
+			assert.Equal(t, tt.wantCalls, js.calls)
+		})
+	}
+}
+
+// failingConsumerInfo fails every consumer lookup with err.
+type failingConsumerInfo struct {
+	*fakeJetStream
+	err error
+}
+
+func (f *failingConsumerInfo) ConsumerInfo(stream, name string, opts ...nats.JSOpt) (*nats.ConsumerInfo, error) {
+	return nil, f.err
+}
+
+func TestStreamManager_EnsureConsumer_WithLookupError_ReturnsError(t *testing.T) {
+	js := &failingConsumerInfo{fakeJetStream: newFakeJetStream(), err: errors.New("jetstream not enabled")}
+
+	_, err := (&StreamManager{js: js}).EnsureConsumer(context.Background(), "DDMRP", bufferConsumer())
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to get consumer buffer-projector on stream DDMRP")
+	assert.Empty(t, js.calls)
+}
@@ -12,43 +12,48 @@ type EventHandler func(ctx context.Context, event *Event) error
 type Subscriber interface {
 	Subscribe(ctx context.Context, subject string, handler EventHandler) error
 	SubscribeDurable(ctx context.Context, subject, durableName string, handler EventHandler) error
+	SubscribeQueue(ctx context.Context, subject, queue string, handler EventHandler) error
+	SubscribeBatch(ctx context.Context, subject, durableName string, config BatchConfig, handler BatchHandler) error
 	Close() error
 }
 
 type NATSSubscriber struct {
-	js   nats.JetStreamContext
-	conn *nats.Conn
-	subs []*nats.Subscription
+	js    nats.JetStreamContext
+	conn  *nats.Conn
+	subs  []*nats.Subscription
+	store PayloadStore
 }
 
-func NewSubscriber(nc *nats.Conn) (*NATSSubscriber, error) {
+type SubscriberOption func(*NATSSubscriber)
+
+// WithPayloadStore resolves claim-checked events published with
+// WithClaimCheck.
+func WithPayloadStore(store PayloadStore) SubscriberOption {
+	return func(s *NATSSubscriber) {
+		s.store = store
+	}
+}
+
+func NewSubscriber(nc *nats.Conn, opts ...SubscriberOption) (*NATSSubscriber, error) {
 	js, err := nc.JetStream()
 	if err != nil {
 		return nil, fmt.Errorf("failed to create JetStream context: %w", err)
 	}
 
-	return &NATSSubscriber{
+	s := &NATSSubscriber{
 		js:   js,
 		conn: nc,
 		subs: make([]*nats.Subscription, 0),
-	}, nil
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	return s, nil
 }
 
 func (s *NATSSubscriber) Subscribe(ctx context.Context, subject string, handler EventHandler) error {
-	sub, err := s.js.Subscribe(subject, func(msg *nats.Msg) {
-		event, err := FromJSON(msg.Data)
-		if err != nil {
-			msg.Nak()
-			return
-		}
-
-		if err := handler(ctx, event); err != nil {
-			msg.Nak()
-			return
-		}
-
-		msg.Ack()
-	})
+	sub, err := s.js.Subscribe(subject, s.handle(ctx, handler))
 
 	if err != nil {
 		return fmt.Errorf("failed to subscribe to subject %s: %w", subject, err)
@@ -59,8 +64,38 @@ func (s *NATSSubscriber) Subscribe(ctx context.Context, subject string, handler
 }
 
 func (s *NATSSubscriber) SubscribeDurable(ctx context.Context, subject, durableName string, handler EventHandler) error {
-	sub, err := s.js.Subscribe(subject, func(msg *nats.Msg) {
-		event, err := FromJSON(msg.Data)
+	sub, err := s.js.Subscribe(subject, s.handle(ctx, handler), nats.Durable(durableName), nats.ManualAck())
+
+	if err != nil {
+		return fmt.Errorf("failed to subscribe to subject %s with durable %s: %w", subject, durableName, err)
+	}
+
+	s.subs = append(s.subs, sub)
+	return nil
+}
+
+// SubscribeQueue joins a durable consumer group. Every replica subscribing
+// with the same queue shares one JetStream consumer, so each message is
+// delivered to exactly one of them and redelivered to another if not acked.
+func (s *NATSSubscriber) SubscribeQueue(ctx context.Context, subject, queue string, handler EventHandler) error {
+	sub, err := s.js.QueueSubscribe(subject, queue, s.handle(ctx, handler), nats.Durable(queue), nats.ManualAck())
+	if err != nil {
+		return fmt.Errorf("failed to subscribe to subject %s with queue %s: %w", subject, queue, err)
+	}
+
+	s.subs = append(s.subs, sub)
+	return nil
+}
+
+func (s *NATSSubscriber) handle(ctx context.Context, handler EventHandler) nats.MsgHandler {
+	return func(msg *nats.Msg) {
+		data, err := decodePayload(ctx, s.store, msg)
+		if err != nil {
+			msg.Nak()
+			return
+		}
+
+		event, err := FromJSON(data)
 		if err != nil {
 			msg.Nak()
 			return
@@ -72,14 +107,7 @@ func (s *NATSSubscriber) SubscribeDurable(ctx context.Context, subject, durableN
 		}
 
 		msg.Ack()
-	}, nats.Durable(durableName), nats.ManualAck())
-
-	if err != nil {
-		return fmt.Errorf("failed to subscribe to subject %s with durable %s: %w", subject, durableName, err)
 	}
-
-	s.subs = append(s.subs, sub)
-	return nil
 }
 
 func (s *NATSSubscriber) Close() error {
@@ -20,6 +20,16 @@ func (m *SubscriberMock) SubscribeDurable(ctx context.Context, subject, durableN
 	return args.Error(0)
 }
 
+func (m *SubscriberMock) SubscribeQueue(ctx context.Context, subject, queue string, handler EventHandler) error {
+	args := m.Called(ctx, subject, queue, handler)
+	return args.Error(0)
+}
+
+func (m *SubscriberMock) SubscribeBatch(ctx context.Context, subject, durableName string, config BatchConfig, handler BatchHandler) error {
+	args := m.Called(ctx, subject, durableName, config, handler)
+	return args.Error(0)
+}
+
 func (m *SubscriberMock) Close() error {
 	args := m.Called()
 	return args.Error(0)
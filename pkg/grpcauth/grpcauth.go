@@ -0,0 +1,162 @@
+// Package grpcauth authenticates gRPC calls with auth-service access tokens
+// and enforces the permission each method requires.
+//
+// The interceptors read the bearer token from the "authorization" metadata,
+// store the caller as an authz.Principal in the context and check the method
+// against an authz.MethodPermissions map.
+package grpcauth
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/giia/giia-core-engine/pkg/authz"
+	pkgErrors "github.com/giia/giia-core-engine/pkg/errors"
+)
+
+// TokenValidator turns an access token into the calling principal. It returns
+// an error when the token is invalid, expired or revoked.
+type TokenValidator interface {
+	Validate(ctx context.Context, token string) (*authz.Principal, error)
+}
+
+// ValidatorFunc adapts a function, e.g. a call to the auth-service
+// ValidateToken RPC, to TokenValidator.
+type ValidatorFunc func(ctx context.Context, token string) (*authz.Principal, error)
+
+func (f ValidatorFunc) Validate(ctx context.Context, token string) (*authz.Principal, error) {
+	return f(ctx, token)
+}
+
+// Interceptor holds the unary and stream server interceptors of a service.
+type Interceptor struct {
+	validator  TokenValidator
+	authorizer authz.Authorizer
+	methods    authz.MethodPermissions
+	public     map[string]bool
+	reads      map[string]bool
+}
+
+func NewInterceptor(validator TokenValidator, authorizer authz.Authorizer, methods authz.MethodPermissions) *Interceptor {
+	return &Interceptor{
+		validator:  validator,
+		authorizer: authorizer,
+		methods:    methods,
+		public:     make(map[string]bool),
+		reads:      make(map[string]bool),
+	}
+}
+
+// Public lets methods through without a token, e.g. the gRPC health check.
+// Methods mapped to "" in MethodPermissions still require a valid token.
+func (i *Interceptor) Public(fullMethods ...string) {
+	for _, method := range fullMethods {
+		i.public[method] = true
+	}
+}
+
+// ReadMethods marks methods that do not change state. Read-only principals,
+// such as read-only impersonation sessions, may only call these.
+func (i *Interceptor) ReadMethods(fullMethods ...string) {
+	for _, method := range fullMethods {
+		i.reads[method] = true
+	}
+}
+
+func (i *Interceptor) Unary() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, err := i.authenticate(ctx, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+func (i *Interceptor) Stream() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := i.authenticate(stream.Context(), info.FullMethod)
+		if err != nil {
+			return err
+		}
+
+		return handler(srv, &principalStream{ServerStream: stream, ctx: ctx})
+	}
+}
+
+func (i *Interceptor) authenticate(ctx context.Context, fullMethod string) (context.Context, error) {
+	if i.public[fullMethod] {
+		return ctx, nil
+	}
+
+	token, ok := bearerToken(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing bearer token")
+	}
+
+	principal, err := i.validator.Validate(ctx, token)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	if principal.ReadOnly && !i.reads[fullMethod] {
+		return nil, status.Error(codes.PermissionDenied, "read-only session cannot call "+fullMethod)
+	}
+
+	ctx = authz.WithPrincipal(ctx, principal)
+	if err := i.methods.Authorize(ctx, i.authorizer, fullMethod); err != nil {
+		return nil, toStatus(err)
+	}
+
+	return ctx, nil
+}
+
+func bearerToken(ctx context.Context) (string, bool) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", false
+	}
+
+	for _, value := range md.Get("authorization") {
+		if token, found := strings.CutPrefix(value, "Bearer "); found && token != "" {
+			return token, true
+		}
+	}
+
+	return "", false
+}
+
+// toStatus maps pkg/errors codes to gRPC codes. Errors that are not
+// CustomErrors come from the validator and are treated as bad credentials.
+func toStatus(err error) error {
+	var customErr *pkgErrors.CustomError
+	if !errors.As(err, &customErr) {
+		return status.Error(codes.Unauthenticated, "invalid or expired token")
+	}
+
+	switch customErr.ErrorCode {
+	case "UNAUTHORIZED":
+		return status.Error(codes.Unauthenticated, customErr.Message)
+	case "FORBIDDEN", pkgErrors.CodePermissionDenied:
+		return status.Error(codes.PermissionDenied, customErr.Message)
+	default:
+		return status.Error(codes.Internal, customErr.Message)
+	}
+}
+
+// principalStream overrides the stream context so handlers see the principal.
+type principalStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *principalStream) Context() context.Context {
+	return s.ctx
+}
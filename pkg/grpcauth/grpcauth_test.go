@@ -0,0 +1,198 @@
+package grpcauth
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/giia/giia-core-engine/pkg/authz"
+)
+
+const (
+	testSecret = "test-secret"
+	testIssuer = "auth-service"
+
+	methodGetBuffer   = "/ddmrp.v1.BufferService/GetBuffer"
+	methodRecalculate = "/ddmrp.v1.BufferService/Recalculate"
+	methodHealth      = "/grpc.health.v1.Health/Check"
+)
+
+var testMethods = authz.MethodPermissions{
+	methodGetBuffer:   "",
+	methodRecalculate: authz.PermissionBuffersRecalculate,
+}
+
+func signToken(t *testing.T, permissions []string, expiresAt time.Time) string {
+	t.Helper()
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, &accessClaims{
+		UserID:         "user-1",
+		OrganizationID: "org-1",
+		Permissions:    permissions,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    testIssuer,
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+		},
+	})
+	signed, err := token.SignedString([]byte(testSecret))
+	if err != nil {
+		t.Fatalf("failed to sign token: %v", err)
+	}
+	return signed
+}
+
+func callUnary(interceptor *Interceptor, method, token string) (*authz.Principal, error) {
+	ctx := context.Background()
+	if token != "" {
+		ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", "Bearer "+token))
+	}
+
+	var principal *authz.Principal
+	_, err := interceptor.Unary()(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, func(ctx context.Context, req interface{}) (interface{}, error) {
+		principal, _ = authz.PrincipalFromContext(ctx)
+		return nil, nil
+	})
+	return principal, err
+}
+
+func TestUnary_WithValidToken_StoresPrincipal(t *testing.T) {
+	interceptor := NewInterceptor(NewJWTValidator(testSecret, testIssuer), authz.NewAuthorizer(nil), testMethods)
+
+	principal, err := callUnary(interceptor, methodGetBuffer, signToken(t, nil, time.Now().Add(time.Minute)))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if principal == nil || principal.UserID != "user-1" || principal.OrganizationID != "org-1" {
+		t.Errorf("expected principal from claims, got %+v", principal)
+	}
+}
+
+func TestUnary_WithMissingOrExpiredToken_ReturnsUnauthenticated(t *testing.T) {
+	interceptor := NewInterceptor(NewJWTValidator(testSecret, testIssuer), authz.NewAuthorizer(nil), testMethods)
+
+	for _, token := range []string{"", "not-a-jwt", signToken(t, nil, time.Now().Add(-time.Minute))} {
+		_, err := callUnary(interceptor, methodGetBuffer, token)
+		if status.Code(err) != codes.Unauthenticated {
+			t.Errorf("expected Unauthenticated for %q, got %v", token, err)
+		}
+	}
+}
+
+func TestUnary_WithoutRequiredPermission_ReturnsPermissionDenied(t *testing.T) {
+	interceptor := NewInterceptor(NewJWTValidator(testSecret, testIssuer), authz.NewAuthorizer(nil), testMethods)
+
+	_, err := callUnary(interceptor, methodRecalculate, signToken(t, []string{"ddmrp:buffers:read"}, time.Now().Add(time.Minute)))
+	if status.Code(err) != codes.PermissionDenied {
+		t.Errorf("expected PermissionDenied, got %v", err)
+	}
+
+	_, err = callUnary(interceptor, methodRecalculate, signToken(t, []string{"ddmrp:*:*"}, time.Now().Add(time.Minute)))
+	if err != nil {
+		t.Errorf("expected wildcard permission to pass, got %v", err)
+	}
+}
+
+func TestUnary_WithUnmappedMethod_ReturnsPermissionDenied(t *testing.T) {
+	interceptor := NewInterceptor(NewJWTValidator(testSecret, testIssuer), authz.NewAuthorizer(nil), testMethods)
+
+	_, err := callUnary(interceptor, "/ddmrp.v1.BufferService/DeleteAll", signToken(t, []string{"*:*:*"}, time.Now().Add(time.Minute)))
+	if status.Code(err) != codes.PermissionDenied {
+		t.Errorf("expected PermissionDenied, got %v", err)
+	}
+}
+
+func TestUnary_WithPublicMethod_SkipsAuthentication(t *testing.T) {
+	interceptor := NewInterceptor(ValidatorFunc(func(ctx context.Context, token string) (*authz.Principal, error) {
+		t.Error("validator must not be called for public methods")
+		return nil, nil
+	}), authz.NewAuthorizer(nil), testMethods)
+	interceptor.Public(methodHealth)
+
+	if _, err := callUnary(interceptor, methodHealth, ""); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func signImpersonationToken(t *testing.T, readOnly bool) string {
+	t.Helper()
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, &accessClaims{
+		UserID:          "user-1",
+		OrganizationID:  "org-1",
+		Permissions:     []string{"*:*:*"},
+		Impersonator:    "admin-1",
+		ImpersonationID: "imp-1",
+		ReadOnly:        readOnly,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    testIssuer,
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Minute)),
+		},
+	})
+	signed, err := token.SignedString([]byte(testSecret))
+	if err != nil {
+		t.Fatalf("failed to sign token: %v", err)
+	}
+	return signed
+}
+
+func TestUnary_WithImpersonationToken_StoresImpersonator(t *testing.T) {
+	interceptor := NewInterceptor(NewJWTValidator(testSecret, testIssuer), authz.NewAuthorizer(nil), testMethods)
+
+	principal, err := callUnary(interceptor, methodRecalculate, signImpersonationToken(t, false))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if principal.ImpersonatorID != "admin-1" || principal.ImpersonationID != "imp-1" || principal.ReadOnly {
+		t.Errorf("expected impersonation claims on principal, got %+v", principal)
+	}
+}
+
+func TestUnary_WithReadOnlyToken_AllowsOnlyReadMethods(t *testing.T) {
+	interceptor := NewInterceptor(NewJWTValidator(testSecret, testIssuer), authz.NewAuthorizer(nil), testMethods)
+	interceptor.ReadMethods(methodGetBuffer)
+	token := signImpersonationToken(t, true)
+
+	principal, err := callUnary(interceptor, methodGetBuffer, token)
+	if err != nil {
+		t.Fatalf("expected read method to pass, got %v", err)
+	}
+	if !principal.ReadOnly {
+		t.Errorf("expected read-only principal, got %+v", principal)
+	}
+
+	_, err = callUnary(interceptor, methodRecalculate, token)
+	if status.Code(err) != codes.PermissionDenied {
+		t.Errorf("expected PermissionDenied, got %v", err)
+	}
+}
+
+type fakeStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *fakeStream) Context() context.Context {
+	return s.ctx
+}
+
+func TestStream_WithValidToken_ExposesPrincipalOnStreamContext(t *testing.T) {
+	interceptor := NewInterceptor(NewJWTValidator(testSecret, testIssuer), authz.NewAuthorizer(nil), testMethods)
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+signToken(t, nil, time.Now().Add(time.Minute))))
+
+	var principal *authz.Principal
+	err := interceptor.Stream()(nil, &fakeStream{ctx: ctx}, &grpc.StreamServerInfo{FullMethod: methodGetBuffer}, func(srv interface{}, stream grpc.ServerStream) error {
+		principal, _ = authz.PrincipalFromContext(stream.Context())
+		return nil
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if principal == nil || principal.UserID != "user-1" {
+		t.Errorf("expected principal on stream context, got %+v", principal)
+	}
+}
@@ -0,0 +1,69 @@
+package grpcauth
+
+import (
+	"context"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/giia/giia-core-engine/pkg/authz"
+	pkgErrors "github.com/giia/giia-core-engine/pkg/errors"
+)
+
+// accessClaims mirrors the access token claims issued by auth-service.
+type accessClaims struct {
+	UserID         string   `json:"user_id"`
+	Email          string   `json:"email"`
+	OrganizationID string   `json:"organization_id"`
+	Roles          []string `json:"roles,omitempty"`
+	Permissions    []string `json:"permissions,omitempty"`
+
+	// Set only on impersonation tokens
+	Impersonator    string `json:"impersonator,omitempty"`
+	ImpersonationID string `json:"impersonation_id,omitempty"`
+	ReadOnly        bool   `json:"read_only,omitempty"`
+	jwt.RegisteredClaims
+}
+
+type jwtValidator struct {
+	secret []byte
+	issuer string
+}
+
+// NewJWTValidator validates auth-service access tokens locally with the
+// shared HMAC secret. It does not see revocations; use a ValidatorFunc over
+// the ValidateToken RPC where logout must take effect immediately.
+func NewJWTValidator(secret, issuer string) TokenValidator {
+	return &jwtValidator{
+		secret: []byte(secret),
+		issuer: issuer,
+	}
+}
+
+func (v *jwtValidator) Validate(ctx context.Context, token string) (*authz.Principal, error) {
+	options := []jwt.ParserOption{jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()})}
+	if v.issuer != "" {
+		options = append(options, jwt.WithIssuer(v.issuer))
+	}
+
+	claims := &accessClaims{}
+	parsed, err := jwt.ParseWithClaims(token, claims, func(*jwt.Token) (interface{}, error) {
+		return v.secret, nil
+	}, options...)
+	if err != nil || !parsed.Valid {
+		return nil, pkgErrors.NewUnauthorized("invalid or expired token")
+	}
+
+	if claims.UserID == "" || claims.OrganizationID == "" {
+		return nil, pkgErrors.NewUnauthorized("invalid token claims")
+	}
+
+	return &authz.Principal{
+		UserID:          claims.UserID,
+		OrganizationID:  claims.OrganizationID,
+		Roles:           claims.Roles,
+		Permissions:     claims.Permissions,
+		ImpersonatorID:  claims.Impersonator,
+		ImpersonationID: claims.ImpersonationID,
+		ReadOnly:        claims.ReadOnly,
+	}, nil
+}
@@ -0,0 +1,124 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Status of the whole platform as seen by the gateway.
+type SystemReport struct {
+	Status    Status    `json:"status"`
+	CheckedAt time.Time `json:"checked_at"`
+	Services  []*Report `json:"services"`
+}
+
+func (r *SystemReport) httpStatus() int {
+	if r.Status == StatusDown {
+		return http.StatusServiceUnavailable
+	}
+	return http.StatusOK
+}
+
+// Aggregator polls the /ready endpoint of each service.
+type Aggregator struct {
+	services map[string]string
+	client   *http.Client
+}
+
+// NewAggregator takes a map of service name to readiness URL.
+func NewAggregator(services map[string]string, client *http.Client) *Aggregator {
+	if client == nil {
+		client = &http.Client{Timeout: 5 * time.Second}
+	}
+	return &Aggregator{
+		services: services,
+		client:   client,
+	}
+}
+
+func (a *Aggregator) Run(ctx context.Context) *SystemReport {
+	reports := make([]*Report, 0, len(a.services))
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+
+	for name, url := range a.services {
+		wg.Add(1)
+		go func(name, url string) {
+			defer wg.Done()
+			report := a.fetch(ctx, name, url)
+			mu.Lock()
+			reports = append(reports, report)
+			mu.Unlock()
+		}(name, url)
+	}
+	wg.Wait()
+
+	sort.Slice(reports, func(i, j int) bool { return reports[i].Service < reports[j].Service })
+
+	status := StatusUp
+	for _, report := range reports {
+		switch report.Status {
+		case StatusDown:
+			status = StatusDown
+		case StatusDegraded:
+			if status == StatusUp {
+				status = StatusDegraded
+			}
+		}
+	}
+
+	return &SystemReport{
+		Status:    status,
+		CheckedAt: time.Now().UTC(),
+		Services:  reports,
+	}
+}
+
+func (a *Aggregator) fetch(ctx context.Context, name, url string) *Report {
+	start := time.Now()
+	unreachable := func(err error) *Report {
+		return &Report{
+			Service:   name,
+			Status:    StatusDown,
+			CheckedAt: time.Now().UTC(),
+			Dependencies: []*DependencyStatus{{
+				Name:      "service",
+				Status:    StatusDown,
+				Critical:  true,
+				LatencyMs: time.Since(start).Milliseconds(),
+				Error:     err.Error(),
+			}},
+		}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return unreachable(err)
+	}
+
+	resp, err := a.client.Do(req)
+	if err != nil {
+		return unreachable(err)
+	}
+	defer resp.Body.Close()
+
+	var report Report
+	if err := json.NewDecoder(resp.Body).Decode(&report); err != nil {
+		return unreachable(fmt.Errorf("invalid readiness response (HTTP %d): %w", resp.StatusCode, err))
+	}
+
+	report.Service = name
+	return &report
+}
+
+// Handler serves the aggregated report, e.g. as the gateway's /status page.
+func (a *Aggregator) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeReport(w, a.Run(r.Context()))
+	})
+}
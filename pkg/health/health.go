@@ -0,0 +1,181 @@
+// Package health reports structured readiness for a service's dependencies
+// and aggregates the reports of several services.
+package health
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+)
+
+type Status string
+
+const (
+	StatusUp       Status = "up"
+	StatusDown     Status = "down"
+	StatusDegraded Status = "degraded"
+)
+
+const defaultTimeout = 2 * time.Second
+
+// CheckFunc returns nil when the dependency is reachable.
+type CheckFunc func(ctx context.Context) error
+
+type DependencyStatus struct {
+	Name      string `json:"name"`
+	Status    Status `json:"status"`
+	Critical  bool   `json:"critical"`
+	LatencyMs int64  `json:"latency_ms"`
+	Error     string `json:"error,omitempty"`
+}
+
+// Report is the /ready response body. Status is down if any critical
+// dependency is down and degraded if only optional ones are.
+type Report struct {
+	Service      string              `json:"service"`
+	Status       Status              `json:"status"`
+	CheckedAt    time.Time           `json:"checked_at"`
+	Dependencies []*DependencyStatus `json:"dependencies"`
+}
+
+type check struct {
+	name     string
+	critical bool
+	fn       CheckFunc
+}
+
+type Checker struct {
+	service string
+	timeout time.Duration
+	checks  []check
+	mu      sync.RWMutex
+}
+
+func NewChecker(service string) *Checker {
+	return &Checker{
+		service: service,
+		timeout: defaultTimeout,
+	}
+}
+
+// WithTimeout bounds each dependency check.
+func (c *Checker) WithTimeout(timeout time.Duration) *Checker {
+	c.timeout = timeout
+	return c
+}
+
+// AddCritical registers a dependency the service cannot serve without.
+func (c *Checker) AddCritical(name string, fn CheckFunc) *Checker {
+	return c.add(name, true, fn)
+}
+
+// AddOptional registers a dependency whose failure only degrades the service.
+func (c *Checker) AddOptional(name string, fn CheckFunc) *Checker {
+	return c.add(name, false, fn)
+}
+
+func (c *Checker) add(name string, critical bool, fn CheckFunc) *Checker {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.checks = append(c.checks, check{name: name, critical: critical, fn: fn})
+	return c
+}
+
+// Run executes all checks concurrently.
+func (c *Checker) Run(ctx context.Context) *Report {
+	c.mu.RLock()
+	checks := append([]check(nil), c.checks...)
+	c.mu.RUnlock()
+
+	results := make([]*DependencyStatus, len(checks))
+	var wg sync.WaitGroup
+
+	for i, chk := range checks {
+		wg.Add(1)
+		go func(i int, chk check) {
+			defer wg.Done()
+			results[i] = c.runCheck(ctx, chk)
+		}(i, chk)
+	}
+	wg.Wait()
+
+	return &Report{
+		Service:      c.service,
+		Status:       overallStatus(results),
+		CheckedAt:    time.Now().UTC(),
+		Dependencies: results,
+	}
+}
+
+func (c *Checker) runCheck(ctx context.Context, chk check) *DependencyStatus {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	start := time.Now()
+	errCh := make(chan error, 1)
+	go func() { errCh <- chk.fn(ctx) }()
+
+	var err error
+	select {
+	case err = <-errCh:
+	case <-ctx.Done():
+		err = ctx.Err()
+	}
+
+	result := &DependencyStatus{
+		Name:      chk.name,
+		Status:    StatusUp,
+		Critical:  chk.critical,
+		LatencyMs: time.Since(start).Milliseconds(),
+	}
+	if err != nil {
+		result.Status = StatusDown
+		result.Error = err.Error()
+	}
+
+	return result
+}
+
+func overallStatus(results []*DependencyStatus) Status {
+	status := StatusUp
+	for _, r := range results {
+		if r.Status == StatusUp {
+			continue
+		}
+		if r.Critical {
+			return StatusDown
+		}
+		status = StatusDegraded
+	}
+	return status
+}
+
+// Handler serves the report, with 503 when the service is down.
+func (c *Checker) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeReport(w, c.Run(r.Context()))
+	})
+}
+
+func writeReport(w http.ResponseWriter, body interface{ httpStatus() int }) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(body.httpStatus())
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func (r *Report) httpStatus() int {
+	if r.Status == StatusDown {
+		return http.StatusServiceUnavailable
+	}
+	return http.StatusOK
+}
+
+// SQLCheck pings a database/sql pool (use gormDB.DB() for GORM).
+func SQLCheck(db *sql.DB) CheckFunc {
+	return func(ctx context.Context) error {
+		return db.PingContext(ctx)
+	}
+}
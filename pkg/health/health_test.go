@@ -0,0 +1,106 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestChecker_Run_WithCriticalFailure_ReportsDown(t *testing.T) {
+	checker := NewChecker("ddmrp").
+		AddCritical("postgres", func(ctx context.Context) error { return errors.New("connection refused") }).
+		AddOptional("nats", func(ctx context.Context) error { return nil })
+
+	report := checker.Run(context.Background())
+
+	if report.Status != StatusDown {
+		t.Errorf("expected status %s, got %s", StatusDown, report.Status)
+	}
+	if report.Dependencies[0].Error != "connection refused" {
+		t.Errorf("expected postgres error to be reported, got %q", report.Dependencies[0].Error)
+	}
+	if report.Dependencies[1].Status != StatusUp {
+		t.Errorf("expected nats up, got %s", report.Dependencies[1].Status)
+	}
+}
+
+func TestChecker_Run_WithOptionalFailure_ReportsDegraded(t *testing.T) {
+	checker := NewChecker("ddmrp").
+		AddCritical("postgres", func(ctx context.Context) error { return nil }).
+		AddOptional("nats", func(ctx context.Context) error { return errors.New("no servers available") })
+
+	if report := checker.Run(context.Background()); report.Status != StatusDegraded {
+		t.Errorf("expected status %s, got %s", StatusDegraded, report.Status)
+	}
+}
+
+func TestChecker_Run_WithSlowDependency_TimesOut(t *testing.T) {
+	checker := NewChecker("auth").
+		WithTimeout(20*time.Millisecond).
+		AddCritical("redis", func(ctx context.Context) error {
+			time.Sleep(time.Second)
+			return nil
+		})
+
+	start := time.Now()
+	report := checker.Run(context.Background())
+
+	if report.Status != StatusDown {
+		t.Errorf("expected status %s, got %s", StatusDown, report.Status)
+	}
+	if time.Since(start) > 500*time.Millisecond {
+		t.Errorf("expected check to give up after the timeout, took %v", time.Since(start))
+	}
+}
+
+func TestChecker_Handler_WhenDown_Returns503(t *testing.T) {
+	checker := NewChecker("auth").AddCritical("postgres", func(ctx context.Context) error { return errors.New("down") })
+
+	rec := httptest.NewRecorder()
+	checker.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/ready", nil))
+
+	if rec.Code != http.StatusServiceUnavailable {
+		t.Errorf("expected 503, got %d", rec.Code)
+	}
+}
+
+func TestAggregator_Run_CombinesServiceReports(t *testing.T) {
+	healthy := httptest.NewServer(NewChecker("auth").AddCritical("postgres", func(ctx context.Context) error { return nil }).Handler())
+	defer healthy.Close()
+
+	degraded := httptest.NewServer(NewChecker("ddmrp").AddOptional("nats", func(ctx context.Context) error { return errors.New("down") }).Handler())
+	defer degraded.Close()
+
+	aggregator := NewAggregator(map[string]string{
+		"auth":      healthy.URL,
+		"ddmrp":     degraded.URL,
+		"execution": "http://127.0.0.1:1/ready",
+	}, nil)
+
+	report := aggregator.Run(context.Background())
+
+	if report.Status != StatusDown {
+		t.Errorf("expected status %s with an unreachable service, got %s", StatusDown, report.Status)
+	}
+	if len(report.Services) != 3 || report.Services[0].Service != "auth" {
+		t.Fatalf("expected 3 services sorted by name, got %+v", report.Services)
+	}
+	if report.Services[1].Status != StatusDegraded {
+		t.Errorf("expected ddmrp degraded, got %s", report.Services[1].Status)
+	}
+
+	rec := httptest.NewRecorder()
+	aggregator.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/status", nil))
+
+	var body SystemReport
+	if err := json.NewDecoder(rec.Body).Decode(&body); err != nil {
+		t.Fatalf("invalid response body: %v", err)
+	}
+	if rec.Code != http.StatusServiceUnavailable || body.Status != StatusDown {
+		t.Errorf("expected 503 and down, got %d and %s", rec.Code, body.Status)
+	}
+}
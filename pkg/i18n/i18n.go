@@ -0,0 +1,189 @@
+// Package i18n translates user-facing messages. Catalogs are keyed by the
+// English text (or a message ID), so untranslated messages fall back to the
+// key itself.
+package i18n
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+const (
+	English    = "en"
+	Spanish    = "es"
+	Portuguese = "pt"
+)
+
+// Catalog holds translations per language.
+type Catalog struct {
+	defaultLanguage string
+	messages        map[string]map[string]string
+	mu              sync.RWMutex
+}
+
+func NewCatalog(defaultLanguage string) *Catalog {
+	return &Catalog{
+		defaultLanguage: normalize(defaultLanguage),
+		messages:        make(map[string]map[string]string),
+	}
+}
+
+// Add merges messages for a language, replacing existing keys.
+func (c *Catalog) Add(language string, messages map[string]string) {
+	language = normalize(language)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.messages[language] == nil {
+		c.messages[language] = make(map[string]string, len(messages))
+	}
+	for key, value := range messages {
+		c.messages[language][key] = value
+	}
+}
+
+// Languages lists the languages with messages plus the default language.
+func (c *Catalog) Languages() []string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	seen := map[string]bool{c.defaultLanguage: true}
+	languages := []string{c.defaultLanguage}
+	for language := range c.messages {
+		if !seen[language] {
+			seen[language] = true
+			languages = append(languages, language)
+		}
+	}
+	sort.Strings(languages[1:])
+	return languages
+}
+
+// T translates key into language, trying the regional variant ("es-ar"), the
+// base language ("es"), the default language and finally the key itself.
+// Placeholders written as {name} are replaced from args.
+func (c *Catalog) T(language, key string, args map[string]string) string {
+	message := c.lookup(normalize(language), key)
+
+	for name, value := range args {
+		message = strings.ReplaceAll(message, "{"+name+"}", value)
+	}
+	return message
+}
+
+// TContext translates using the language stored in ctx.
+func (c *Catalog) TContext(ctx context.Context, key string, args map[string]string) string {
+	return c.T(LanguageFromContext(ctx), key, args)
+}
+
+func (c *Catalog) lookup(language, key string) string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	candidates := []string{language}
+	if base, _, found := strings.Cut(language, "-"); found {
+		candidates = append(candidates, base)
+	}
+	candidates = append(candidates, c.defaultLanguage)
+
+	for _, candidate := range candidates {
+		if message, ok := c.messages[candidate][key]; ok {
+			return message
+		}
+	}
+	return key
+}
+
+// Negotiate picks the best supported language. Preferences are tried in order
+// (e.g. user setting, organization setting) before the Accept-Language header;
+// fallback is returned when nothing matches.
+func Negotiate(supported []string, acceptLanguage string, fallback string, preferences ...string) string {
+	supportedSet := make(map[string]bool, len(supported))
+	for _, language := range supported {
+		supportedSet[normalize(language)] = true
+	}
+
+	match := func(language string) (string, bool) {
+		language = normalize(language)
+		if supportedSet[language] {
+			return language, true
+		}
+		if base, _, found := strings.Cut(language, "-"); found && supportedSet[base] {
+			return base, true
+		}
+		return "", false
+	}
+
+	for _, preference := range preferences {
+		if language, ok := match(preference); ok {
+			return language
+		}
+	}
+
+	for _, language := range parseAcceptLanguage(acceptLanguage) {
+		if matched, ok := match(language); ok {
+			return matched
+		}
+	}
+
+	return normalize(fallback)
+}
+
+type weightedLanguage struct {
+	language string
+	q        float64
+}
+
+// parseAcceptLanguage returns the languages in the header by descending q.
+func parseAcceptLanguage(header string) []string {
+	var weighted []weightedLanguage
+
+	for _, part := range strings.Split(header, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		language, params, _ := strings.Cut(part, ";")
+		q := 1.0
+		if value, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			if parsed, err := strconv.ParseFloat(value, 64); err == nil {
+				q = parsed
+			}
+		}
+
+		if language = strings.TrimSpace(language); language != "*" && q > 0 {
+			weighted = append(weighted, weightedLanguage{language: language, q: q})
+		}
+	}
+
+	sort.SliceStable(weighted, func(i, j int) bool { return weighted[i].q > weighted[j].q })
+
+	languages := make([]string, len(weighted))
+	for i, w := range weighted {
+		languages[i] = w.language
+	}
+	return languages
+}
+
+func normalize(language string) string {
+	return strings.ToLower(strings.ReplaceAll(strings.TrimSpace(language), "_", "-"))
+}
+
+type languageKey struct{}
+
+func WithLanguage(ctx context.Context, language string) context.Context {
+	return context.WithValue(ctx, languageKey{}, normalize(language))
+}
+
+// LanguageFromContext returns the negotiated language, or English.
+func LanguageFromContext(ctx context.Context) string {
+	if language, ok := ctx.Value(languageKey{}).(string); ok && language != "" {
+		return language
+	}
+	return English
+}
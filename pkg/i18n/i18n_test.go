@@ -0,0 +1,93 @@
+package i18n
+
+import (
+	"context"
+	"testing"
+)
+
+func newTestCatalog() *Catalog {
+	catalog := NewCatalog(English)
+	catalog.Add(Spanish, map[string]string{
+		"invalid credentials":    "credenciales inválidas",
+		"Welcome, {name}!":       "¡Bienvenido, {name}!",
+		"buffer in red zone":     "buffer en zona roja",
+		"notification.low_stock": "Stock bajo en {sku}",
+	})
+	catalog.Add("es-AR", map[string]string{
+		"buffer in red zone": "buffer en zona roja (AR)",
+	})
+	catalog.Add(Portuguese, map[string]string{
+		"invalid credentials": "credenciais inválidas",
+	})
+	return catalog
+}
+
+func TestCatalog_T_FallbackChain(t *testing.T) {
+	catalog := newTestCatalog()
+
+	tests := []struct {
+		language string
+		key      string
+		want     string
+	}{
+		{"es", "invalid credentials", "credenciales inválidas"},
+		{"es-AR", "buffer in red zone", "buffer en zona roja (AR)"},
+		{"es_MX", "buffer in red zone", "buffer en zona roja"},
+		{"pt-BR", "invalid credentials", "credenciais inválidas"},
+		{"pt", "buffer in red zone", "buffer in red zone"},
+		{"de", "invalid credentials", "invalid credentials"},
+	}
+
+	for _, tt := range tests {
+		if got := catalog.T(tt.language, tt.key, nil); got != tt.want {
+			t.Errorf("T(%q, %q) = %q, want %q", tt.language, tt.key, got, tt.want)
+		}
+	}
+}
+
+func TestCatalog_T_ReplacesPlaceholders(t *testing.T) {
+	catalog := newTestCatalog()
+
+	got := catalog.T(Spanish, "notification.low_stock", map[string]string{"sku": "SKU-1"})
+
+	if got != "Stock bajo en SKU-1" {
+		t.Errorf("unexpected translation %q", got)
+	}
+}
+
+func TestCatalog_TContext_UsesContextLanguage(t *testing.T) {
+	catalog := newTestCatalog()
+	ctx := WithLanguage(context.Background(), "es")
+
+	if got := catalog.TContext(ctx, "Welcome, {name}!", map[string]string{"name": "Ana"}); got != "¡Bienvenido, Ana!" {
+		t.Errorf("unexpected translation %q", got)
+	}
+	if got := LanguageFromContext(context.Background()); got != English {
+		t.Errorf("expected default language %q, got %q", English, got)
+	}
+}
+
+func TestNegotiate(t *testing.T) {
+	supported := []string{English, Spanish, Portuguese}
+
+	tests := []struct {
+		name        string
+		accept      string
+		preferences []string
+		want        string
+	}{
+		{"user preference wins", "pt-BR", []string{"es", "en"}, "es"},
+		{"empty preference skipped", "pt-BR,pt;q=0.9", []string{"", ""}, "pt"},
+		{"header q ordering", "fr;q=0.9, es-AR;q=0.8, en;q=0.5", nil, "es"},
+		{"unsupported falls back", "fr, de", nil, "en"},
+		{"zero weight ignored", "es;q=0, pt;q=0.1", nil, "pt"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Negotiate(supported, tt.accept, English, tt.preferences...); got != tt.want {
+				t.Errorf("Negotiate() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,3 @@
+module github.com/giia/giia-core-engine/pkg/lock
+
+go 1.24.0
@@ -0,0 +1,53 @@
+// Package lock provides distributed locks so singleton jobs run on exactly one
+// instance when a service is scaled horizontally.
+package lock
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// ErrNotHeld is returned when releasing a lock that is no longer held.
+var ErrNotHeld = errors.New("lock: not held")
+
+// Locker acquires named locks without blocking.
+type Locker interface {
+	// TryLock returns acquired=false, with a nil error, when another holder
+	// owns key.
+	TryLock(ctx context.Context, key string) (l Lock, acquired bool, err error)
+}
+
+type Lock interface {
+	Unlock(ctx context.Context) error
+}
+
+// RunExclusive runs fn only if key can be locked, and reports whether it ran.
+// The lock is released when fn returns.
+func RunExclusive(ctx context.Context, locker Locker, key string, fn func(ctx context.Context) error) (bool, error) {
+	l, acquired, err := locker.TryLock(ctx, key)
+	if err != nil {
+		return false, fmt.Errorf("failed to acquire lock %q: %w", key, err)
+	}
+	if !acquired {
+		return false, nil
+	}
+
+	runErr := fn(ctx)
+
+	// Release even if the job's context was cancelled
+	if err := l.Unlock(context.WithoutCancel(ctx)); err != nil && runErr == nil {
+		return true, fmt.Errorf("failed to release lock %q: %w", key, err)
+	}
+
+	return true, runErr
+}
+
+// Singleton wraps a cron entrypoint so only the lock holder executes it.
+// Instances that lose the race skip the run silently.
+func Singleton(locker Locker, key string, fn func(ctx context.Context) error) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		_, err := RunExclusive(ctx, locker, key, fn)
+		return err
+	}
+}
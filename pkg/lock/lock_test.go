@@ -0,0 +1,97 @@
+package lock
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestRunExclusive_WithConcurrentCallers_RunsOnce(t *testing.T) {
+	locker := NewMemoryLocker()
+	release := make(chan struct{})
+	var runs int32
+
+	var wg sync.WaitGroup
+	results := make([]bool, 5)
+
+	// First caller holds the lock until the others have tried
+	started := make(chan struct{})
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		results[0], _ = RunExclusive(context.Background(), locker, "nightly", func(ctx context.Context) error {
+			atomic.AddInt32(&runs, 1)
+			close(started)
+			<-release
+			return nil
+		})
+	}()
+	<-started
+
+	for i := 1; i < 5; i++ {
+		ran, err := RunExclusive(context.Background(), locker, "nightly", func(ctx context.Context) error {
+			atomic.AddInt32(&runs, 1)
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		results[i] = ran
+	}
+
+	close(release)
+	wg.Wait()
+
+	if runs != 1 {
+		t.Errorf("expected 1 run, got %d", runs)
+	}
+	if !results[0] {
+		t.Error("expected first caller to run")
+	}
+}
+
+func TestRunExclusive_ReleasesLockAfterRun(t *testing.T) {
+	locker := NewMemoryLocker()
+	jobErr := errors.New("job failed")
+
+	ran, err := RunExclusive(context.Background(), locker, "snapshot", func(ctx context.Context) error {
+		return jobErr
+	})
+	if !ran || !errors.Is(err, jobErr) {
+		t.Fatalf("expected job to run and return its error, got ran=%v err=%v", ran, err)
+	}
+
+	ran, err = RunExclusive(context.Background(), locker, "snapshot", func(ctx context.Context) error {
+		return nil
+	})
+	if !ran || err != nil {
+		t.Errorf("expected lock to be free again, got ran=%v err=%v", ran, err)
+	}
+}
+
+func TestMemoryLock_UnlockTwice_ReturnsErrNotHeld(t *testing.T) {
+	locker := NewMemoryLocker()
+
+	l, acquired, err := locker.TryLock(context.Background(), "key")
+	if err != nil || !acquired {
+		t.Fatalf("expected lock to be acquired, got acquired=%v err=%v", acquired, err)
+	}
+
+	if err := l.Unlock(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := l.Unlock(context.Background()); !errors.Is(err, ErrNotHeld) {
+		t.Errorf("expected ErrNotHeld, got %v", err)
+	}
+}
+
+func TestAdvisoryKey_IsStable(t *testing.T) {
+	if advisoryKey("ddmrp.recalculation") != advisoryKey("ddmrp.recalculation") {
+		t.Error("expected the same key for the same name")
+	}
+	if advisoryKey("ddmrp.recalculation") == advisoryKey("analytics.snapshot") {
+		t.Error("expected different keys for different names")
+	}
+}
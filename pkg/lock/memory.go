@@ -0,0 +1,46 @@
+package lock
+
+import (
+	"context"
+	"sync"
+)
+
+// MemoryLocker is a process-local Locker for tests and single-instance
+// deployments.
+type MemoryLocker struct {
+	mu   sync.Mutex
+	held map[string]bool
+}
+
+func NewMemoryLocker() *MemoryLocker {
+	return &MemoryLocker{held: make(map[string]bool)}
+}
+
+func (m *MemoryLocker) TryLock(ctx context.Context, key string) (Lock, bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.held[key] {
+		return nil, false, nil
+	}
+
+	m.held[key] = true
+	return &memoryLock{locker: m, key: key}, true, nil
+}
+
+type memoryLock struct {
+	locker *MemoryLocker
+	key    string
+	once   sync.Once
+}
+
+func (l *memoryLock) Unlock(ctx context.Context) error {
+	err := ErrNotHeld
+	l.once.Do(func() {
+		l.locker.mu.Lock()
+		defer l.locker.mu.Unlock()
+		delete(l.locker.held, l.key)
+		err = nil
+	})
+	return err
+}
@@ -0,0 +1,81 @@
+package lock
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"hash/fnv"
+	"sync"
+)
+
+// PostgresLocker uses session-level advisory locks. Each held lock pins one
+// pooled connection until it is released; if the process dies the connection
+// closes and Postgres frees the lock.
+type PostgresLocker struct {
+	db *sql.DB
+}
+
+func NewPostgresLocker(db *sql.DB) *PostgresLocker {
+	return &PostgresLocker{db: db}
+}
+
+func (p *PostgresLocker) TryLock(ctx context.Context, key string) (Lock, bool, error) {
+	conn, err := p.db.Conn(ctx)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to get connection: %w", err)
+	}
+
+	id := advisoryKey(key)
+
+	var acquired bool
+	if err := conn.QueryRowContext(ctx, "SELECT pg_try_advisory_lock($1)", id).Scan(&acquired); err != nil {
+		conn.Close()
+		return nil, false, fmt.Errorf("failed to try advisory lock: %w", err)
+	}
+
+	if !acquired {
+		conn.Close()
+		return nil, false, nil
+	}
+
+	return &postgresLock{conn: conn, id: id}, true, nil
+}
+
+type postgresLock struct {
+	conn *sql.Conn
+	id   int64
+	once sync.Once
+}
+
+// Unlock returns the connection to the pool only once Postgres confirms the
+// lock is released. Otherwise the session may still hold it, so the
+// connection is discarded, which ends the session and frees the lock, rather
+// than handed to the next borrower with the lock attached.
+func (l *postgresLock) Unlock(ctx context.Context) error {
+	err := ErrNotHeld
+	l.once.Do(func() {
+		var released bool
+		scanErr := l.conn.QueryRowContext(ctx, "SELECT pg_advisory_unlock($1)", l.id).Scan(&released)
+		if scanErr == nil && released {
+			err = nil
+			l.conn.Close()
+			return
+		}
+
+		_ = l.conn.Raw(func(any) error { return driver.ErrBadConn })
+		l.conn.Close()
+
+		if scanErr != nil {
+			err = fmt.Errorf("failed to release advisory lock: %w", scanErr)
+		}
+	})
+	return err
+}
+
+// advisoryKey maps a lock name onto the bigint keyspace of advisory locks.
+func advisoryKey(key string) int64 {
+	h := fnv.New64a()
+	h.Write([]byte(key))
+	return int64(h.Sum64())
+}
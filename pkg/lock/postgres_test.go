@@ -0,0 +1,107 @@
+package lock
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+// advisoryDB is a database/sql connector whose sessions grant every advisory
+// lock and answer pg_advisory_unlock with unlockResult or unlockErr.
+type advisoryDB struct {
+	unlockResult bool
+	unlockErr    error
+	closed       atomic.Int32
+}
+
+func (d *advisoryDB) Connect(context.Context) (driver.Conn, error) { return &advisoryConn{db: d}, nil }
+func (d *advisoryDB) Driver() driver.Driver                        { return nil }
+
+type advisoryConn struct {
+	db *advisoryDB
+}
+
+func (c *advisoryConn) Prepare(string) (driver.Stmt, error) {
+	return nil, errors.New("prepared statements are not supported")
+}
+
+func (c *advisoryConn) Close() error {
+	c.db.closed.Add(1)
+	return nil
+}
+
+func (c *advisoryConn) Begin() (driver.Tx, error) {
+	return nil, errors.New("transactions are not supported")
+}
+
+func (c *advisoryConn) QueryContext(_ context.Context, query string, _ []driver.NamedValue) (driver.Rows, error) {
+	if strings.Contains(query, "pg_advisory_unlock") {
+		if c.db.unlockErr != nil {
+			return nil, c.db.unlockErr
+		}
+		return &boolRows{value: c.db.unlockResult}, nil
+	}
+	return &boolRows{value: true}, nil
+}
+
+type boolRows struct {
+	value bool
+	done  bool
+}
+
+func (r *boolRows) Columns() []string { return []string{"result"} }
+func (r *boolRows) Close() error      { return nil }
+
+func (r *boolRows) Next(dest []driver.Value) error {
+	if r.done {
+		return io.EOF
+	}
+	dest[0] = r.value
+	r.done = true
+	return nil
+}
+
+func TestPostgresLock_Unlock_ReturnsConnectionToPoolWhenReleased(t *testing.T) {
+	fake := &advisoryDB{unlockResult: true}
+	db := sql.OpenDB(fake)
+	defer db.Close()
+
+	l, acquired, err := NewPostgresLocker(db).TryLock(context.Background(), "key")
+	if err != nil || !acquired {
+		t.Fatalf("expected lock to be acquired, got acquired=%v err=%v", acquired, err)
+	}
+
+	if err := l.Unlock(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if fake.closed.Load() != 0 || db.Stats().Idle != 1 {
+		t.Errorf("expected connection back in the pool, got closed=%d idle=%d", fake.closed.Load(), db.Stats().Idle)
+	}
+}
+
+func TestPostgresLock_Unlock_DiscardsConnectionWhenNotReleased(t *testing.T) {
+	for name, fake := range map[string]*advisoryDB{
+		"unlock false": {unlockResult: false},
+		"unlock error": {unlockErr: errors.New("connection reset")},
+	} {
+		db := sql.OpenDB(fake)
+
+		l, acquired, err := NewPostgresLocker(db).TryLock(context.Background(), "key")
+		if err != nil || !acquired {
+			t.Fatalf("%s: expected lock to be acquired, got acquired=%v err=%v", name, acquired, err)
+		}
+
+		if err := l.Unlock(context.Background()); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+		if fake.closed.Load() != 1 || db.Stats().OpenConnections != 0 {
+			t.Errorf("%s: expected connection to be discarded, got closed=%d open=%d", name, fake.closed.Load(), db.Stats().OpenConnections)
+		}
+		db.Close()
+	}
+}
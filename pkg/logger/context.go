@@ -4,7 +4,11 @@ import "context"
 
 type contextKey string
 
-const requestIDKey contextKey = "request_id"
+const (
+	requestIDKey      contextKey = "request_id"
+	organizationIDKey contextKey = "organization_id"
+	userIDKey         contextKey = "user_id"
+)
 
 func WithRequestID(ctx context.Context, requestID string) context.Context {
 	return context.WithValue(ctx, requestIDKey, requestID)
@@ -16,3 +20,25 @@ func ExtractRequestID(ctx context.Context) string {
 	}
 	return ""
 }
+
+func WithOrganizationID(ctx context.Context, organizationID string) context.Context {
+	return context.WithValue(ctx, organizationIDKey, organizationID)
+}
+
+func ExtractOrganizationID(ctx context.Context) string {
+	if organizationID, ok := ctx.Value(organizationIDKey).(string); ok {
+		return organizationID
+	}
+	return ""
+}
+
+func WithUserID(ctx context.Context, userID string) context.Context {
+	return context.WithValue(ctx, userIDKey, userID)
+}
+
+func ExtractUserID(ctx context.Context) string {
+	if userID, ok := ctx.Value(userIDKey).(string); ok {
+		return userID
+	}
+	return ""
+}
@@ -0,0 +1,155 @@
+package logger
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"sync/atomic"
+
+	"github.com/rs/zerolog"
+)
+
+// LevelController holds the base level and per-component overrides. It is
+// safe to change levels while the service is running.
+type LevelController struct {
+	mu         sync.RWMutex
+	base       zerolog.Level
+	components map[string]zerolog.Level
+}
+
+func NewLevelController(level string) *LevelController {
+	return &LevelController{
+		base:       parseLogLevel(level),
+		components: make(map[string]zerolog.Level),
+	}
+}
+
+// Enabled reports whether a line at level should be written for component.
+func (c *LevelController) Enabled(component string, level zerolog.Level) bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	threshold := c.base
+	if override, ok := c.components[component]; ok && component != "" {
+		threshold = override
+	}
+	return level >= threshold
+}
+
+func (c *LevelController) SetLevel(level string) error {
+	parsed, err := parseLevelStrict(level)
+	if err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.base = parsed
+	return nil
+}
+
+func (c *LevelController) SetComponentLevel(component, level string) error {
+	parsed, err := parseLevelStrict(level)
+	if err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.components[component] = parsed
+	return nil
+}
+
+func (c *LevelController) ResetComponentLevel(component string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.components, component)
+}
+
+// LevelsSnapshot is the JSON shape served and accepted by Handler.
+type LevelsSnapshot struct {
+	Level      string            `json:"level"`
+	Components map[string]string `json:"components,omitempty"`
+}
+
+func (c *LevelController) Snapshot() LevelsSnapshot {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	snapshot := LevelsSnapshot{
+		Level:      c.base.String(),
+		Components: make(map[string]string, len(c.components)),
+	}
+	for component, level := range c.components {
+		snapshot.Components[component] = level.String()
+	}
+	return snapshot
+}
+
+// Handler serves the current levels on GET. PUT accepts a LevelsSnapshot;
+// an empty component level removes that override.
+func (c *LevelController) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+		case http.MethodPut:
+			var req LevelsSnapshot
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				http.Error(w, "invalid request body", http.StatusBadRequest)
+				return
+			}
+			if err := c.apply(req); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+		default:
+			w.Header().Set("Allow", "GET, PUT")
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(c.Snapshot())
+	})
+}
+
+func (c *LevelController) apply(req LevelsSnapshot) error {
+	if req.Level != "" {
+		if err := c.SetLevel(req.Level); err != nil {
+			return err
+		}
+	}
+	for component, level := range req.Components {
+		if level == "" {
+			c.ResetComponentLevel(component)
+			continue
+		}
+		if err := c.SetComponentLevel(component, level); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func parseLevelStrict(level string) (zerolog.Level, error) {
+	switch level {
+	case "debug", "info", "warn", "error", "fatal":
+		return parseLogLevel(level), nil
+	default:
+		return zerolog.NoLevel, fmt.Errorf("unknown log level %q", level)
+	}
+}
+
+// debugSampler keeps one in rate debug lines.
+type debugSampler struct {
+	rate    uint32
+	counter atomic.Uint32
+}
+
+func (s *debugSampler) sample() bool {
+	if s.rate <= 1 {
+		return true
+	}
+	return s.counter.Add(1)%s.rate == 1
+}
@@ -2,10 +2,12 @@ package logger
 
 import (
 	"context"
+	"io"
 	"os"
-	"time"
 
 	"github.com/rs/zerolog"
+
+	"github.com/giia/giia-core-engine/pkg/redact"
 )
 
 type Tags map[string]interface{}
@@ -21,13 +23,18 @@ type Logger interface {
 type ZerologLogger struct {
 	logger      zerolog.Logger
 	serviceName string
+	component   string
+	levels      *LevelController
+	sampler     *debugSampler
+	redactor    *redact.Redactor
 }
 
 func New(serviceName string, logLevel string) *ZerologLogger {
-	level := parseLogLevel(logLevel)
-	zerolog.SetGlobalLevel(level)
+	return NewWithOptions(serviceName, Options{Level: logLevel})
+}
 
-	logger := zerolog.New(os.Stdout).
+func newZerologLogger(serviceName string, output io.Writer, logLevel string) *ZerologLogger {
+	logger := zerolog.New(output).
 		With().
 		Timestamp().
 		Str("service", serviceName).
@@ -36,9 +43,31 @@ func New(serviceName string, logLevel string) *ZerologLogger {
 	return &ZerologLogger{
 		logger:      logger,
 		serviceName: serviceName,
+		levels:      NewLevelController(logLevel),
+		sampler:     &debugSampler{},
+		redactor:    redact.Default(),
+	}
+}
+
+// Named returns a logger for a component (package, subsystem) that adds a
+// "component" field and honors per-component level overrides. Named loggers
+// share the level controller and sampler of their parent.
+func (l *ZerologLogger) Named(component string) *ZerologLogger {
+	return &ZerologLogger{
+		logger:      l.logger.With().Str("component", component).Logger(),
+		serviceName: l.serviceName,
+		component:   component,
+		levels:      l.levels,
+		sampler:     l.sampler,
+		redactor:    l.redactor,
 	}
 }
 
+// Levels returns the controller used to change levels at runtime.
+func (l *ZerologLogger) Levels() *LevelController {
+	return l.levels
+}
+
 func parseLogLevel(level string) zerolog.Level {
 	switch level {
 	case "debug":
@@ -57,42 +86,64 @@ func parseLogLevel(level string) zerolog.Level {
 }
 
 func (l *ZerologLogger) Debug(ctx context.Context, msg string, tags Tags) {
+	if !l.levels.Enabled(l.component, zerolog.DebugLevel) || !l.sampler.sample() {
+		return
+	}
 	event := l.logger.Debug()
 	event = l.addContextFields(ctx, event, tags)
-	event.Msg(msg)
+	event.Msg(l.message(msg))
 }
 
 func (l *ZerologLogger) Info(ctx context.Context, msg string, tags Tags) {
+	if !l.levels.Enabled(l.component, zerolog.InfoLevel) {
+		return
+	}
 	event := l.logger.Info()
 	event = l.addContextFields(ctx, event, tags)
-	event.Msg(msg)
+	event.Msg(l.message(msg))
 }
 
 func (l *ZerologLogger) Warn(ctx context.Context, msg string, tags Tags) {
+	if !l.levels.Enabled(l.component, zerolog.WarnLevel) {
+		return
+	}
 	event := l.logger.Warn()
 	event = l.addContextFields(ctx, event, tags)
-	event.Msg(msg)
+	event.Msg(l.message(msg))
 }
 
 func (l *ZerologLogger) Error(ctx context.Context, err error, msg string, tags Tags) {
+	if !l.levels.Enabled(l.component, zerolog.ErrorLevel) {
+		return
+	}
 	event := l.logger.Error().Err(err)
 	event = l.addContextFields(ctx, event, tags)
-	event.Msg(msg)
+	event.Msg(l.message(msg))
 }
 
 func (l *ZerologLogger) Fatal(ctx context.Context, err error, msg string, tags Tags) {
 	event := l.logger.Fatal().Err(err)
 	event = l.addContextFields(ctx, event, tags)
-	event.Msg(msg)
+	event.Msg(l.message(msg))
 }
 
 func (l *ZerologLogger) addContextFields(ctx context.Context, event *zerolog.Event, tags Tags) *zerolog.Event {
-	if requestID := ExtractRequestID(ctx); requestID != "" {
-		event = event.Str("request_id", requestID)
+	contextFields := map[string]string{
+		"request_id":      ExtractRequestID(ctx),
+		"organization_id": ExtractOrganizationID(ctx),
+		"user_id":         ExtractUserID(ctx),
+	}
+	for _, key := range []string{"request_id", "organization_id", "user_id"} {
+		if _, tagged := tags[key]; contextFields[key] != "" && !tagged {
+			event = event.Str(key, contextFields[key])
+		}
 	}
 
 	if tags != nil {
 		for key, value := range tags {
+			if l.redactor != nil {
+				value = l.redactor.Value(key, value)
+			}
 			event = event.Interface(key, value)
 		}
 	}
@@ -100,10 +151,15 @@ func (l *ZerologLogger) addContextFields(ctx context.Context, event *zerolog.Eve
 	return event
 }
 
-func NewWithConfig(serviceName string, logLevel string, logToFile bool, logFilePath string) (*ZerologLogger, error) {
-	level := parseLogLevel(logLevel)
-	zerolog.SetGlobalLevel(level)
+// message masks tokens embedded in free text, e.g. fmt.Sprintf'd messages.
+func (l *ZerologLogger) message(msg string) string {
+	if l.redactor == nil {
+		return msg
+	}
+	return redact.String(msg)
+}
 
+func NewWithConfig(serviceName string, logLevel string, logToFile bool, logFilePath string) (*ZerologLogger, error) {
 	var output = os.Stdout
 	if logToFile && logFilePath != "" {
 		file, err := os.OpenFile(logFilePath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
@@ -113,30 +169,9 @@ func NewWithConfig(serviceName string, logLevel string, logToFile bool, logFileP
 		output = file
 	}
 
-	logger := zerolog.New(output).
-		With().
-		Timestamp().
-		Str("service", serviceName).
-		Logger()
-
-	return &ZerologLogger{
-		logger:      logger,
-		serviceName: serviceName,
-	}, nil
+	return newZerologLogger(serviceName, output, logLevel), nil
 }
 
 func NewConsoleLogger(serviceName string) *ZerologLogger {
-	logger := zerolog.New(zerolog.ConsoleWriter{
-		Out:        os.Stdout,
-		TimeFormat: time.RFC3339,
-	}).
-		With().
-		Timestamp().
-		Str("service", serviceName).
-		Logger()
-
-	return &ZerologLogger{
-		logger:      logger,
-		serviceName: serviceName,
-	}
+	return NewWithOptions(serviceName, Options{Format: FormatConsole, Level: "debug"})
 }
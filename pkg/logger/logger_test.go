@@ -0,0 +1,137 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func decodeLines(t *testing.T, buf *bytes.Buffer) []map[string]interface{} {
+	var lines []map[string]interface{}
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		if line == "" {
+			continue
+		}
+		var entry map[string]interface{}
+		require.NoError(t, json.Unmarshal([]byte(line), &entry))
+		lines = append(lines, entry)
+	}
+	return lines
+}
+
+func TestLogger_AddsContextFields(t *testing.T) {
+	buf := &bytes.Buffer{}
+	log := NewWithOptions("test-service", Options{Level: "info", Output: buf})
+
+	ctx := WithRequestID(context.Background(), "req-1")
+	ctx = WithOrganizationID(ctx, "org-1")
+	ctx = WithUserID(ctx, "user-1")
+
+	log.Info(ctx, "hello", Tags{"user_id": "explicit"})
+
+	lines := decodeLines(t, buf)
+	require.Len(t, lines, 1)
+	assert.Equal(t, "req-1", lines[0]["request_id"])
+	assert.Equal(t, "org-1", lines[0]["organization_id"])
+	assert.Equal(t, "explicit", lines[0]["user_id"])
+	assert.Equal(t, "test-service", lines[0]["service"])
+}
+
+func TestLogger_ComponentLevelOverride(t *testing.T) {
+	buf := &bytes.Buffer{}
+	log := NewWithOptions("test-service", Options{
+		Level:           "info",
+		ComponentLevels: map[string]string{"grpc": "debug"},
+		Output:          buf,
+	})
+	ctx := context.Background()
+
+	log.Debug(ctx, "root debug", nil)
+	log.Named("grpc").Debug(ctx, "grpc debug", nil)
+	log.Named("repositories").Debug(ctx, "repositories debug", nil)
+
+	lines := decodeLines(t, buf)
+	require.Len(t, lines, 1)
+	assert.Equal(t, "grpc debug", lines[0]["message"])
+	assert.Equal(t, "grpc", lines[0]["component"])
+
+	require.NoError(t, log.Levels().SetLevel("error"))
+	log.Named("repositories").Warn(ctx, "dropped", nil)
+	assert.Len(t, decodeLines(t, buf), 1)
+}
+
+func TestLogger_SamplesDebugLines(t *testing.T) {
+	buf := &bytes.Buffer{}
+	log := NewWithOptions("test-service", Options{Level: "debug", DebugSampleRate: 10, Output: buf})
+	ctx := context.Background()
+
+	for i := 0; i < 100; i++ {
+		log.Debug(ctx, "hot path", nil)
+	}
+	log.Info(ctx, "not sampled", nil)
+
+	assert.Len(t, decodeLines(t, buf), 11)
+}
+
+func TestLevelController_Handler(t *testing.T) {
+	controller := NewLevelController("info")
+	handler := controller.Handler()
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPut, "/log-levels",
+		strings.NewReader(`{"components":{"grpc":"debug"}}`)))
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "debug", controller.Snapshot().Components["grpc"])
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPut, "/log-levels",
+		strings.NewReader(`{"components":{"grpc":""}}`)))
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Empty(t, controller.Snapshot().Components)
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPut, "/log-levels",
+		strings.NewReader(`{"level":"verbose"}`)))
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestOptionsFromEnv(t *testing.T) {
+	t.Setenv("LOG_LEVEL", "warn")
+	t.Setenv("LOG_FORMAT", FormatConsole)
+	t.Setenv("LOG_DEBUG_SAMPLE_RATE", "50")
+	t.Setenv("LOG_COMPONENT_LEVELS", "grpc=debug, repositories=error")
+
+	opts := OptionsFromEnv()
+
+	assert.Equal(t, "warn", opts.Level)
+	assert.Equal(t, FormatConsole, opts.Format)
+	assert.Equal(t, uint32(50), opts.DebugSampleRate)
+	assert.Equal(t, map[string]string{"grpc": "debug", "repositories": "error"}, opts.ComponentLevels)
+}
+
+func TestLogger_RedactsSensitiveTags(t *testing.T) {
+	buf := &bytes.Buffer{}
+	log := NewWithOptions("test-service", Options{Level: "info", RedactFields: []string{"cuit"}, Output: buf})
+
+	log.Info(context.Background(), "Password reset token: eyJhbGciOiJIUzI1NiJ9.eyJzdWIiOiIxIn0.c2ln", Tags{
+		"email":        "jane@example.com",
+		"access_token": "abc",
+		"cuit":         "20-12345678-9",
+		"attempts":     2,
+	})
+
+	lines := decodeLines(t, buf)
+	require.Len(t, lines, 1)
+	assert.Equal(t, "Password reset token: [REDACTED]", lines[0]["message"])
+	assert.Equal(t, "j***@example.com", lines[0]["email"])
+	assert.Equal(t, "[REDACTED]", lines[0]["access_token"])
+	assert.Equal(t, "[REDACTED]", lines[0]["cuit"])
+	assert.Equal(t, float64(2), lines[0]["attempts"])
+}
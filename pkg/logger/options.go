@@ -0,0 +1,93 @@
+package logger
+
+import (
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+const (
+	FormatJSON    = "json"
+	FormatConsole = "console"
+)
+
+type Options struct {
+	// Level is the base level: debug, info, warn, error or fatal.
+	Level string
+	// ComponentLevels overrides Level for loggers created with Named.
+	ComponentLevels map[string]string
+	// DebugSampleRate keeps one in N debug lines; 0 or 1 keeps all of them.
+	DebugSampleRate uint32
+	// Format is FormatJSON (default) or FormatConsole for local development.
+	Format string
+	// Output defaults to stdout.
+	Output io.Writer
+	// RedactFields are masked in addition to redact.DefaultFields.
+	RedactFields []string
+	// DisableRedaction writes tags and messages unmasked (local debugging only).
+	DisableRedaction bool
+}
+
+// OptionsFromEnv reads LOG_LEVEL, LOG_FORMAT, LOG_DEBUG_SAMPLE_RATE,
+// LOG_COMPONENT_LEVELS ("grpc=debug,repositories=warn") and LOG_REDACT_FIELDS
+// ("cuit,phone").
+func OptionsFromEnv() Options {
+	opts := Options{
+		Level:  os.Getenv("LOG_LEVEL"),
+		Format: os.Getenv("LOG_FORMAT"),
+	}
+
+	if rate, err := strconv.ParseUint(os.Getenv("LOG_DEBUG_SAMPLE_RATE"), 10, 32); err == nil {
+		opts.DebugSampleRate = uint32(rate)
+	}
+
+	if overrides := os.Getenv("LOG_COMPONENT_LEVELS"); overrides != "" {
+		opts.ComponentLevels = make(map[string]string)
+		for _, pair := range strings.Split(overrides, ",") {
+			component, level, found := strings.Cut(strings.TrimSpace(pair), "=")
+			if found && component != "" {
+				opts.ComponentLevels[component] = level
+			}
+		}
+	}
+
+	if fields := os.Getenv("LOG_REDACT_FIELDS"); fields != "" {
+		for _, field := range strings.Split(fields, ",") {
+			if field = strings.TrimSpace(field); field != "" {
+				opts.RedactFields = append(opts.RedactFields, field)
+			}
+		}
+	}
+
+	return opts
+}
+
+func NewWithOptions(serviceName string, opts Options) *ZerologLogger {
+	output := opts.Output
+	if output == nil {
+		output = os.Stdout
+	}
+	if opts.Format == FormatConsole {
+		output = zerolog.ConsoleWriter{
+			Out:        output,
+			TimeFormat: time.RFC3339,
+		}
+	}
+
+	l := newZerologLogger(serviceName, output, opts.Level)
+	l.sampler.rate = opts.DebugSampleRate
+	if opts.DisableRedaction {
+		l.redactor = nil
+	} else if len(opts.RedactFields) > 0 {
+		l.redactor = l.redactor.With(opts.RedactFields...)
+	}
+	for component, level := range opts.ComponentLevels {
+		_ = l.levels.SetComponentLevel(component, level)
+	}
+
+	return l
+}
@@ -0,0 +1,110 @@
+package mailer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/mail"
+	"strings"
+)
+
+const (
+	ProviderSMTP     = "smtp"
+	ProviderSendGrid = "sendgrid"
+	ProviderSES      = "ses"
+)
+
+var (
+	ErrNoRecipients  = errors.New("mailer: message has no recipients")
+	ErrEmptySubject  = errors.New("mailer: message subject is empty")
+	ErrEmptyBody     = errors.New("mailer: message has neither HTML nor text body")
+	ErrInvalidSender = errors.New("mailer: sender address is not configured")
+)
+
+type Message struct {
+	To       []string
+	From     string
+	FromName string
+	ReplyTo  string
+	Subject  string
+	HTMLBody string
+	TextBody string
+	Category string
+}
+
+type SendResult struct {
+	Provider          string
+	ProviderMessageID string
+}
+
+type Mailer interface {
+	Send(ctx context.Context, msg *Message) (*SendResult, error)
+}
+
+func (m *Message) Validate() error {
+	if len(m.To) == 0 {
+		return ErrNoRecipients
+	}
+
+	for _, to := range m.To {
+		if err := validateAddress(to); err != nil {
+			return fmt.Errorf("mailer: invalid recipient address %q: %w", to, err)
+		}
+	}
+
+	if m.From != "" {
+		if err := validateAddress(m.From); err != nil {
+			return fmt.Errorf("mailer: invalid sender address %q: %w", m.From, err)
+		}
+	}
+
+	if m.ReplyTo != "" {
+		if err := validateAddress(m.ReplyTo); err != nil {
+			return fmt.Errorf("mailer: invalid reply-to address %q: %w", m.ReplyTo, err)
+		}
+	}
+
+	if m.Subject == "" {
+		return ErrEmptySubject
+	}
+
+	if m.HTMLBody == "" && m.TextBody == "" {
+		return ErrEmptyBody
+	}
+
+	return nil
+}
+
+// validateAddress rejects CR and LF explicitly because some adapters write
+// addresses into raw headers.
+func validateAddress(address string) error {
+	if strings.ContainsAny(address, "\r\n") {
+		return errors.New("address contains a line break")
+	}
+	_, err := mail.ParseAddress(address)
+	return err
+}
+
+func senderOrDefault(msg *Message, defaultFrom, defaultFromName string) (string, string, error) {
+	from := msg.From
+	fromName := msg.FromName
+	if from == "" {
+		from = defaultFrom
+		if fromName == "" {
+			fromName = defaultFromName
+		}
+	}
+
+	if from == "" {
+		return "", "", ErrInvalidSender
+	}
+
+	return from, fromName, nil
+}
+
+func formatAddress(email, name string) string {
+	if name == "" {
+		return email
+	}
+	return (&mail.Address{Name: name, Address: email}).String()
+}
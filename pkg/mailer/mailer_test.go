@@ -0,0 +1,505 @@
+package mailer
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/smtp"
+	"strings"
+	"testing"
+	"time"
+)
+
+type memorySendLog struct {
+	entries []*SendLogEntry
+	updates []string
+}
+
+func (s *memorySendLog) Record(ctx context.Context, entry *SendLogEntry) error {
+	s.entries = append(s.entries, entry)
+	return nil
+}
+
+func (s *memorySendLog) UpdateStatus(ctx context.Context, provider, providerMessageID, recipient string, status SendStatus, reason string) error {
+	s.updates = append(s.updates, provider+"|"+providerMessageID+"|"+recipient+"|"+string(status))
+	return nil
+}
+
+func TestMessageValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		msg     *Message
+		wantErr error
+	}{
+		{"no recipients", &Message{Subject: "s", TextBody: "b"}, ErrNoRecipients},
+		{"empty subject", &Message{To: []string{"a@example.com"}, TextBody: "b"}, ErrEmptySubject},
+		{"empty body", &Message{To: []string{"a@example.com"}, Subject: "s"}, ErrEmptyBody},
+		{"valid", &Message{To: []string{"a@example.com"}, Subject: "s", HTMLBody: "<p>b</p>"}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.Validate()
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("expected error %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestMessageValidateRejectsHeaderInjection(t *testing.T) {
+	base := Message{To: []string{"a@example.com"}, Subject: "s", TextBody: "b"}
+
+	replyTo := base
+	replyTo.ReplyTo = "a@example.com\r\nBcc: victim@example.com"
+	from := base
+	from.From = "noreply@example.com\nBcc: victim@example.com"
+
+	for _, msg := range []Message{replyTo, from} {
+		if err := msg.Validate(); err == nil {
+			t.Errorf("expected header injection to be rejected: %+v", msg)
+		}
+	}
+}
+
+func TestSMTPMailerSend(t *testing.T) {
+	m := NewSMTPMailer(&SMTPConfig{Host: "smtp.example.com", Port: 587, Username: "user", Password: "pass", From: "noreply@example.com", FromName: "GIIA"})
+
+	var gotAddr string
+	var gotRaw []byte
+	m.sendMail = func(addr string, a smtp.Auth, from string, to []string, msg []byte) error {
+		gotAddr = addr
+		gotRaw = msg
+		return nil
+	}
+
+	result, err := m.Send(context.Background(), &Message{
+		To:       []string{"user@example.com"},
+		Subject:  "Welcome",
+		HTMLBody: "<p>Hello</p>",
+		TextBody: "Hello",
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if gotAddr != "smtp.example.com:587" {
+		t.Errorf("expected addr smtp.example.com:587, got %s", gotAddr)
+	}
+	if result.Provider != ProviderSMTP || result.ProviderMessageID == "" {
+		t.Errorf("unexpected result %+v", result)
+	}
+
+	raw := string(gotRaw)
+	if !strings.Contains(raw, "multipart/alternative") {
+		t.Error("expected multipart/alternative message")
+	}
+	if !strings.Contains(raw, "Subject: Welcome") {
+		t.Error("expected subject header")
+	}
+}
+
+func TestSendGridMailerSend(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer key" {
+			t.Errorf("expected bearer token, got %s", r.Header.Get("Authorization"))
+		}
+
+		var payload sendGridRequest
+		body, _ := io.ReadAll(r.Body)
+		if err := json.Unmarshal(body, &payload); err != nil {
+			t.Fatalf("invalid payload: %v", err)
+		}
+		if payload.Content[0].Type != "text/plain" {
+			t.Errorf("expected text/plain first, got %s", payload.Content[0].Type)
+		}
+
+		w.Header().Set("X-Message-Id", "sg-123")
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	m := NewSendGridMailer(&SendGridConfig{APIKey: "key", From: "noreply@example.com", BaseURL: server.URL})
+
+	result, err := m.Send(context.Background(), &Message{
+		To:       []string{"user@example.com"},
+		Subject:  "Hi",
+		HTMLBody: "<p>Hi</p>",
+		TextBody: "Hi",
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if result.ProviderMessageID != "sg-123" {
+		t.Errorf("expected message id sg-123, got %s", result.ProviderMessageID)
+	}
+}
+
+func TestSESMailerSend(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v2/email/outbound-emails" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		if !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=AKID/") {
+			t.Errorf("expected sigv4 authorization, got %s", r.Header.Get("Authorization"))
+		}
+		_, _ = w.Write([]byte(`{"MessageId":"ses-456"}`))
+	}))
+	defer server.Close()
+
+	m := NewSESMailer(&SESConfig{Region: "us-east-1", AccessKeyID: "AKID", SecretAccessKey: "secret", From: "noreply@example.com", Endpoint: server.URL})
+
+	result, err := m.Send(context.Background(), &Message{To: []string{"user@example.com"}, Subject: "Hi", TextBody: "Hi"})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if result.ProviderMessageID != "ses-456" {
+		t.Errorf("expected message id ses-456, got %s", result.ProviderMessageID)
+	}
+}
+
+func TestTemplateRegistryRender(t *testing.T) {
+	registry := NewTemplateRegistry()
+	err := registry.Register(Template{
+		Name:    "welcome",
+		Subject: "Welcome {{.Name}}",
+		HTML:    "<p>Hello {{.Name}}</p>",
+		Text:    "Hello {{.Name}}",
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	rendered, err := registry.Render("welcome", map[string]string{"Name": "<Ana>"})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if rendered.Subject != "Welcome <Ana>" {
+		t.Errorf("unexpected subject %q", rendered.Subject)
+	}
+	if rendered.HTMLBody != "<p>Hello &lt;Ana&gt;</p>" {
+		t.Errorf("expected escaped HTML, got %q", rendered.HTMLBody)
+	}
+	if rendered.TextBody != "Hello <Ana>" {
+		t.Errorf("unexpected text %q", rendered.TextBody)
+	}
+
+	if _, err := registry.Render("missing", nil); !errors.Is(err, ErrTemplateNotFound) {
+		t.Errorf("expected ErrTemplateNotFound, got %v", err)
+	}
+}
+
+type stubMailer struct {
+	err error
+}
+
+func (m *stubMailer) Send(ctx context.Context, msg *Message) (*SendResult, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	return &SendResult{Provider: "stub", ProviderMessageID: "id-1"}, nil
+}
+
+func TestLoggingMailerRecordsEachRecipient(t *testing.T) {
+	store := &memorySendLog{}
+	m := NewLoggingMailer(&stubMailer{}, store)
+
+	_, err := m.Send(context.Background(), &Message{To: []string{"a@example.com", "b@example.com"}, Subject: "s", TextBody: "b", Category: "digest"})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if len(store.entries) != 2 {
+		t.Fatalf("expected 2 entries, got %d", len(store.entries))
+	}
+	if store.entries[0].Status != SendStatusSent || store.entries[0].ProviderMessageID != "id-1" {
+		t.Errorf("unexpected entry %+v", store.entries[0])
+	}
+}
+
+func TestLoggingMailerRecordsFailure(t *testing.T) {
+	store := &memorySendLog{}
+	m := NewLoggingMailer(&stubMailer{err: errors.New("boom")}, store)
+
+	_, err := m.Send(context.Background(), &Message{To: []string{"a@example.com"}, Subject: "s", TextBody: "b"})
+	if err == nil {
+		t.Fatal("expected error")
+	}
+
+	if len(store.entries) != 1 || store.entries[0].Status != SendStatusFailed || store.entries[0].Error != "boom" {
+		t.Errorf("unexpected entries %+v", store.entries)
+	}
+}
+
+type failingSendLog struct {
+	memorySendLog
+}
+
+func (s *failingSendLog) Record(ctx context.Context, entry *SendLogEntry) error {
+	return errors.New("db down")
+}
+
+func TestLoggingMailerReportsLogFailureWithoutFailingSend(t *testing.T) {
+	m := NewLoggingMailer(&stubMailer{}, &failingSendLog{})
+
+	var reported error
+	m.OnLogError(func(ctx context.Context, err error) {
+		reported = err
+	})
+
+	result, err := m.Send(context.Background(), &Message{To: []string{"a@example.com"}, Subject: "s", TextBody: "b"})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if result == nil || result.ProviderMessageID != "id-1" {
+		t.Errorf("expected send result, got %+v", result)
+	}
+	if reported == nil {
+		t.Error("expected log failure to be reported")
+	}
+}
+
+func TestParseSendGridEvents(t *testing.T) {
+	body := []byte(`[
+		{"email":"a@example.com","event":"bounce","reason":"550 no such user","timestamp":1700000000,"sg_message_id":"abc.filter0001"},
+		{"email":"b@example.com","event":"spamreport","timestamp":1700000000,"sg_message_id":"abc.filter0002"},
+		{"email":"c@example.com","event":"open","timestamp":1700000000,"sg_message_id":"abc.filter0003"}
+	]`)
+
+	events, err := ParseSendGridEvents(body)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if len(events) != 2 {
+		t.Fatalf("expected 2 events, got %d", len(events))
+	}
+	if events[0].Type != DeliveryEventBounce || events[0].ProviderMessageID != "abc" {
+		t.Errorf("unexpected bounce event %+v", events[0])
+	}
+	if events[1].Status() != SendStatusComplained {
+		t.Errorf("expected complained status, got %s", events[1].Status())
+	}
+}
+
+func TestParseSESNotification(t *testing.T) {
+	message := `{"notificationType":"Bounce","mail":{"messageId":"ses-1"},"bounce":{"bounceType":"Permanent","timestamp":"2024-01-01T00:00:00Z","bouncedRecipients":[{"emailAddress":"a@example.com"}]}}`
+	envelope, _ := json.Marshal(map[string]string{"Type": "Notification", "Message": message})
+
+	events, subscription, err := ParseSESNotification(envelope)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if subscription != nil {
+		t.Error("expected no subscription")
+	}
+	if len(events) != 1 || events[0].Recipient != "a@example.com" || events[0].ProviderMessageID != "ses-1" {
+		t.Errorf("unexpected events %+v", events)
+	}
+}
+
+func TestWebhookHandlerRejectsInvalidToken(t *testing.T) {
+	handler := &WebhookHandler{Store: &memorySendLog{}, Token: "secret"}
+
+	req := httptest.NewRequest(http.MethodPost, "/webhooks/email/sendgrid?token=wrong", strings.NewReader("[]"))
+	rec := httptest.NewRecorder()
+	handler.SendGrid().ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusUnauthorized {
+		t.Errorf("expected status %d, got %d", http.StatusUnauthorized, rec.Code)
+	}
+}
+
+func TestWebhookHandlerAppliesSendGridEvents(t *testing.T) {
+	store := &memorySendLog{}
+	handler := &WebhookHandler{Store: store, Token: "secret"}
+
+	body := `[{"email":"a@example.com","event":"bounce","timestamp":1700000000,"sg_message_id":"abc.filter"}]`
+	req := httptest.NewRequest(http.MethodPost, "/webhooks/email/sendgrid?token=secret", strings.NewReader(body))
+	rec := httptest.NewRecorder()
+	handler.SendGrid().ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusNoContent {
+		t.Errorf("expected status %d, got %d", http.StatusNoContent, rec.Code)
+	}
+	if len(store.updates) != 1 || store.updates[0] != "sendgrid|abc|a@example.com|bounced" {
+		t.Errorf("unexpected updates %v", store.updates)
+	}
+}
+
+func TestWebhookHandlerRejectsWhenNoAuthenticationConfigured(t *testing.T) {
+	handler := &WebhookHandler{Store: &memorySendLog{}}
+
+	for name, h := range map[string]http.Handler{"sendgrid": handler.SendGrid(), "ses": handler.SES()} {
+		req := httptest.NewRequest(http.MethodPost, "/webhooks/email/"+name, strings.NewReader("[]"))
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+
+		if rec.Code != http.StatusUnauthorized {
+			t.Errorf("%s: expected status %d, got %d", name, http.StatusUnauthorized, rec.Code)
+		}
+	}
+}
+
+type certTransport struct {
+	pem []byte
+}
+
+func (t *certTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(bytes.NewReader(t.pem)), Header: http.Header{}}, nil
+}
+
+func signedSNSNotification(t *testing.T, key *rsa.PrivateKey, message string) []byte {
+	t.Helper()
+	return signedSNSMessage(t, key, "Notification", "arn:aws:sns:us-east-1:123456789012:ses-events", message)
+}
+
+func signedSNSMessage(t *testing.T, key *rsa.PrivateKey, msgType, topicARN, message string) []byte {
+	t.Helper()
+	msg := snsSignedMessage{
+		Type:             msgType,
+		MessageID:        "msg-1",
+		TopicArn:         topicARN,
+		Message:          message,
+		Timestamp:        "2024-01-01T00:00:00.000Z",
+		SignatureVersion: "2",
+		SigningCertURL:   "https://sns.us-east-1.amazonaws.com/SimpleNotificationService-test.pem",
+	}
+	if msgType == "SubscriptionConfirmation" {
+		msg.Token = "subscription-token"
+		msg.SubscribeURL = "https://sns.us-east-1.amazonaws.com/?Action=ConfirmSubscription&Token=subscription-token"
+	}
+	canonical, err := msg.stringToSign()
+	if err != nil {
+		t.Fatalf("failed to build string to sign: %v", err)
+	}
+	digest := sha256.Sum256([]byte(canonical))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	if err != nil {
+		t.Fatalf("failed to sign: %v", err)
+	}
+	msg.Signature = base64.StdEncoding.EncodeToString(signature)
+
+	body, _ := json.Marshal(msg)
+	return body
+}
+
+func TestWebhookHandlerVerifiesSNSSignature(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	template := &x509.Certificate{SerialNumber: big.NewInt(1), NotBefore: time.Now().Add(-time.Hour), NotAfter: time.Now().Add(time.Hour)}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("failed to create certificate: %v", err)
+	}
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+
+	message := `{"notificationType":"Bounce","mail":{"messageId":"ses-1"},"bounce":{"bounceType":"Permanent","timestamp":"2024-01-01T00:00:00Z","bouncedRecipients":[{"emailAddress":"a@example.com"}]}}`
+	body := signedSNSNotification(t, key, message)
+
+	store := &memorySendLog{}
+	handler := &WebhookHandler{
+		Store:              store,
+		VerifySNSSignature: true,
+		AllowedTopicARNs:   []string{"arn:aws:sns:us-east-1:123456789012:ses-events"},
+		HTTPClient:         &http.Client{Transport: &certTransport{pem: certPEM}},
+	}
+
+	rec := httptest.NewRecorder()
+	handler.SES().ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/webhooks/email/ses", bytes.NewReader(body)))
+	if rec.Code != http.StatusNoContent {
+		t.Fatalf("expected status %d, got %d", http.StatusNoContent, rec.Code)
+	}
+	if len(store.updates) != 1 {
+		t.Errorf("expected 1 update, got %v", store.updates)
+	}
+
+	tampered := bytes.Replace(body, []byte("a@example.com"), []byte("b@example.com"), 1)
+	rec = httptest.NewRecorder()
+	handler.SES().ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/webhooks/email/ses", bytes.NewReader(tampered)))
+	if rec.Code != http.StatusUnauthorized {
+		t.Errorf("expected tampered message to be rejected, got %d", rec.Code)
+	}
+}
+
+// countingTransport serves the signing certificate and counts every request,
+// so tests can tell whether a subscription was confirmed.
+type countingTransport struct {
+	pem      []byte
+	requests []string
+}
+
+func (t *countingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.requests = append(t.requests, req.URL.String())
+	return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(bytes.NewReader(t.pem)), Header: http.Header{}}, nil
+}
+
+func TestWebhookHandlerRejectsSNSTopicsOutsideAllowList(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	template := &x509.Certificate{SerialNumber: big.NewInt(1), NotBefore: time.Now().Add(-time.Hour), NotAfter: time.Now().Add(time.Hour)}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("failed to create certificate: %v", err)
+	}
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+
+	foreignTopic := "arn:aws:sns:us-east-1:999999999999:attacker"
+	message := `{"notificationType":"Bounce","mail":{"messageId":"ses-1"},"bounce":{"bounceType":"Permanent","timestamp":"2024-01-01T00:00:00Z","bouncedRecipients":[{"emailAddress":"a@example.com"}]}}`
+
+	for name, body := range map[string][]byte{
+		"subscription": signedSNSMessage(t, key, "SubscriptionConfirmation", foreignTopic, "confirm"),
+		"notification": signedSNSMessage(t, key, "Notification", foreignTopic, message),
+	} {
+		store := &memorySendLog{}
+		transport := &countingTransport{pem: certPEM}
+		handler := &WebhookHandler{
+			Store:              store,
+			VerifySNSSignature: true,
+			AllowedTopicARNs:   []string{"arn:aws:sns:us-east-1:123456789012:ses-events"},
+			HTTPClient:         &http.Client{Transport: transport},
+		}
+
+		rec := httptest.NewRecorder()
+		handler.SES().ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/webhooks/email/ses", bytes.NewReader(body)))
+
+		if rec.Code != http.StatusUnauthorized {
+			t.Errorf("%s: expected status %d, got %d", name, http.StatusUnauthorized, rec.Code)
+		}
+		if len(transport.requests) != 0 {
+			t.Errorf("%s: expected no outbound requests, got %v", name, transport.requests)
+		}
+		if len(store.updates) != 0 {
+			t.Errorf("%s: expected no updates, got %v", name, store.updates)
+		}
+	}
+}
+
+func TestWebhookHandlerValidateRequiresAllowedTopicsWithSNSVerification(t *testing.T) {
+	if err := (&WebhookHandler{VerifySNSSignature: true}).Validate(); !errors.Is(err, ErrNoAllowedTopics) {
+		t.Errorf("expected ErrNoAllowedTopics, got %v", err)
+	}
+	if err := (&WebhookHandler{VerifySNSSignature: true, AllowedTopicARNs: []string{"arn:aws:sns:us-east-1:123456789012:ses-events"}}).Validate(); err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+	if err := (&WebhookHandler{Token: "secret"}).Validate(); err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+}
@@ -0,0 +1,131 @@
+package mailer
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+const defaultSendGridBaseURL = "https://api.sendgrid.com"
+
+type SendGridConfig struct {
+	APIKey     string
+	From       string
+	FromName   string
+	BaseURL    string
+	HTTPClient *http.Client
+}
+
+type SendGridMailer struct {
+	config *SendGridConfig
+	client *http.Client
+}
+
+func NewSendGridMailer(config *SendGridConfig) *SendGridMailer {
+	client := config.HTTPClient
+	if client == nil {
+		client = &http.Client{Timeout: 10 * time.Second}
+	}
+
+	if config.BaseURL == "" {
+		config.BaseURL = defaultSendGridBaseURL
+	}
+
+	return &SendGridMailer{
+		config: config,
+		client: client,
+	}
+}
+
+type sendGridAddress struct {
+	Email string `json:"email"`
+	Name  string `json:"name,omitempty"`
+}
+
+type sendGridContent struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+type sendGridPersonalization struct {
+	To []sendGridAddress `json:"to"`
+}
+
+type sendGridRequest struct {
+	Personalizations []sendGridPersonalization `json:"personalizations"`
+	From             sendGridAddress           `json:"from"`
+	ReplyTo          *sendGridAddress          `json:"reply_to,omitempty"`
+	Subject          string                    `json:"subject"`
+	Content          []sendGridContent         `json:"content"`
+	Categories       []string                  `json:"categories,omitempty"`
+}
+
+func (m *SendGridMailer) Send(ctx context.Context, msg *Message) (*SendResult, error) {
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+
+	from, fromName, err := senderOrDefault(msg, m.config.From, m.config.FromName)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := sendGridRequest{
+		From:    sendGridAddress{Email: from, Name: fromName},
+		Subject: msg.Subject,
+	}
+
+	recipients := make([]sendGridAddress, len(msg.To))
+	for i, to := range msg.To {
+		recipients[i] = sendGridAddress{Email: to}
+	}
+	payload.Personalizations = []sendGridPersonalization{{To: recipients}}
+
+	if msg.ReplyTo != "" {
+		payload.ReplyTo = &sendGridAddress{Email: msg.ReplyTo}
+	}
+
+	// SendGrid requires text/plain to precede text/html
+	if msg.TextBody != "" {
+		payload.Content = append(payload.Content, sendGridContent{Type: "text/plain", Value: msg.TextBody})
+	}
+	if msg.HTMLBody != "" {
+		payload.Content = append(payload.Content, sendGridContent{Type: "text/html", Value: msg.HTMLBody})
+	}
+
+	if msg.Category != "" {
+		payload.Categories = []string{msg.Category}
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to serialize sendgrid request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, m.config.BaseURL+"/v3/mail/send", bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to build sendgrid request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+m.config.APIKey)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := m.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send email via sendgrid: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusMultipleChoices {
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+		return nil, fmt.Errorf("sendgrid rejected email with status %d: %s", resp.StatusCode, string(respBody))
+	}
+
+	return &SendResult{
+		Provider:          ProviderSendGrid,
+		ProviderMessageID: resp.Header.Get("X-Message-Id"),
+	}, nil
+}
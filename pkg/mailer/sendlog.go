@@ -0,0 +1,103 @@
+package mailer
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+type SendStatus string
+
+const (
+	SendStatusSent       SendStatus = "sent"
+	SendStatusFailed     SendStatus = "failed"
+	SendStatusDelivered  SendStatus = "delivered"
+	SendStatusBounced    SendStatus = "bounced"
+	SendStatusComplained SendStatus = "complained"
+	SendStatusDropped    SendStatus = "dropped"
+)
+
+type SendLogEntry struct {
+	Provider          string
+	ProviderMessageID string
+	Recipient         string
+	Subject           string
+	Category          string
+	Status            SendStatus
+	Error             string
+	SentAt            time.Time
+}
+
+// SendLogStore persists one entry per recipient and receives delivery status
+// updates from provider webhooks.
+type SendLogStore interface {
+	Record(ctx context.Context, entry *SendLogEntry) error
+	UpdateStatus(ctx context.Context, provider, providerMessageID, recipient string, status SendStatus, reason string) error
+}
+
+type LoggingMailer struct {
+	next       Mailer
+	store      SendLogStore
+	now        func() time.Time
+	onLogError func(ctx context.Context, err error)
+}
+
+func NewLoggingMailer(next Mailer, store SendLogStore) *LoggingMailer {
+	return &LoggingMailer{
+		next:  next,
+		store: store,
+		now:   time.Now,
+	}
+}
+
+// OnLogError is called when a send log entry cannot be written, e.g. to log
+// it. The send itself is still reported as successful.
+func (m *LoggingMailer) OnLogError(fn func(ctx context.Context, err error)) {
+	m.onLogError = fn
+}
+
+func (m *LoggingMailer) Send(ctx context.Context, msg *Message) (*SendResult, error) {
+	result, sendErr := m.next.Send(ctx, msg)
+
+	status := SendStatusSent
+	errMessage := ""
+	provider := ""
+	providerMessageID := ""
+	if sendErr != nil {
+		status = SendStatusFailed
+		errMessage = sendErr.Error()
+	}
+	if result != nil {
+		provider = result.Provider
+		providerMessageID = result.ProviderMessageID
+	}
+
+	var logErr error
+	for _, to := range msg.To {
+		entry := &SendLogEntry{
+			Provider:          provider,
+			ProviderMessageID: providerMessageID,
+			Recipient:         to,
+			Subject:           msg.Subject,
+			Category:          msg.Category,
+			Status:            status,
+			Error:             errMessage,
+			SentAt:            m.now().UTC(),
+		}
+		if err := m.store.Record(ctx, entry); err != nil && logErr == nil {
+			logErr = err
+		}
+	}
+
+	if sendErr != nil {
+		return nil, sendErr
+	}
+
+	// The email is already out; failing here would make callers retry and
+	// send it twice.
+	if logErr != nil && m.onLogError != nil {
+		m.onLogError(ctx, fmt.Errorf("mailer: email sent but send log could not be recorded: %w", logErr))
+	}
+
+	return result, nil
+}
@@ -0,0 +1,153 @@
+package mailer
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+type SESConfig struct {
+	Region          string
+	AccessKeyID     string
+	SecretAccessKey string
+	SessionToken    string
+	From            string
+	FromName        string
+	Endpoint        string
+	HTTPClient      *http.Client
+}
+
+// SESMailer sends through the SES v2 SendEmail API using SigV4-signed requests,
+// avoiding a dependency on the AWS SDK.
+type SESMailer struct {
+	config *SESConfig
+	client *http.Client
+	now    func() time.Time
+}
+
+func NewSESMailer(config *SESConfig) *SESMailer {
+	client := config.HTTPClient
+	if client == nil {
+		client = &http.Client{Timeout: 10 * time.Second}
+	}
+
+	if config.Endpoint == "" {
+		config.Endpoint = fmt.Sprintf("https://email.%s.amazonaws.com", config.Region)
+	}
+
+	return &SESMailer{
+		config: config,
+		client: client,
+		now:    time.Now,
+	}
+}
+
+type sesContent struct {
+	Data    string `json:"Data"`
+	Charset string `json:"Charset,omitempty"`
+}
+
+type sesBody struct {
+	Html *sesContent `json:"Html,omitempty"`
+	Text *sesContent `json:"Text,omitempty"`
+}
+
+type sesSimple struct {
+	Subject sesContent `json:"Subject"`
+	Body    sesBody    `json:"Body"`
+}
+
+type sesEmailTag struct {
+	Name  string `json:"Name"`
+	Value string `json:"Value"`
+}
+
+type sesRequest struct {
+	FromEmailAddress string `json:"FromEmailAddress"`
+	Destination      struct {
+		ToAddresses []string `json:"ToAddresses"`
+	} `json:"Destination"`
+	ReplyToAddresses []string `json:"ReplyToAddresses,omitempty"`
+	Content          struct {
+		Simple sesSimple `json:"Simple"`
+	} `json:"Content"`
+	EmailTags []sesEmailTag `json:"EmailTags,omitempty"`
+}
+
+type sesResponse struct {
+	MessageID string `json:"MessageId"`
+}
+
+func (m *SESMailer) Send(ctx context.Context, msg *Message) (*SendResult, error) {
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+
+	from, fromName, err := senderOrDefault(msg, m.config.From, m.config.FromName)
+	if err != nil {
+		return nil, err
+	}
+
+	var payload sesRequest
+	payload.FromEmailAddress = formatAddress(from, fromName)
+	payload.Destination.ToAddresses = msg.To
+	if msg.ReplyTo != "" {
+		payload.ReplyToAddresses = []string{msg.ReplyTo}
+	}
+	payload.Content.Simple.Subject = sesContent{Data: msg.Subject, Charset: "UTF-8"}
+	if msg.HTMLBody != "" {
+		payload.Content.Simple.Body.Html = &sesContent{Data: msg.HTMLBody, Charset: "UTF-8"}
+	}
+	if msg.TextBody != "" {
+		payload.Content.Simple.Body.Text = &sesContent{Data: msg.TextBody, Charset: "UTF-8"}
+	}
+	if msg.Category != "" {
+		payload.EmailTags = []sesEmailTag{{Name: "category", Value: msg.Category}}
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to serialize ses request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, m.config.Endpoint+"/v2/email/outbound-emails", bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to build ses request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	signRequestV4(req, body, &awsCredentials{
+		AccessKeyID:     m.config.AccessKeyID,
+		SecretAccessKey: m.config.SecretAccessKey,
+		SessionToken:    m.config.SessionToken,
+	}, m.config.Region, "ses", m.now().UTC())
+
+	resp, err := m.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send email via ses: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(io.LimitReader(resp.Body, 64*1024))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read ses response: %w", err)
+	}
+
+	if resp.StatusCode >= http.StatusMultipleChoices {
+		return nil, fmt.Errorf("ses rejected email with status %d: %s", resp.StatusCode, string(respBody))
+	}
+
+	var parsed sesResponse
+	if err := json.Unmarshal(respBody, &parsed); err != nil {
+		return nil, fmt.Errorf("failed to parse ses response: %w", err)
+	}
+
+	return &SendResult{
+		Provider:          ProviderSES,
+		ProviderMessageID: parsed.MessageID,
+	}, nil
+}
@@ -0,0 +1,160 @@
+package mailer
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"mime"
+	"mime/quotedprintable"
+	"net/smtp"
+	"strings"
+	"time"
+)
+
+type SMTPConfig struct {
+	Host     string
+	Port     int
+	Username string
+	Password string
+	From     string
+	FromName string
+}
+
+type sendMailFunc func(addr string, a smtp.Auth, from string, to []string, msg []byte) error
+
+type SMTPMailer struct {
+	config   *SMTPConfig
+	sendMail sendMailFunc
+}
+
+func NewSMTPMailer(config *SMTPConfig) *SMTPMailer {
+	return &SMTPMailer{
+		config:   config,
+		sendMail: smtp.SendMail,
+	}
+}
+
+func (m *SMTPMailer) Send(ctx context.Context, msg *Message) (*SendResult, error) {
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+
+	from, fromName, err := senderOrDefault(msg, m.config.From, m.config.FromName)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("smtp send cancelled: %w", err)
+	}
+
+	messageID := generateMessageID(from)
+	raw, err := buildMIMEMessage(msg, formatAddress(from, fromName), messageID, time.Now().UTC())
+	if err != nil {
+		return nil, err
+	}
+
+	var auth smtp.Auth
+	if m.config.Username != "" {
+		auth = smtp.PlainAuth("", m.config.Username, m.config.Password, m.config.Host)
+	}
+
+	addr := fmt.Sprintf("%s:%d", m.config.Host, m.config.Port)
+	if err := m.sendMail(addr, auth, from, msg.To, raw); err != nil {
+		return nil, fmt.Errorf("failed to send email via smtp: %w", err)
+	}
+
+	return &SendResult{
+		Provider:          ProviderSMTP,
+		ProviderMessageID: strings.Trim(messageID, "<>"),
+	}, nil
+}
+
+func buildMIMEMessage(msg *Message, from, messageID string, date time.Time) ([]byte, error) {
+	var buf bytes.Buffer
+
+	writeHeader := func(key, value string) {
+		buf.WriteString(key)
+		buf.WriteString(": ")
+		buf.WriteString(value)
+		buf.WriteString("\r\n")
+	}
+
+	writeHeader("From", from)
+	writeHeader("To", strings.Join(msg.To, ", "))
+	if msg.ReplyTo != "" {
+		writeHeader("Reply-To", msg.ReplyTo)
+	}
+	writeHeader("Subject", mime.QEncoding.Encode("utf-8", msg.Subject))
+	writeHeader("Date", date.Format(time.RFC1123Z))
+	writeHeader("Message-ID", messageID)
+	writeHeader("MIME-Version", "1.0")
+
+	if msg.HTMLBody != "" && msg.TextBody != "" {
+		boundary := randomHex(12)
+		writeHeader("Content-Type", fmt.Sprintf("multipart/alternative; boundary=%q", boundary))
+		buf.WriteString("\r\n")
+
+		for _, part := range []struct {
+			contentType string
+			body        string
+		}{
+			{"text/plain; charset=UTF-8", msg.TextBody},
+			{"text/html; charset=UTF-8", msg.HTMLBody},
+		} {
+			buf.WriteString("--" + boundary + "\r\n")
+			writeHeader("Content-Type", part.contentType)
+			writeHeader("Content-Transfer-Encoding", "quoted-printable")
+			buf.WriteString("\r\n")
+			if err := writeQuotedPrintable(&buf, part.body); err != nil {
+				return nil, err
+			}
+			buf.WriteString("\r\n")
+		}
+
+		buf.WriteString("--" + boundary + "--\r\n")
+		return buf.Bytes(), nil
+	}
+
+	contentType := "text/html; charset=UTF-8"
+	body := msg.HTMLBody
+	if body == "" {
+		contentType = "text/plain; charset=UTF-8"
+		body = msg.TextBody
+	}
+
+	writeHeader("Content-Type", contentType)
+	writeHeader("Content-Transfer-Encoding", "quoted-printable")
+	buf.WriteString("\r\n")
+	if err := writeQuotedPrintable(&buf, body); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func writeQuotedPrintable(buf *bytes.Buffer, body string) error {
+	w := quotedprintable.NewWriter(buf)
+	if _, err := w.Write([]byte(body)); err != nil {
+		return fmt.Errorf("failed to encode email body: %w", err)
+	}
+	return w.Close()
+}
+
+func generateMessageID(from string) string {
+	domain := "localhost"
+	if at := strings.LastIndex(from, "@"); at >= 0 && at < len(from)-1 {
+		domain = from[at+1:]
+	}
+	return fmt.Sprintf("<%d.%s@%s>", time.Now().UnixNano(), randomHex(8), domain)
+}
+
+func randomHex(n int) string {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return fmt.Sprintf("%x", time.Now().UnixNano())
+	}
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,136 @@
+package mailer
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	htmltemplate "html/template"
+	"sync"
+	texttemplate "text/template"
+)
+
+var ErrTemplateNotFound = errors.New("mailer: template not found")
+
+// Template describes a named email. Subject and Text are rendered with
+// text/template; HTML is rendered with html/template so data is escaped.
+type Template struct {
+	Name    string
+	Subject string
+	HTML    string
+	Text    string
+}
+
+type RenderedTemplate struct {
+	Subject  string
+	HTMLBody string
+	TextBody string
+}
+
+type compiledTemplate struct {
+	subject *texttemplate.Template
+	html    *htmltemplate.Template
+	text    *texttemplate.Template
+}
+
+type TemplateRegistry struct {
+	mu        sync.RWMutex
+	templates map[string]*compiledTemplate
+}
+
+func NewTemplateRegistry() *TemplateRegistry {
+	return &TemplateRegistry{
+		templates: make(map[string]*compiledTemplate),
+	}
+}
+
+func (r *TemplateRegistry) Register(tmpl Template) error {
+	if tmpl.Name == "" {
+		return errors.New("mailer: template name is required")
+	}
+
+	if tmpl.HTML == "" && tmpl.Text == "" {
+		return fmt.Errorf("mailer: template %q has neither HTML nor text body", tmpl.Name)
+	}
+
+	compiled := &compiledTemplate{}
+
+	subject, err := texttemplate.New(tmpl.Name + ":subject").Parse(tmpl.Subject)
+	if err != nil {
+		return fmt.Errorf("mailer: failed to parse subject of template %q: %w", tmpl.Name, err)
+	}
+	compiled.subject = subject
+
+	if tmpl.HTML != "" {
+		html, err := htmltemplate.New(tmpl.Name + ":html").Parse(tmpl.HTML)
+		if err != nil {
+			return fmt.Errorf("mailer: failed to parse HTML of template %q: %w", tmpl.Name, err)
+		}
+		compiled.html = html
+	}
+
+	if tmpl.Text != "" {
+		text, err := texttemplate.New(tmpl.Name + ":text").Parse(tmpl.Text)
+		if err != nil {
+			return fmt.Errorf("mailer: failed to parse text of template %q: %w", tmpl.Name, err)
+		}
+		compiled.text = text
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.templates[tmpl.Name] = compiled
+
+	return nil
+}
+
+func (r *TemplateRegistry) Render(name string, data interface{}) (*RenderedTemplate, error) {
+	r.mu.RLock()
+	compiled, ok := r.templates[name]
+	r.mu.RUnlock()
+
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrTemplateNotFound, name)
+	}
+
+	rendered := &RenderedTemplate{}
+
+	var buf bytes.Buffer
+	if err := compiled.subject.Execute(&buf, data); err != nil {
+		return nil, fmt.Errorf("mailer: failed to render subject of template %q: %w", name, err)
+	}
+	rendered.Subject = buf.String()
+
+	if compiled.html != nil {
+		buf.Reset()
+		if err := compiled.html.Execute(&buf, data); err != nil {
+			return nil, fmt.Errorf("mailer: failed to render HTML of template %q: %w", name, err)
+		}
+		rendered.HTMLBody = buf.String()
+	}
+
+	if compiled.text != nil {
+		buf.Reset()
+		if err := compiled.text.Execute(&buf, data); err != nil {
+			return nil, fmt.Errorf("mailer: failed to render text of template %q: %w", name, err)
+		}
+		rendered.TextBody = buf.String()
+	}
+
+	return rendered, nil
+}
+
+// NewTemplatedMessage renders the named template and returns a message ready to send.
+func (r *TemplateRegistry) NewTemplatedMessage(name string, to []string, data interface{}) (*Message, error) {
+	rendered, err := r.Render(name, data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Message{
+		To:       to,
+		Subject:  rendered.Subject,
+		HTMLBody: rendered.HTMLBody,
+		TextBody: rendered.TextBody,
+		Category: name,
+	}, nil
+}
@@ -0,0 +1,405 @@
+package mailer
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+)
+
+type DeliveryEventType string
+
+const (
+	DeliveryEventDelivered DeliveryEventType = "delivered"
+	DeliveryEventBounce    DeliveryEventType = "bounce"
+	DeliveryEventComplaint DeliveryEventType = "complaint"
+	DeliveryEventDropped   DeliveryEventType = "dropped"
+)
+
+var (
+	ErrUnsupportedNotification = errors.New("mailer: unsupported webhook notification")
+	ErrNoAllowedTopics         = errors.New("mailer: VerifySNSSignature requires AllowedTopicARNs")
+)
+
+type DeliveryEvent struct {
+	Type              DeliveryEventType
+	Provider          string
+	ProviderMessageID string
+	Recipient         string
+	Reason            string
+	OccurredAt        time.Time
+}
+
+// Status maps a delivery event to the send log status it produces.
+func (e DeliveryEvent) Status() SendStatus {
+	switch e.Type {
+	case DeliveryEventBounce:
+		return SendStatusBounced
+	case DeliveryEventComplaint:
+		return SendStatusComplained
+	case DeliveryEventDropped:
+		return SendStatusDropped
+	default:
+		return SendStatusDelivered
+	}
+}
+
+type sendGridEvent struct {
+	Email       string `json:"email"`
+	Event       string `json:"event"`
+	Reason      string `json:"reason"`
+	Timestamp   int64  `json:"timestamp"`
+	SGMessageID string `json:"sg_message_id"`
+}
+
+// ParseSendGridEvents parses a SendGrid Event Webhook payload. Events other than
+// delivered, bounce, dropped and spamreport are ignored.
+func ParseSendGridEvents(body []byte) ([]DeliveryEvent, error) {
+	var raw []sendGridEvent
+	if err := json.Unmarshal(body, &raw); err != nil {
+		return nil, fmt.Errorf("mailer: failed to parse sendgrid events: %w", err)
+	}
+
+	events := make([]DeliveryEvent, 0, len(raw))
+	for _, ev := range raw {
+		var eventType DeliveryEventType
+		switch ev.Event {
+		case "delivered":
+			eventType = DeliveryEventDelivered
+		case "bounce":
+			eventType = DeliveryEventBounce
+		case "dropped":
+			eventType = DeliveryEventDropped
+		case "spamreport":
+			eventType = DeliveryEventComplaint
+		default:
+			continue
+		}
+
+		// sg_message_id is the X-Message-Id returned on send followed by a filter suffix
+		messageID := ev.SGMessageID
+		if idx := strings.Index(messageID, "."); idx >= 0 {
+			messageID = messageID[:idx]
+		}
+
+		events = append(events, DeliveryEvent{
+			Type:              eventType,
+			Provider:          ProviderSendGrid,
+			ProviderMessageID: messageID,
+			Recipient:         ev.Email,
+			Reason:            ev.Reason,
+			OccurredAt:        time.Unix(ev.Timestamp, 0).UTC(),
+		})
+	}
+
+	return events, nil
+}
+
+type snsEnvelope struct {
+	Type         string `json:"Type"`
+	TopicArn     string `json:"TopicArn"`
+	Message      string `json:"Message"`
+	SubscribeURL string `json:"SubscribeURL"`
+}
+
+type sesNotification struct {
+	NotificationType string `json:"notificationType"`
+	EventType        string `json:"eventType"`
+	Mail             struct {
+		MessageID string `json:"messageId"`
+	} `json:"mail"`
+	Bounce *struct {
+		BounceType        string    `json:"bounceType"`
+		Timestamp         time.Time `json:"timestamp"`
+		BouncedRecipients []struct {
+			EmailAddress   string `json:"emailAddress"`
+			DiagnosticCode string `json:"diagnosticCode"`
+		} `json:"bouncedRecipients"`
+	} `json:"bounce"`
+	Complaint *struct {
+		ComplaintFeedbackType string    `json:"complaintFeedbackType"`
+		Timestamp             time.Time `json:"timestamp"`
+		ComplainedRecipients  []struct {
+			EmailAddress string `json:"emailAddress"`
+		} `json:"complainedRecipients"`
+	} `json:"complaint"`
+	Delivery *struct {
+		Timestamp  time.Time `json:"timestamp"`
+		Recipients []string  `json:"recipients"`
+	} `json:"delivery"`
+}
+
+// SNSSubscription is returned by ParseSESNotification when the payload is an
+// SNS subscription confirmation rather than an SES notification.
+type SNSSubscription struct {
+	SubscribeURL string
+}
+
+// ParseSESNotification parses an SES notification delivered through SNS.
+func ParseSESNotification(body []byte) ([]DeliveryEvent, *SNSSubscription, error) {
+	var envelope snsEnvelope
+	if err := json.Unmarshal(body, &envelope); err != nil {
+		return nil, nil, fmt.Errorf("mailer: failed to parse sns envelope: %w", err)
+	}
+
+	switch envelope.Type {
+	case "SubscriptionConfirmation":
+		return nil, &SNSSubscription{SubscribeURL: envelope.SubscribeURL}, nil
+	case "Notification":
+	default:
+		return nil, nil, fmt.Errorf("%w: sns type %q", ErrUnsupportedNotification, envelope.Type)
+	}
+
+	var notification sesNotification
+	if err := json.Unmarshal([]byte(envelope.Message), &notification); err != nil {
+		return nil, nil, fmt.Errorf("mailer: failed to parse ses notification: %w", err)
+	}
+
+	kind := notification.NotificationType
+	if kind == "" {
+		kind = notification.EventType
+	}
+	messageID := notification.Mail.MessageID
+
+	var events []DeliveryEvent
+	switch kind {
+	case "Bounce":
+		if notification.Bounce == nil {
+			return nil, nil, fmt.Errorf("%w: bounce without details", ErrUnsupportedNotification)
+		}
+		for _, r := range notification.Bounce.BouncedRecipients {
+			reason := notification.Bounce.BounceType
+			if r.DiagnosticCode != "" {
+				reason = reason + ": " + r.DiagnosticCode
+			}
+			events = append(events, DeliveryEvent{
+				Type:              DeliveryEventBounce,
+				Provider:          ProviderSES,
+				ProviderMessageID: messageID,
+				Recipient:         r.EmailAddress,
+				Reason:            reason,
+				OccurredAt:        notification.Bounce.Timestamp,
+			})
+		}
+	case "Complaint":
+		if notification.Complaint == nil {
+			return nil, nil, fmt.Errorf("%w: complaint without details", ErrUnsupportedNotification)
+		}
+		for _, r := range notification.Complaint.ComplainedRecipients {
+			events = append(events, DeliveryEvent{
+				Type:              DeliveryEventComplaint,
+				Provider:          ProviderSES,
+				ProviderMessageID: messageID,
+				Recipient:         r.EmailAddress,
+				Reason:            notification.Complaint.ComplaintFeedbackType,
+				OccurredAt:        notification.Complaint.Timestamp,
+			})
+		}
+	case "Delivery":
+		if notification.Delivery == nil {
+			return nil, nil, fmt.Errorf("%w: delivery without details", ErrUnsupportedNotification)
+		}
+		for _, r := range notification.Delivery.Recipients {
+			events = append(events, DeliveryEvent{
+				Type:              DeliveryEventDelivered,
+				Provider:          ProviderSES,
+				ProviderMessageID: messageID,
+				Recipient:         r,
+				OccurredAt:        notification.Delivery.Timestamp,
+			})
+		}
+	default:
+		return nil, nil, fmt.Errorf("%w: ses type %q", ErrUnsupportedNotification, kind)
+	}
+
+	return events, nil, nil
+}
+
+// ApplyDeliveryEvents updates the send log for each event.
+func ApplyDeliveryEvents(ctx context.Context, store SendLogStore, events []DeliveryEvent) error {
+	for _, ev := range events {
+		if err := store.UpdateStatus(ctx, ev.Provider, ev.ProviderMessageID, ev.Recipient, ev.Status(), ev.Reason); err != nil {
+			return fmt.Errorf("mailer: failed to update send log for %s: %w", ev.Recipient, err)
+		}
+	}
+	return nil
+}
+
+// WebhookHandler receives bounce and complaint callbacks from SendGrid and SES.
+// Requests must carry Token in the "token" query parameter. SES callbacks may
+// instead, or in addition, be authenticated by VerifySNSSignature. Callbacks
+// are rejected when no authentication is configured.
+//
+// A valid SNS signature only proves that some AWS account sent the message, so
+// SES callbacks are also checked against AllowedTopicARNs, before a
+// subscription is confirmed or a notification applied. VerifySNSSignature
+// without AllowedTopicARNs rejects every SES callback; call Validate at startup.
+type WebhookHandler struct {
+	Store              SendLogStore
+	Token              string
+	VerifySNSSignature bool
+	AllowedTopicARNs   []string
+	HTTPClient         *http.Client
+
+	sns snsVerifier
+}
+
+// Validate reports a configuration that would reject every SES callback.
+func (h *WebhookHandler) Validate() error {
+	if h.VerifySNSSignature && len(h.AllowedTopicARNs) == 0 {
+		return ErrNoAllowedTopics
+	}
+	return nil
+}
+
+func (h *WebhookHandler) SendGrid() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, ok := h.readBody(w, r)
+		if !ok {
+			return
+		}
+
+		if !h.tokenValid(r) {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+
+		events, err := ParseSendGridEvents(body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		if err := ApplyDeliveryEvents(r.Context(), h.Store, events); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+func (h *WebhookHandler) SES() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, ok := h.readBody(w, r)
+		if !ok {
+			return
+		}
+
+		if !h.sesAuthorized(r, body) {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+
+		events, subscription, err := ParseSESNotification(body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		if subscription != nil {
+			if err := h.confirmSubscription(r.Context(), subscription.SubscribeURL); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		if err := ApplyDeliveryEvents(r.Context(), h.Store, events); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// tokenValid fails closed: an unset Token rejects every request.
+func (h *WebhookHandler) tokenValid(r *http.Request) bool {
+	if h.Token == "" {
+		return false
+	}
+	token := r.URL.Query().Get("token")
+	return subtle.ConstantTimeCompare([]byte(token), []byte(h.Token)) == 1
+}
+
+func (h *WebhookHandler) sesAuthorized(r *http.Request, body []byte) bool {
+	if h.Token == "" && !h.VerifySNSSignature {
+		return false
+	}
+	if h.Token != "" && !h.tokenValid(r) {
+		return false
+	}
+	if (h.VerifySNSSignature || len(h.AllowedTopicARNs) > 0) && !h.topicAllowed(body) {
+		return false
+	}
+	if h.VerifySNSSignature {
+		return h.sns.verify(r.Context(), h.httpClient(), body) == nil
+	}
+	return true
+}
+
+// topicAllowed fails closed: an empty AllowedTopicARNs rejects every topic.
+func (h *WebhookHandler) topicAllowed(body []byte) bool {
+	var envelope snsEnvelope
+	if err := json.Unmarshal(body, &envelope); err != nil {
+		return false
+	}
+	return envelope.TopicArn != "" && slices.Contains(h.AllowedTopicARNs, envelope.TopicArn)
+}
+
+func (h *WebhookHandler) httpClient() *http.Client {
+	if h.HTTPClient != nil {
+		return h.HTTPClient
+	}
+	return &http.Client{Timeout: 10 * time.Second}
+}
+
+func (h *WebhookHandler) readBody(w http.ResponseWriter, r *http.Request) ([]byte, bool) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return nil, false
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, 1<<20))
+	if err != nil {
+		http.Error(w, "failed to read body", http.StatusBadRequest)
+		return nil, false
+	}
+
+	return body, true
+}
+
+func (h *WebhookHandler) confirmSubscription(ctx context.Context, subscribeURL string) error {
+	parsed, err := url.Parse(subscribeURL)
+	if err != nil || parsed.Scheme != "https" || !strings.HasSuffix(parsed.Hostname(), ".amazonaws.com") {
+		return fmt.Errorf("mailer: refusing to confirm subscription at %q", subscribeURL)
+	}
+
+	client := h.httpClient()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, subscribeURL, nil)
+	if err != nil {
+		return fmt.Errorf("mailer: failed to build subscription request: %w", err)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("mailer: failed to confirm subscription: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("mailer: subscription confirmation returned status %d", resp.StatusCode)
+	}
+
+	return nil
+}
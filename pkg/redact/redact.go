@@ -0,0 +1,121 @@
+// Package redact masks sensitive values (tokens, passwords, emails, API keys)
+// before they are written to logs or events.
+package redact
+
+import (
+	"regexp"
+	"strings"
+)
+
+const Mask = "[REDACTED]"
+
+// DefaultFields are masked by Default. Keys match case-insensitively and
+// ignore "_" and "-", so "accessToken", "access_token" and "Access-Token" match.
+var DefaultFields = []string{
+	"password", "new_password", "old_password", "current_password",
+	"token", "access_token", "refresh_token", "activation_token", "reset_token", "id_token",
+	"secret", "client_secret", "two_factor_secret",
+	"api_key", "authorization", "cookie",
+	"email",
+}
+
+var (
+	jwtPattern    = regexp.MustCompile(`eyJ[A-Za-z0-9_-]+\.[A-Za-z0-9_-]+\.[A-Za-z0-9_-]+`)
+	bearerPattern = regexp.MustCompile(`(?i)(bearer\s+)[A-Za-z0-9._~+/=-]+`)
+)
+
+type Redactor struct {
+	fields map[string]bool
+}
+
+// New returns a Redactor masking the given field names.
+func New(fields ...string) *Redactor {
+	r := &Redactor{fields: make(map[string]bool, len(fields))}
+	for _, field := range fields {
+		r.fields[normalize(field)] = true
+	}
+	return r
+}
+
+func Default() *Redactor {
+	return New(DefaultFields...)
+}
+
+// With returns a copy of r that also masks fields.
+func (r *Redactor) With(fields ...string) *Redactor {
+	clone := &Redactor{fields: make(map[string]bool, len(r.fields)+len(fields))}
+	for field := range r.fields {
+		clone.fields[field] = true
+	}
+	for _, field := range fields {
+		clone.fields[normalize(field)] = true
+	}
+	return clone
+}
+
+func (r *Redactor) IsSensitive(key string) bool {
+	return r.fields[normalize(key)]
+}
+
+// Value masks value when key is sensitive. Emails keep their domain and first
+// letter; nested maps and slices are redacted recursively; other strings have
+// embedded JWTs and bearer tokens masked.
+func (r *Redactor) Value(key string, value interface{}) interface{} {
+	if r.IsSensitive(key) {
+		if s, ok := value.(string); ok && strings.Contains(s, "@") {
+			return MaskEmail(s)
+		}
+		return Mask
+	}
+
+	switch v := value.(type) {
+	case map[string]interface{}:
+		return r.Map(v)
+	case []interface{}:
+		redacted := make([]interface{}, len(v))
+		for i, item := range v {
+			redacted[i] = r.Value("", item)
+		}
+		return redacted
+	case string:
+		return String(v)
+	default:
+		return value
+	}
+}
+
+// Map returns a redacted copy of m; m itself is not modified.
+func (r *Redactor) Map(m map[string]interface{}) map[string]interface{} {
+	if m == nil {
+		return nil
+	}
+
+	redacted := make(map[string]interface{}, len(m))
+	for key, value := range m {
+		redacted[key] = r.Value(key, value)
+	}
+	return redacted
+}
+
+// String masks JWTs and bearer tokens embedded in free text such as log
+// messages.
+func String(s string) string {
+	s = jwtPattern.ReplaceAllString(s, Mask)
+	return bearerPattern.ReplaceAllString(s, "${1}"+Mask)
+}
+
+// MaskEmail keeps the first letter of the local part and the domain:
+// "jane.doe@example.com" becomes "j***@example.com".
+func MaskEmail(email string) string {
+	local, domain, found := strings.Cut(email, "@")
+	if !found || local == "" {
+		return Mask
+	}
+	return local[:1] + "***@" + domain
+}
+
+func normalize(key string) string {
+	key = strings.ToLower(key)
+	key = strings.ReplaceAll(key, "_", "")
+	return strings.ReplaceAll(key, "-", "")
+}
@@ -0,0 +1,182 @@
+package scheduler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	defaultRunsLimit = 50
+	maxRunsLimit     = 500
+)
+
+// OrganizationFunc returns the caller's organization from the request
+// context, as set by the embedding service's authentication middleware.
+type OrganizationFunc func(ctx context.Context) (string, bool)
+
+// AdminHandler exposes schedule management over HTTP, scoped to the caller's
+// organization:
+//
+//	GET    /schedules
+//	PUT    /schedules
+//	DELETE /schedules/{id}
+//	GET    /schedules/runs?limit=50 (after History)
+//
+// The organization always comes from OrganizationFunc, never from the query
+// or body, so one tenant cannot read or change another tenant's schedules.
+// Authentication is left to the embedding service's middleware.
+type AdminHandler struct {
+	store        Store
+	runs         RunStore
+	organization OrganizationFunc
+	mux          *http.ServeMux
+	now          func() time.Time
+}
+
+func NewAdminHandler(store Store, organization OrganizationFunc) *AdminHandler {
+	h := &AdminHandler{
+		store:        store,
+		organization: organization,
+		mux:          http.NewServeMux(),
+		now:          time.Now,
+	}
+
+	h.mux.HandleFunc("GET /schedules", h.list)
+	h.mux.HandleFunc("PUT /schedules", h.upsert)
+	h.mux.HandleFunc("DELETE /schedules/{id}", h.delete)
+
+	return h
+}
+
+// History exposes the run history in runs.
+func (h *AdminHandler) History(runs RunStore) {
+	h.runs = runs
+	h.mux.HandleFunc("GET /schedules/runs", h.listRuns)
+}
+
+func (h *AdminHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.mux.ServeHTTP(w, r)
+}
+
+func (h *AdminHandler) list(w http.ResponseWriter, r *http.Request) {
+	orgID, ok := h.organizationID(w, r)
+	if !ok {
+		return
+	}
+
+	schedules, err := h.store.List(r.Context(), orgID)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to list schedules")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{"schedules": schedules})
+}
+
+func (h *AdminHandler) listRuns(w http.ResponseWriter, r *http.Request) {
+	orgID, ok := h.organizationID(w, r)
+	if !ok {
+		return
+	}
+
+	limit := defaultRunsLimit
+	if raw := r.URL.Query().Get("limit"); raw != "" {
+		parsed, err := strconv.Atoi(raw)
+		if err != nil || parsed < 1 || parsed > maxRunsLimit {
+			writeError(w, http.StatusBadRequest, fmt.Sprintf("limit must be between 1 and %d", maxRunsLimit))
+			return
+		}
+		limit = parsed
+	}
+
+	runs, err := h.runs.ListRuns(r.Context(), orgID, limit)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to list runs")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{"runs": runs})
+}
+
+// upsert keys the schedule by the caller's organization and job type; an ID
+// in the body is ignored so it cannot point at another tenant's row.
+func (h *AdminHandler) upsert(w http.ResponseWriter, r *http.Request) {
+	orgID, ok := h.organizationID(w, r)
+	if !ok {
+		return
+	}
+
+	var schedule Schedule
+	if err := json.NewDecoder(r.Body).Decode(&schedule); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	if schedule.OrganizationID != "" && schedule.OrganizationID != orgID {
+		writeError(w, http.StatusForbidden, "organization_id does not match the caller's organization")
+		return
+	}
+	schedule.ID = ""
+	schedule.OrganizationID = orgID
+
+	if err := schedule.Validate(); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	schedule.LastRunAt = nil
+	schedule.UpdatedAt = h.now().UTC()
+	if schedule.CreatedAt.IsZero() {
+		schedule.CreatedAt = schedule.UpdatedAt
+	}
+
+	if err := h.store.Upsert(r.Context(), &schedule); err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to save schedule")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, &schedule)
+}
+
+func (h *AdminHandler) delete(w http.ResponseWriter, r *http.Request) {
+	orgID, ok := h.organizationID(w, r)
+	if !ok {
+		return
+	}
+
+	err := h.store.Delete(r.Context(), orgID, r.PathValue("id"))
+	if errors.Is(err, ErrScheduleNotFound) {
+		writeError(w, http.StatusNotFound, "schedule not found")
+		return
+	}
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to delete schedule")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *AdminHandler) organizationID(w http.ResponseWriter, r *http.Request) (string, bool) {
+	orgID, ok := h.organization(r.Context())
+	if !ok || orgID == "" {
+		writeError(w, http.StatusUnauthorized, "organization not found in request context")
+		return "", false
+	}
+	return orgID, true
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, map[string]string{"error": message})
+}
@@ -0,0 +1,51 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+type RunStatus string
+
+const (
+	RunSucceeded RunStatus = "succeeded"
+	RunFailed    RunStatus = "failed"
+	RunSkipped   RunStatus = "skipped"
+)
+
+// ErrSkipped is returned by a job that decided not to run, e.g. because
+// another replica holds its lock. The run is recorded as skipped and not
+// reported to OnError.
+var ErrSkipped = errors.New("scheduler: run skipped")
+
+// Run is one row of the run history table.
+type Run struct {
+	ID             string     `json:"id"`
+	ScheduleID     string     `json:"schedule_id"`
+	OrganizationID string     `json:"organization_id"`
+	JobType        JobType    `json:"job_type"`
+	Status         RunStatus  `json:"status"`
+	Error          string     `json:"error,omitempty"`
+	StartedAt      time.Time  `json:"started_at"`
+	FinishedAt     *time.Time `json:"finished_at,omitempty"`
+}
+
+// RunStore persists run history. ListRuns returns the newest runs first.
+type RunStore interface {
+	RecordRun(ctx context.Context, run *Run) error
+	ListRuns(ctx context.Context, organizationID string, limit int) ([]*Run, error)
+}
+
+func finishRun(run *Run, err error, at time.Time) {
+	run.FinishedAt = &at
+	switch {
+	case err == nil:
+		run.Status = RunSucceeded
+	case errors.Is(err, ErrSkipped):
+		run.Status = RunSkipped
+	default:
+		run.Status = RunFailed
+		run.Error = err.Error()
+	}
+}
@@ -0,0 +1,292 @@
+// Package scheduler runs recurring jobs per organization from stored cron
+// schedules, each evaluated in the organization's timezone.
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+type JobType string
+
+const (
+	JobDDMRPRecalculation JobType = "ddmrp.recalculation"
+	JobAnalyticsSnapshot  JobType = "analytics.snapshot"
+	JobHubDigest          JobType = "hub.digest"
+	JobUsageMetering      JobType = "auth.usage_metering"
+)
+
+var ErrScheduleNotFound = errors.New("scheduler: schedule not found")
+
+// Schedule is one row of the scheduling table. There is at most one schedule
+// per organization and job type.
+type Schedule struct {
+	ID             string     `json:"id"`
+	OrganizationID string     `json:"organization_id"`
+	JobType        JobType    `json:"job_type"`
+	CronExpression string     `json:"cron_expression"`
+	Timezone       string     `json:"timezone"`
+	Enabled        bool       `json:"enabled"`
+	LastRunAt      *time.Time `json:"last_run_at,omitempty"`
+	CreatedAt      time.Time  `json:"created_at"`
+	UpdatedAt      time.Time  `json:"updated_at"`
+}
+
+func (s *Schedule) Validate() error {
+	if s.OrganizationID == "" {
+		return errors.New("organization_id is required")
+	}
+	if s.JobType == "" {
+		return errors.New("job_type is required")
+	}
+	if _, err := ParseCron(s.CronExpression); err != nil {
+		return err
+	}
+	if _, err := time.LoadLocation(s.timezone()); err != nil {
+		return fmt.Errorf("invalid timezone %q", s.Timezone)
+	}
+	return nil
+}
+
+// NextRun returns the first occurrence after t in the schedule's timezone.
+func (s *Schedule) NextRun(t time.Time) (time.Time, error) {
+	expr, err := ParseCron(s.CronExpression)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	loc, err := time.LoadLocation(s.timezone())
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid timezone %q", s.Timezone)
+	}
+
+	return expr.Next(t.In(loc)), nil
+}
+
+func (s *Schedule) timezone() string {
+	if s.Timezone == "" {
+		return "UTC"
+	}
+	return s.Timezone
+}
+
+// Store persists schedules. Upsert replaces the schedule for the same
+// organization and job type; Delete returns ErrScheduleNotFound when id does
+// not belong to organizationID; MarkRun must only touch last_run_at.
+type Store interface {
+	List(ctx context.Context, organizationID string) ([]*Schedule, error)
+	ListEnabled(ctx context.Context) ([]*Schedule, error)
+	Upsert(ctx context.Context, schedule *Schedule) error
+	Delete(ctx context.Context, organizationID, id string) error
+	MarkRun(ctx context.Context, id string, at time.Time) error
+}
+
+// JobFunc runs one job for one organization.
+type JobFunc func(ctx context.Context, organizationID string) error
+
+// FenceFunc reports whether this instance may run jobs. In an active-passive
+// deployment the passive region returns false, e.g. by checking that its
+// database is a replica with database.IsPrimary.
+type FenceFunc func(ctx context.Context) (bool, error)
+
+// Dispatcher polls the store and runs due jobs. Several occurrences missed
+// while the dispatcher was down are coalesced into a single run.
+type Dispatcher struct {
+	store   Store
+	jobs    map[JobType]JobFunc
+	onError func(schedule *Schedule, err error)
+	fence   FenceFunc
+	runs    RunStore
+	now     func() time.Time
+	mu      sync.RWMutex
+
+	// running holds the IDs of schedules whose job has not returned yet
+	running   map[string]struct{}
+	runningMu sync.Mutex
+	wg        sync.WaitGroup
+}
+
+func NewDispatcher(store Store) *Dispatcher {
+	return &Dispatcher{
+		store:   store,
+		jobs:    make(map[JobType]JobFunc),
+		onError: func(*Schedule, error) {},
+		now:     time.Now,
+		running: make(map[string]struct{}),
+	}
+}
+
+func (d *Dispatcher) Register(jobType JobType, fn JobFunc) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.jobs[jobType] = fn
+}
+
+// OnError sets the callback for job, store and schedule errors.
+func (d *Dispatcher) OnError(fn func(schedule *Schedule, err error)) {
+	d.onError = fn
+}
+
+// Fence makes RunDue skip ticks while fn returns false. A fence error also
+// skips the tick, since running from a region of unknown role risks writing
+// from both.
+func (d *Dispatcher) Fence(fn FenceFunc) {
+	d.fence = fn
+}
+
+// History records every run, with its outcome, in runs. Failing to record a
+// run goes to OnError and does not stop the job.
+func (d *Dispatcher) History(runs RunStore) {
+	d.runs = runs
+}
+
+// RunDue starts every enabled schedule whose next occurrence has passed and
+// returns how many jobs were started. It does not wait for them, so a slow
+// job only delays its own schedule: while a schedule's previous run is still
+// going it is left unmarked and starts on the first tick after that run
+// returns. Use Wait to block until started jobs finish.
+func (d *Dispatcher) RunDue(ctx context.Context) (int, error) {
+	if d.fence != nil {
+		active, err := d.fence(ctx)
+		if err != nil {
+			return 0, fmt.Errorf("failed to check fence: %w", err)
+		}
+		if !active {
+			return 0, nil
+		}
+	}
+
+	schedules, err := d.store.ListEnabled(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list schedules: %w", err)
+	}
+
+	now := d.now()
+	started := 0
+
+	for _, schedule := range schedules {
+		d.mu.RLock()
+		job, ok := d.jobs[schedule.JobType]
+		d.mu.RUnlock()
+		if !ok {
+			continue
+		}
+
+		due, err := d.isDue(schedule, now)
+		if err != nil {
+			d.onError(schedule, err)
+			continue
+		}
+		if !due {
+			continue
+		}
+
+		if !d.claim(schedule.ID) {
+			continue
+		}
+
+		// Mark first so a crashing job is not retried every tick
+		if err := d.store.MarkRun(ctx, schedule.ID, now); err != nil {
+			d.release(schedule.ID)
+			d.onError(schedule, fmt.Errorf("failed to mark schedule run: %w", err))
+			continue
+		}
+
+		started++
+		d.wg.Add(1)
+		go func(schedule *Schedule) {
+			defer d.wg.Done()
+			defer d.release(schedule.ID)
+			d.run(ctx, schedule, job)
+		}(schedule)
+	}
+
+	return started, nil
+}
+
+// Wait blocks until every job started by RunDue has returned.
+func (d *Dispatcher) Wait() {
+	d.wg.Wait()
+}
+
+// claim reports whether scheduleID has no run in flight, and marks it as
+// running if so.
+func (d *Dispatcher) claim(scheduleID string) bool {
+	d.runningMu.Lock()
+	defer d.runningMu.Unlock()
+	if _, ok := d.running[scheduleID]; ok {
+		return false
+	}
+	d.running[scheduleID] = struct{}{}
+	return true
+}
+
+func (d *Dispatcher) release(scheduleID string) {
+	d.runningMu.Lock()
+	defer d.runningMu.Unlock()
+	delete(d.running, scheduleID)
+}
+
+func (d *Dispatcher) run(ctx context.Context, schedule *Schedule, job JobFunc) {
+	run := &Run{
+		ScheduleID:     schedule.ID,
+		OrganizationID: schedule.OrganizationID,
+		JobType:        schedule.JobType,
+		StartedAt:      d.now().UTC(),
+	}
+
+	err := job(ctx, schedule.OrganizationID)
+	if err != nil && !errors.Is(err, ErrSkipped) {
+		d.onError(schedule, fmt.Errorf("job %s failed for organization %s: %w", schedule.JobType, schedule.OrganizationID, err))
+	}
+
+	if d.runs == nil {
+		return
+	}
+
+	finishRun(run, err, d.now().UTC())
+	// Record even if the job's context was cancelled
+	if err := d.runs.RecordRun(context.WithoutCancel(ctx), run); err != nil {
+		d.onError(schedule, fmt.Errorf("failed to record run: %w", err))
+	}
+}
+
+// Start polls every interval until ctx is cancelled, then waits for the
+// jobs still running.
+func (d *Dispatcher) Start(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			d.Wait()
+			return
+		case <-ticker.C:
+			if _, err := d.RunDue(ctx); err != nil {
+				d.onError(nil, err)
+			}
+		}
+	}
+}
+
+func (d *Dispatcher) isDue(schedule *Schedule, now time.Time) (bool, error) {
+	// An edited schedule starts counting from the edit, not from its last run
+	reference := schedule.UpdatedAt
+	if schedule.LastRunAt != nil && schedule.LastRunAt.After(reference) {
+		reference = *schedule.LastRunAt
+	}
+	if reference.IsZero() {
+		reference = now.Add(-time.Minute)
+	}
+
+	next, err := schedule.NextRun(reference)
+	if err != nil {
+		return false, err
+	}
+
+	return !next.IsZero() && !next.After(now), nil
+}
@@ -0,0 +1,494 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+type memoryStore struct {
+	mu        sync.Mutex
+	schedules map[string]*Schedule
+}
+
+func newMemoryStore(schedules ...*Schedule) *memoryStore {
+	s := &memoryStore{schedules: make(map[string]*Schedule)}
+	for _, schedule := range schedules {
+		s.schedules[schedule.ID] = schedule
+	}
+	return s
+}
+
+func (s *memoryStore) List(ctx context.Context, organizationID string) ([]*Schedule, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var result []*Schedule
+	for _, schedule := range s.schedules {
+		if schedule.OrganizationID == organizationID {
+			result = append(result, schedule)
+		}
+	}
+	return result, nil
+}
+
+func (s *memoryStore) ListEnabled(ctx context.Context) ([]*Schedule, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var result []*Schedule
+	for _, schedule := range s.schedules {
+		if schedule.Enabled {
+			copied := *schedule
+			result = append(result, &copied)
+		}
+	}
+	return result, nil
+}
+
+func (s *memoryStore) Upsert(ctx context.Context, schedule *Schedule) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if schedule.ID == "" {
+		schedule.ID = schedule.OrganizationID + "/" + string(schedule.JobType)
+	}
+	s.schedules[schedule.ID] = schedule
+	return nil
+}
+
+func (s *memoryStore) Delete(ctx context.Context, organizationID, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if schedule, ok := s.schedules[id]; !ok || schedule.OrganizationID != organizationID {
+		return ErrScheduleNotFound
+	}
+	delete(s.schedules, id)
+	return nil
+}
+
+func (s *memoryStore) MarkRun(ctx context.Context, id string, at time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.schedules[id].LastRunAt = &at
+	return nil
+}
+
+func TestParseCron_InvalidExpressions(t *testing.T) {
+	for _, expr := range []string{"", "* * * *", "60 * * * *", "* 24 * * *", "*/0 * * * *", "5-1 * * * *", "a * * * *"} {
+		if _, err := ParseCron(expr); err == nil {
+			t.Errorf("expected error for %q", expr)
+		}
+	}
+}
+
+func TestCronExpression_Next(t *testing.T) {
+	base := time.Date(2026, 3, 10, 10, 7, 30, 0, time.UTC) // Tuesday
+
+	tests := []struct {
+		expr string
+		want time.Time
+	}{
+		{"*/15 * * * *", time.Date(2026, 3, 10, 10, 15, 0, 0, time.UTC)},
+		{"0 2 * * *", time.Date(2026, 3, 11, 2, 0, 0, 0, time.UTC)},
+		{"@daily", time.Date(2026, 3, 11, 0, 0, 0, 0, time.UTC)},
+		{"30 8 * * 1-5", time.Date(2026, 3, 11, 8, 30, 0, 0, time.UTC)},
+		{"0 9 * * 7", time.Date(2026, 3, 15, 9, 0, 0, 0, time.UTC)},
+		{"0 0 1 * *", time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)},
+		{"0 0 1 * 1", time.Date(2026, 3, 16, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		expr, err := ParseCron(tt.expr)
+		if err != nil {
+			t.Fatalf("ParseCron(%q) failed: %v", tt.expr, err)
+		}
+		if got := expr.Next(base); !got.Equal(tt.want) {
+			t.Errorf("Next(%q) = %v, want %v", tt.expr, got, tt.want)
+		}
+	}
+}
+
+func TestSchedule_NextRun_UsesTimezone(t *testing.T) {
+	schedule := &Schedule{CronExpression: "0 2 * * *", Timezone: "America/Argentina/Buenos_Aires"}
+
+	next, err := schedule.NextRun(time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// 02:00 in Buenos Aires (UTC-3) is 05:00 UTC
+	if want := time.Date(2026, 3, 10, 5, 0, 0, 0, time.UTC); !next.Equal(want) {
+		t.Errorf("expected %v, got %v", want, next.UTC())
+	}
+}
+
+func TestDispatcher_RunDue_RunsOnlyDueSchedulesPerOrganization(t *testing.T) {
+	now := time.Date(2026, 3, 10, 2, 0, 30, 0, time.UTC)
+	yesterday := now.Add(-24 * time.Hour)
+
+	store := newMemoryStore(
+		&Schedule{ID: "a", OrganizationID: "org-a", JobType: JobDDMRPRecalculation, CronExpression: "0 2 * * *", Enabled: true, UpdatedAt: yesterday},
+		&Schedule{ID: "b", OrganizationID: "org-b", JobType: JobDDMRPRecalculation, CronExpression: "0 2 * * *", Timezone: "Europe/Madrid", Enabled: true, UpdatedAt: yesterday},
+		&Schedule{ID: "c", OrganizationID: "org-c", JobType: JobDDMRPRecalculation, CronExpression: "0 2 * * *", Enabled: false, UpdatedAt: yesterday},
+	)
+
+	dispatcher := NewDispatcher(store)
+	dispatcher.now = func() time.Time { return now }
+
+	var mu sync.Mutex
+	var ran []string
+	dispatcher.Register(JobDDMRPRecalculation, func(ctx context.Context, organizationID string) error {
+		mu.Lock()
+		defer mu.Unlock()
+		ran = append(ran, organizationID)
+		return nil
+	})
+
+	started, err := dispatcher.RunDue(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	dispatcher.Wait()
+
+	// Madrid's 02:00 already passed at 01:00 UTC, so org-b also ran
+	if started != 2 {
+		t.Errorf("expected 2 jobs started, got %d (%v)", started, ran)
+	}
+
+	// A second tick in the same minute must not rerun anything
+	started, _ = dispatcher.RunDue(context.Background())
+	if started != 0 {
+		t.Errorf("expected no jobs on second tick, got %d", started)
+	}
+}
+
+func TestDispatcher_RunDue_ReportsJobErrors(t *testing.T) {
+	now := time.Date(2026, 3, 10, 2, 0, 0, 0, time.UTC)
+	store := newMemoryStore(&Schedule{ID: "a", OrganizationID: "org-a", JobType: JobHubDigest, CronExpression: "@hourly", Enabled: true, UpdatedAt: now.Add(-2 * time.Hour)})
+
+	dispatcher := NewDispatcher(store)
+	dispatcher.now = func() time.Time { return now }
+	dispatcher.Register(JobHubDigest, func(ctx context.Context, organizationID string) error {
+		return errors.New("boom")
+	})
+
+	var reported error
+	dispatcher.OnError(func(schedule *Schedule, err error) { reported = err })
+
+	if _, err := dispatcher.RunDue(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	dispatcher.Wait()
+	if reported == nil || !strings.Contains(reported.Error(), "boom") {
+		t.Errorf("expected job error to be reported, got %v", reported)
+	}
+}
+
+func TestDispatcher_RunDue_WithInactiveFence_SkipsJobs(t *testing.T) {
+	now := time.Date(2026, 3, 10, 2, 0, 0, 0, time.UTC)
+	store := newMemoryStore(&Schedule{ID: "a", OrganizationID: "org-a", JobType: JobHubDigest, CronExpression: "@hourly", Enabled: true, UpdatedAt: now.Add(-2 * time.Hour)})
+
+	dispatcher := NewDispatcher(store)
+	dispatcher.now = func() time.Time { return now }
+	dispatcher.Register(JobHubDigest, func(ctx context.Context, organizationID string) error {
+		t.Error("job must not run on a passive instance")
+		return nil
+	})
+
+	dispatcher.Fence(func(ctx context.Context) (bool, error) { return false, nil })
+	if started, err := dispatcher.RunDue(context.Background()); err != nil || started != 0 {
+		t.Fatalf("expected no jobs and no error, got %d, %v", started, err)
+	}
+
+	dispatcher.Fence(func(ctx context.Context) (bool, error) { return true, errors.New("db unreachable") })
+	if _, err := dispatcher.RunDue(context.Background()); err == nil {
+		t.Error("expected fence error")
+	}
+
+	// The skipped ticks must not have consumed the run
+	if store.schedules["a"].LastRunAt != nil {
+		t.Error("expected schedule not to be marked as run")
+	}
+}
+
+type organizationKey struct{}
+
+func organizationFromContext(ctx context.Context) (string, bool) {
+	orgID, ok := ctx.Value(organizationKey{}).(string)
+	return orgID, ok
+}
+
+// asOrganization sets the caller's organization the way the embedding
+// service's auth middleware would.
+func asOrganization(r *http.Request, orgID string) *http.Request {
+	return r.WithContext(context.WithValue(r.Context(), organizationKey{}, orgID))
+}
+
+// waitForRelease waits until the run of scheduleID has returned.
+func waitForRelease(t *testing.T, d *Dispatcher, scheduleID string) {
+	t.Helper()
+	deadline := time.Now().Add(time.Second)
+	for time.Now().Before(deadline) {
+		d.runningMu.Lock()
+		_, running := d.running[scheduleID]
+		d.runningMu.Unlock()
+		if !running {
+			return
+		}
+		time.Sleep(time.Millisecond)
+	}
+	t.Fatalf("run of %s did not finish", scheduleID)
+}
+
+func TestDispatcher_RunDue_WithSlowJob_DoesNotBlockOtherSchedules(t *testing.T) {
+	now := time.Date(2026, 3, 10, 2, 0, 0, 0, time.UTC)
+	store := newMemoryStore(
+		&Schedule{ID: "slow", OrganizationID: "org-a", JobType: JobAnalyticsSnapshot, CronExpression: "* * * * *", Enabled: true, UpdatedAt: now.Add(-time.Hour)},
+		&Schedule{ID: "fast", OrganizationID: "org-b", JobType: JobHubDigest, CronExpression: "* * * * *", Enabled: true, UpdatedAt: now.Add(-time.Hour)},
+	)
+
+	var mu sync.Mutex
+	dispatcher := NewDispatcher(store)
+	dispatcher.now = func() time.Time {
+		mu.Lock()
+		defer mu.Unlock()
+		return now
+	}
+
+	release := make(chan struct{})
+	var slowRuns, fastRuns int
+	dispatcher.Register(JobAnalyticsSnapshot, func(ctx context.Context, organizationID string) error {
+		mu.Lock()
+		slowRuns++
+		mu.Unlock()
+		<-release
+		return nil
+	})
+	dispatcher.Register(JobHubDigest, func(ctx context.Context, organizationID string) error {
+		mu.Lock()
+		defer mu.Unlock()
+		fastRuns++
+		return nil
+	})
+
+	if started, err := dispatcher.RunDue(context.Background()); err != nil || started != 2 {
+		t.Fatalf("expected 2 jobs started, got %d, %v", started, err)
+	}
+
+	waitForRelease(t, dispatcher, "fast")
+
+	// The next tick returns while the slow job is still running and starts
+	// only the fast schedule again
+	mu.Lock()
+	now = now.Add(time.Minute)
+	mu.Unlock()
+	if started, err := dispatcher.RunDue(context.Background()); err != nil || started != 1 {
+		t.Fatalf("expected 1 job started, got %d, %v", started, err)
+	}
+
+	close(release)
+	dispatcher.Wait()
+
+	if slowRuns != 1 || fastRuns != 2 {
+		t.Errorf("expected 1 slow and 2 fast runs, got %d and %d", slowRuns, fastRuns)
+	}
+}
+
+func TestAdminHandler_UpsertAndList(t *testing.T) {
+	store := newMemoryStore()
+	handler := NewAdminHandler(store, organizationFromContext)
+
+	body := `{"organization_id":"org-a","job_type":"analytics.snapshot","cron_expression":"0 3 * * *","timezone":"UTC","enabled":true}`
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, asOrganization(httptest.NewRequest(http.MethodPut, "/schedules", strings.NewReader(body)), "org-a"))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, asOrganization(httptest.NewRequest(http.MethodGet, "/schedules", nil), "org-a"))
+	if rec.Code != http.StatusOK || !strings.Contains(rec.Body.String(), "analytics.snapshot") {
+		t.Errorf("expected schedule in list, got %d: %s", rec.Code, rec.Body.String())
+	}
+}
+
+func TestAdminHandler_Upsert_WithInvalidCron_ReturnsBadRequest(t *testing.T) {
+	handler := NewAdminHandler(newMemoryStore(), organizationFromContext)
+
+	body := `{"organization_id":"org-a","job_type":"analytics.snapshot","cron_expression":"every day"}`
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, asOrganization(httptest.NewRequest(http.MethodPut, "/schedules", strings.NewReader(body)), "org-a"))
+
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("expected 400, got %d", rec.Code)
+	}
+}
+
+func TestAdminHandler_Delete_WithUnknownID_ReturnsNotFound(t *testing.T) {
+	handler := NewAdminHandler(newMemoryStore(), organizationFromContext)
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, asOrganization(httptest.NewRequest(http.MethodDelete, "/schedules/missing", nil), "org-a"))
+
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("expected 404, got %d", rec.Code)
+	}
+}
+
+func TestAdminHandler_WithoutOrganization_ReturnsUnauthorized(t *testing.T) {
+	handler := NewAdminHandler(newMemoryStore(), organizationFromContext)
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/schedules?organization_id=org-a", nil))
+
+	if rec.Code != http.StatusUnauthorized {
+		t.Errorf("expected 401, got %d", rec.Code)
+	}
+}
+
+func TestAdminHandler_CrossTenantAccess(t *testing.T) {
+	victim := &Schedule{ID: "b", OrganizationID: "org-b", JobType: JobAnalyticsSnapshot, CronExpression: "0 3 * * *", Enabled: true}
+	store := newMemoryStore(victim)
+	handler := NewAdminHandler(store, organizationFromContext)
+
+	// The query parameter is ignored; org-a only sees its own schedules
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, asOrganization(httptest.NewRequest(http.MethodGet, "/schedules?organization_id=org-b", nil), "org-a"))
+	if rec.Code != http.StatusOK || strings.Contains(rec.Body.String(), "org-b") {
+		t.Errorf("expected no org-b schedules, got %d: %s", rec.Code, rec.Body.String())
+	}
+
+	// Naming another organization in the body is rejected
+	body := `{"organization_id":"org-b","job_type":"analytics.snapshot","cron_expression":"* * * * *","enabled":true}`
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, asOrganization(httptest.NewRequest(http.MethodPut, "/schedules", strings.NewReader(body)), "org-a"))
+	if rec.Code != http.StatusForbidden {
+		t.Errorf("expected 403 for another organization's body, got %d", rec.Code)
+	}
+
+	// Reusing another organization's schedule ID creates org-a's own row
+	body = `{"id":"b","job_type":"analytics.snapshot","cron_expression":"* * * * *","enabled":true}`
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, asOrganization(httptest.NewRequest(http.MethodPut, "/schedules", strings.NewReader(body)), "org-a"))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+	if store.schedules["b"].OrganizationID != "org-b" || store.schedules["b"].CronExpression != "0 3 * * *" {
+		t.Errorf("expected org-b schedule untouched, got %+v", store.schedules["b"])
+	}
+
+	// Deleting another organization's schedule looks like a missing one
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, asOrganization(httptest.NewRequest(http.MethodDelete, "/schedules/b", nil), "org-a"))
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("expected 404, got %d", rec.Code)
+	}
+	if _, ok := store.schedules["b"]; !ok {
+		t.Error("expected org-b schedule not to be deleted")
+	}
+}
+
+type memoryRunStore struct {
+	mu   sync.Mutex
+	runs []*Run
+}
+
+func (s *memoryRunStore) RecordRun(ctx context.Context, run *Run) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.runs = append(s.runs, run)
+	return nil
+}
+
+func (s *memoryRunStore) ListRuns(ctx context.Context, organizationID string, limit int) ([]*Run, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var result []*Run
+	for i := len(s.runs) - 1; i >= 0 && len(result) < limit; i-- {
+		if s.runs[i].OrganizationID == organizationID {
+			result = append(result, s.runs[i])
+		}
+	}
+	return result, nil
+}
+
+func TestDispatcher_RunDue_WithHistory_RecordsOutcomes(t *testing.T) {
+	now := time.Date(2026, 3, 10, 2, 0, 0, 0, time.UTC)
+	store := newMemoryStore(
+		&Schedule{ID: "a", OrganizationID: "org-a", JobType: JobAnalyticsSnapshot, CronExpression: "@hourly", Enabled: true, UpdatedAt: now.Add(-2 * time.Hour)},
+		&Schedule{ID: "b", OrganizationID: "org-b", JobType: JobAnalyticsSnapshot, CronExpression: "@hourly", Enabled: true, UpdatedAt: now.Add(-2 * time.Hour)},
+		&Schedule{ID: "c", OrganizationID: "org-c", JobType: JobAnalyticsSnapshot, CronExpression: "@hourly", Enabled: true, UpdatedAt: now.Add(-2 * time.Hour)},
+	)
+	runs := &memoryRunStore{}
+
+	dispatcher := NewDispatcher(store)
+	dispatcher.now = func() time.Time { return now }
+	dispatcher.History(runs)
+	dispatcher.Register(JobAnalyticsSnapshot, func(ctx context.Context, organizationID string) error {
+		switch organizationID {
+		case "org-b":
+			return errors.New("snapshot failed")
+		case "org-c":
+			return ErrSkipped
+		}
+		return nil
+	})
+
+	var reported []error
+	var mu sync.Mutex
+	dispatcher.OnError(func(schedule *Schedule, err error) {
+		mu.Lock()
+		defer mu.Unlock()
+		reported = append(reported, err)
+	})
+
+	if _, err := dispatcher.RunDue(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	dispatcher.Wait()
+
+	want := map[string]RunStatus{"org-a": RunSucceeded, "org-b": RunFailed, "org-c": RunSkipped}
+	if len(runs.runs) != len(want) {
+		t.Fatalf("expected %d runs, got %d", len(want), len(runs.runs))
+	}
+	for _, run := range runs.runs {
+		if run.Status != want[run.OrganizationID] {
+			t.Errorf("expected %s for %s, got %s", want[run.OrganizationID], run.OrganizationID, run.Status)
+		}
+		if run.FinishedAt == nil {
+			t.Errorf("expected finished_at for %s", run.OrganizationID)
+		}
+		if run.OrganizationID == "org-b" && run.Error != "snapshot failed" {
+			t.Errorf("expected error message on failed run, got %q", run.Error)
+		}
+	}
+
+	// Skipped runs are not errors
+	if len(reported) != 1 {
+		t.Errorf("expected only the failure to be reported, got %v", reported)
+	}
+}
+
+func TestAdminHandler_ListRuns(t *testing.T) {
+	runs := &memoryRunStore{runs: []*Run{
+		{ID: "1", OrganizationID: "org-a", JobType: JobAnalyticsSnapshot, Status: RunFailed, Error: "timeout"},
+		{ID: "2", OrganizationID: "org-b", JobType: JobAnalyticsSnapshot, Status: RunSucceeded},
+	}}
+	handler := NewAdminHandler(newMemoryStore(), organizationFromContext)
+	handler.History(runs)
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, asOrganization(httptest.NewRequest(http.MethodGet, "/schedules/runs?organization_id=org-b", nil), "org-a"))
+	if rec.Code != http.StatusOK || !strings.Contains(rec.Body.String(), "timeout") || strings.Contains(rec.Body.String(), "org-b") {
+		t.Errorf("expected only org-a runs, got %d: %s", rec.Code, rec.Body.String())
+	}
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, asOrganization(httptest.NewRequest(http.MethodGet, "/schedules/runs?limit=0", nil), "org-a"))
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("expected 400 for invalid limit, got %d", rec.Code)
+	}
+}
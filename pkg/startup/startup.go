@@ -0,0 +1,122 @@
+// Package startup waits for dependencies on boot instead of crashing, so a
+// cluster cold start converges without crash loops.
+package startup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"net/http"
+	"sync/atomic"
+	"time"
+)
+
+var ErrNotReady = errors.New("startup: service is not ready")
+
+type Backoff struct {
+	InitialInterval time.Duration
+	MaxInterval     time.Duration
+	Multiplier      float64
+	// Jitter is the random fraction (0-1) applied to each interval.
+	Jitter float64
+	// MaxElapsed stops retrying after this long; zero retries until ctx is done.
+	MaxElapsed time.Duration
+}
+
+func DefaultBackoff() Backoff {
+	return Backoff{
+		InitialInterval: 500 * time.Millisecond,
+		MaxInterval:     30 * time.Second,
+		Multiplier:      2,
+		Jitter:          0.2,
+		MaxElapsed:      5 * time.Minute,
+	}
+}
+
+// interval returns the wait before the given retry attempt (starting at 1).
+func (b Backoff) interval(attempt int) time.Duration {
+	d := float64(b.InitialInterval)
+	for i := 1; i < attempt; i++ {
+		d *= b.Multiplier
+		if d >= float64(b.MaxInterval) {
+			d = float64(b.MaxInterval)
+			break
+		}
+	}
+
+	if b.Jitter > 0 {
+		d += d * b.Jitter * (2*rand.Float64() - 1)
+	}
+
+	return time.Duration(d)
+}
+
+// Retry calls connect until it succeeds, the backoff's MaxElapsed passes or
+// ctx is cancelled. onRetry, if set, is told about every failed attempt.
+func Retry(ctx context.Context, name string, backoff Backoff, connect func(ctx context.Context) error, onRetry func(attempt int, wait time.Duration, err error)) error {
+	start := time.Now()
+
+	for attempt := 1; ; attempt++ {
+		err := connect(ctx)
+		if err == nil {
+			return nil
+		}
+
+		wait := backoff.interval(attempt)
+		if backoff.MaxElapsed > 0 && time.Since(start)+wait > backoff.MaxElapsed {
+			return fmt.Errorf("%s unavailable after %d attempts: %w", name, attempt, err)
+		}
+
+		if onRetry != nil {
+			onRetry(attempt, wait, err)
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return fmt.Errorf("%s unavailable: %w", name, ctx.Err())
+		case <-timer.C:
+		}
+	}
+}
+
+// Gate tracks whether startup has finished. Serve its Handler (or use Check
+// with pkg/health) while dependencies are still being retried.
+type Gate struct {
+	ready atomic.Bool
+}
+
+func NewGate() *Gate {
+	return &Gate{}
+}
+
+func (g *Gate) MarkReady() {
+	g.ready.Store(true)
+}
+
+func (g *Gate) Ready() bool {
+	return g.ready.Load()
+}
+
+func (g *Gate) Check(ctx context.Context) error {
+	if !g.Ready() {
+		return ErrNotReady
+	}
+	return nil
+}
+
+// Handler answers 503 until MarkReady is called, then 200.
+func (g *Gate) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if !g.Ready() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			_, _ = w.Write([]byte(`{"status":"starting"}`))
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"status":"ready"}`))
+	})
+}
@@ -0,0 +1,100 @@
+package startup
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func fastBackoff() Backoff {
+	return Backoff{
+		InitialInterval: time.Millisecond,
+		MaxInterval:     4 * time.Millisecond,
+		Multiplier:      2,
+		MaxElapsed:      time.Second,
+	}
+}
+
+func TestRetry_SucceedsAfterTransientFailures(t *testing.T) {
+	attempts := 0
+	var retries []int
+
+	err := Retry(context.Background(), "postgres", fastBackoff(), func(ctx context.Context) error {
+		attempts++
+		if attempts < 3 {
+			return errors.New("connection refused")
+		}
+		return nil
+	}, func(attempt int, wait time.Duration, err error) {
+		retries = append(retries, attempt)
+	})
+
+	if err != nil {
+		t.Fatalf("expected success, got %v", err)
+	}
+	if attempts != 3 || len(retries) != 2 {
+		t.Errorf("expected 3 attempts and 2 retries, got %d and %d", attempts, len(retries))
+	}
+}
+
+func TestRetry_GivesUpAfterMaxElapsed(t *testing.T) {
+	backoff := fastBackoff()
+	backoff.MaxElapsed = 20 * time.Millisecond
+	cause := errors.New("no servers available")
+
+	err := Retry(context.Background(), "nats", backoff, func(ctx context.Context) error {
+		return cause
+	}, nil)
+
+	if !errors.Is(err, cause) {
+		t.Errorf("expected last error to be wrapped, got %v", err)
+	}
+}
+
+func TestRetry_StopsWhenContextCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	backoff := fastBackoff()
+	backoff.MaxElapsed = 0
+
+	err := Retry(ctx, "redis", backoff, func(ctx context.Context) error {
+		return errors.New("down")
+	}, nil)
+
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("expected context.Canceled, got %v", err)
+	}
+}
+
+func TestBackoff_Interval_IsCappedAndJittered(t *testing.T) {
+	backoff := Backoff{InitialInterval: 100 * time.Millisecond, MaxInterval: time.Second, Multiplier: 2, Jitter: 0.2}
+
+	if got := backoff.interval(20); got > 1200*time.Millisecond || got < 800*time.Millisecond {
+		t.Errorf("expected capped interval within jitter, got %v", got)
+	}
+	if got := backoff.interval(1); got > 120*time.Millisecond || got < 80*time.Millisecond {
+		t.Errorf("expected initial interval within jitter, got %v", got)
+	}
+}
+
+func TestGate_Handler(t *testing.T) {
+	gate := NewGate()
+
+	rec := httptest.NewRecorder()
+	gate.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/ready", nil))
+	if rec.Code != http.StatusServiceUnavailable {
+		t.Errorf("expected 503 before ready, got %d", rec.Code)
+	}
+
+	gate.MarkReady()
+
+	rec = httptest.NewRecorder()
+	gate.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/ready", nil))
+	if rec.Code != http.StatusOK {
+		t.Errorf("expected 200 after ready, got %d", rec.Code)
+	}
+}
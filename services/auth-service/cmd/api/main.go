@@ -2,74 +2,112 @@ package main
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
-	"log"
+	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
 	"time"
 
 	"github.com/joho/godotenv"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/redis/go-redis/v9"
 	"gorm.io/gorm"
 
 	pkgDatabase "github.com/giia/giia-core-engine/pkg/database"
 	pkgErrors "github.com/giia/giia-core-engine/pkg/errors"
+	"github.com/giia/giia-core-engine/pkg/health"
 	pkgLogger "github.com/giia/giia-core-engine/pkg/logger"
+	"github.com/giia/giia-core-engine/pkg/startup"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/infrastructure/adapters/metrics"
 	"github.com/giia/giia-core-engine/services/auth-service/internal/infrastructure/config"
 	grpcInit "github.com/giia/giia-core-engine/services/auth-service/internal/infrastructure/grpc/initialization"
+	grpcServer "github.com/giia/giia-core-engine/services/auth-service/internal/infrastructure/grpc/server"
 	"github.com/giia/giia-core-engine/services/auth-service/pkg/database"
 )
 
 func main() {
+	ctx := context.Background()
+
 	// Load environment variables
-	if err := godotenv.Load(); err != nil {
-		log.Println("Warning: .env file not found, using system environment variables")
+	envErr := godotenv.Load()
+
+	// Initialize logger (LOG_LEVEL, LOG_FORMAT, LOG_DEBUG_SAMPLE_RATE, LOG_COMPONENT_LEVELS)
+	logger := pkgLogger.NewWithOptions("auth-service", pkgLogger.OptionsFromEnv())
+	if envErr != nil {
+		logger.Warn(ctx, ".env file not found, using system environment variables", nil)
 	}
 
 	// Load configuration
 	cfg := config.Load()
 
-	// Log the database configuration
-	log.Printf("🔧 [Database] Config: Host=%s, Port=%s, User=%s, DBName=%s, SSLMode=%s",
-		cfg.Database.Host, cfg.Database.Port, cfg.Database.User, cfg.Database.DBName, cfg.Database.SSLMode)
+	logger.Info(ctx, "Database configuration loaded", pkgLogger.Tags{
+		"host":     cfg.Database.Host,
+		"port":     cfg.Database.Port,
+		"user":     cfg.Database.User,
+		"db_name":  cfg.Database.DBName,
+		"ssl_mode": cfg.Database.SSLMode,
+	})
 
-	// Initialize database
+	// Initialize database. DB_POOL_MODE selects the pool sizing profile and
+	// DB_MAX_OPEN_CONNS and friends override individual limits.
+	poolProfile := pkgDatabase.PoolProfileFromEnv("DB")
 	dbConfig := database.Config{
 		DSN:             cfg.GetDatabaseDSN(),
-		MaxOpenConns:    25,
-		MaxIdleConns:    5,
-		ConnMaxLifetime: 5 * time.Minute,
-		ConnMaxIdleTime: 2 * time.Minute,
+		MaxOpenConns:    poolProfile.MaxOpenConns,
+		MaxIdleConns:    poolProfile.MaxIdleConns,
+		ConnMaxLifetime: poolProfile.ConnMaxLifetime,
+		ConnMaxIdleTime: poolProfile.ConnMaxIdleTime,
 	}
+	logger.Info(ctx, "Using database pool profile", pkgLogger.Tags{
+		"pool_mode":        cfg.Database.PoolMode,
+		"max_open_conns":   poolProfile.MaxOpenConns,
+		"max_idle_conns":   poolProfile.MaxIdleConns,
+		"max_open_ceiling": poolProfile.MaxOpenCeiling,
+	})
 
-	log.Printf("🔧 [Database] DSN: %s", dbConfig.DSN)
+	// Serve health before waiting on dependencies so probes see a live but
+	// not-ready pod while startup retries; the gate flips once everything is up
+	gate := startup.NewGate()
+	readiness := health.NewChecker("auth-service").AddCritical("startup", gate.Check)
+	healthPort := fmt.Sprintf(":%s", getEnvOrDefault("HEALTH_PORT", "8091"))
+	healthServer := newHealthServer(healthPort, readiness)
+	go func() {
+		logger.Info(ctx, "Starting health server", pkgLogger.Tags{"port": healthPort})
+		if err := healthServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			logger.Fatal(ctx, err, "Failed to start health server", nil)
+		}
+	}()
 
-	// Adjust connection pool settings based on pool mode
-	if cfg.Database.PoolMode == "transaction" {
-		// For transaction pooling, we can use more connections
-		// since the pooler handles the actual database connections
-		dbConfig.MaxOpenConns = 50
-		dbConfig.MaxIdleConns = 10
-		log.Printf("🔧 [Database] Using transaction pooling mode (MaxOpenConns: %d, MaxIdleConns: %d)",
-			dbConfig.MaxOpenConns, dbConfig.MaxIdleConns)
-	} else {
-		// Use standard connection pool settings
-		dbConfig.MaxOpenConns = 25
-		dbConfig.MaxIdleConns = 5
-		log.Printf("🔧 [Database] Using standard connection pool (MaxOpenConns: %d, MaxIdleConns: %d)",
-			dbConfig.MaxOpenConns, dbConfig.MaxIdleConns)
+	// Dependencies may still be starting on a cluster cold start; wait for
+	// them with backoff instead of crash looping
+	backoff := startup.DefaultBackoff()
+	logRetry := func(name string) func(int, time.Duration, error) {
+		return func(attempt int, wait time.Duration, err error) {
+			logger.Warn(ctx, "Dependency not available yet, retrying", pkgLogger.Tags{
+				"dependency": name,
+				"attempt":    attempt,
+				"retry_in":   wait.Round(time.Millisecond).String(),
+				"error":      err.Error(),
+			})
+		}
 	}
 
-	db, err := database.NewConnection(dbConfig)
+	var db *database.DB
+	err := startup.Retry(ctx, "database", backoff, func(ctx context.Context) error {
+		var connErr error
+		db, connErr = database.NewConnection(dbConfig)
+		return connErr
+	}, logRetry("database"))
 	if err != nil {
-		log.Fatalf("Failed to connect to database: %v", err)
+		logger.Fatal(ctx, err, "Failed to connect to database", nil)
 	}
 	defer db.Close()
 
 	// Run database migrations
 	if err := db.RunMigrations(); err != nil {
-		log.Fatalf("Failed to run migrations: %v", err)
+		logger.Fatal(ctx, err, "Failed to run migrations", nil)
 	}
 
 	// Initialize Redis client
@@ -80,29 +118,63 @@ func main() {
 	})
 
 	// Test Redis connection
-	ctx := context.Background()
-	if err := redisClient.Ping(ctx).Err(); err != nil {
-		log.Printf("⚠️  [Redis] Warning: Failed to connect to Redis: %v", err)
-		log.Printf("⚠️  [Redis] gRPC server will not start without Redis")
+	if err := startup.Retry(ctx, "redis", backoff, func(ctx context.Context) error {
+		return redisClient.Ping(ctx).Err()
+	}, logRetry("redis")); err != nil {
+		logger.Error(ctx, err, "Failed to connect to Redis; gRPC server will not start without Redis", nil)
 	} else {
-		log.Printf("✅ [Redis] Connected successfully to %s", cfg.GetRedisAddr())
+		logger.Info(ctx, "Connected to Redis", pkgLogger.Tags{"addr": cfg.GetRedisAddr()})
 	}
 
-	// Initialize logger
-	logger := pkgLogger.New("auth-service", "info")
-
 	// Initialize GORM database for gRPC server
-	gormDB, err := connectGormDB(cfg)
+	var gormDB *gorm.DB
+	err = startup.Retry(ctx, "database", backoff, func(ctx context.Context) error {
+		var connErr error
+		gormDB, connErr = connectGormDB(cfg, poolProfile)
+		return connErr
+	}, logRetry("database"))
 	if err != nil {
-		log.Fatalf("Failed to connect GORM database: %v", err)
+		logger.Fatal(ctx, err, "Failed to connect GORM database", nil)
+	}
+	slowQueries := pkgDatabase.NewSlowQueryPlugin(pkgDatabase.SlowQueryConfig{
+		Threshold:      cfg.Database.SlowQueryThreshold,
+		Logger:         logger,
+		CaptureExplain: cfg.Database.ExplainSlowQueries,
+	})
+	if err := gormDB.Use(slowQueries); err != nil {
+		logger.Fatal(ctx, err, "Failed to register slow query plugin", nil)
 	}
 	defer func() {
+		slowQueries.Wait()
 		sqlDB, _ := gormDB.DB()
 		if sqlDB != nil {
 			sqlDB.Close()
 		}
 	}()
 
+	// Export pool usage and, when the profile has a ceiling, grow or shrink
+	// the pools with demand
+	gormSQLDB, err := gormDB.DB()
+	if err != nil {
+		logger.Fatal(ctx, err, "Failed to get GORM connection pool", nil)
+	}
+	pools := map[string]*sql.DB{"auth": db.DB, "grpc": gormSQLDB}
+	prometheus.MustRegister(metrics.NewDBPoolCollector(pools))
+
+	tunerCtx, stopTuners := context.WithCancel(ctx)
+	defer stopTuners()
+	for name, pool := range pools {
+		name := name
+		tuner := pkgDatabase.NewPoolTuner(pool, poolProfile, 30*time.Second, func(from, to int) {
+			logger.Info(ctx, "Resized database pool", pkgLogger.Tags{
+				"pool":               name,
+				"max_open_conns_was": from,
+				"max_open_conns":     to,
+			})
+		})
+		go tuner.Run(tunerCtx)
+	}
+
 	// Initialize gRPC server
 	grpcPort := fmt.Sprintf(":%s", getEnvOrDefault("GRPC_PORT", "9091"))
 	grpcContainer, err := grpcInit.InitializeGRPCServer(&grpcInit.GRPCConfig{
@@ -113,36 +185,44 @@ func main() {
 		JWTIssuer:        cfg.JWT.Issuer,
 		DB:               gormDB,
 		RedisClient:      redisClient,
+		Readiness:        grpcServer.AddDependencyChecks(readiness, gormDB, redisClient),
 		Logger:           logger,
 	})
 	if err != nil {
-		log.Fatalf("Failed to initialize gRPC server: %v", err)
+		logger.Fatal(ctx, err, "Failed to initialize gRPC server", nil)
 	}
 
 	// Start gRPC server in a goroutine
 	go func() {
-		log.Printf("🚀 [gRPC Server] Starting on %s", grpcPort)
+		logger.Info(ctx, "Starting gRPC server", pkgLogger.Tags{"port": grpcPort})
 		if err := grpcContainer.Server.Start(); err != nil {
-			log.Fatalf("Failed to start gRPC server: %v", err)
+			logger.Fatal(ctx, err, "Failed to start gRPC server", nil)
 		}
 	}()
+	gate.MarkReady()
 
 	// Setup graceful shutdown
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
 	<-quit
 
-	log.Println("🛑 [Auth Service] Shutting down server...")
+	logger.Info(ctx, "Shutting down server", nil)
 
 	// Shutdown gRPC server
 	grpcContainer.Server.Stop()
-	log.Println("✅ [gRPC Server] Server shutdown gracefully")
+	logger.Info(ctx, "gRPC server shut down gracefully", nil)
+
+	shutdownCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	if err := healthServer.Shutdown(shutdownCtx); err != nil {
+		logger.Error(ctx, err, "Failed to shut down health server", nil)
+	}
 
 	// Close Redis connection
 	if err := redisClient.Close(); err != nil {
-		log.Printf("⚠️  [Redis] Error closing connection: %v", err)
+		logger.Error(ctx, err, "Failed to close Redis connection", nil)
 	} else {
-		log.Println("✅ [Redis] Connection closed")
+		logger.Info(ctx, "Redis connection closed", nil)
 	}
 }
 
@@ -153,9 +233,20 @@ func getEnvOrDefault(key, defaultValue string) string {
 	return defaultValue
 }
 
-func connectGormDB(cfg *config.Config) (*gorm.DB, error) {
+// newHealthServer serves /live, which only proves the process is up, and
+// /ready, which stays 503 until startup finishes and the dependencies answer.
+func newHealthServer(addr string, readiness *health.Checker) *http.Server {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/live", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	mux.Handle("/ready", readiness.Handler())
+	return &http.Server{Addr: addr, Handler: mux, ReadHeaderTimeout: 5 * time.Second}
+}
+
+func connectGormDB(cfg *config.Config, profile pkgDatabase.PoolProfile) (*gorm.DB, error) {
 	dsn := cfg.GetDatabaseDSN()
-	gormDB, err := pkgDatabase.ConnectWithDSN(context.Background(), dsn)
+	gormDB, err := pkgDatabase.ConnectWithDSNAndProfile(context.Background(), dsn, profile)
 	if err != nil {
 		return nil, pkgErrors.NewInternalServerError("failed to connect to database")
 	}
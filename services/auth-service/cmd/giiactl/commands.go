@@ -0,0 +1,79 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"strings"
+
+	"github.com/giia/giia-core-engine/services/auth-service/internal/infrastructure/grpc/client"
+)
+
+// stringList collects a repeatable flag.
+type stringList []string
+
+func (l *stringList) String() string { return strings.Join(*l, ",") }
+
+func (l *stringList) Set(value string) error {
+	*l = append(*l, value)
+	return nil
+}
+
+func validateToken(ctx context.Context, authClient *client.AuthClient, args []string) error {
+	fs := flag.NewFlagSet("token:validate", flag.ContinueOnError)
+	token := fs.String("token", "", "access token to validate")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *token == "" {
+		return errors.New("-token is required")
+	}
+
+	resp, err := authClient.ValidateToken(ctx, *token, requestID())
+	if err != nil {
+		return err
+	}
+	return printJSON(resp)
+}
+
+func getUser(ctx context.Context, authClient *client.AuthClient, args []string) error {
+	fs := flag.NewFlagSet("user:get", flag.ContinueOnError)
+	userID := fs.String("user", "", "user ID")
+	orgID := fs.String("org", "", "organization ID")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *userID == "" || *orgID == "" {
+		return errors.New("-user and -org are required")
+	}
+
+	resp, err := authClient.GetUser(ctx, *userID, *orgID, requestID())
+	if err != nil {
+		return err
+	}
+	return printJSON(resp.User)
+}
+
+func checkPermissions(ctx context.Context, authClient *client.AuthClient, args []string) error {
+	fs := flag.NewFlagSet("permission:check", flag.ContinueOnError)
+	userID := fs.String("user", "", "user ID")
+	var permissions stringList
+	fs.Var(&permissions, "perm", "permission code (repeatable)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *userID == "" || len(permissions) == 0 {
+		return errors.New("-user and at least one -perm are required")
+	}
+
+	resp, err := authClient.BatchCheckPermissions(ctx, *userID, permissions, requestID())
+	if err != nil {
+		return err
+	}
+
+	results := make(map[string]bool, len(permissions))
+	for i, permission := range permissions {
+		results[permission] = i < len(resp.Results) && resp.Results[i]
+	}
+	return printJSON(results)
+}
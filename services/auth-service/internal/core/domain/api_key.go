@@ -0,0 +1,119 @@
+package domain
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	apiKeyPrefix     = "giia_"
+	apiKeyLookupLen  = 12
+	apiKeySecretSize = 32
+
+	DefaultAPIKeyRateLimit = 60
+	MaxAPIKeyRateLimit     = 600
+)
+
+// APIKeyScopeActions lists the actions an API key may be scoped to. Keys are
+// meant for external read access (BI tools), so write actions are rejected.
+var APIKeyScopeActions = []string{"read", "list", "export"}
+
+// APIKey is a read-only credential for machine clients. Only the SHA-256 hash
+// of the key is stored; the plaintext is returned once on creation.
+type APIKey struct {
+	ID                 uuid.UUID  `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	OrganizationID     uuid.UUID  `json:"organization_id" gorm:"type:uuid;not null;index"`
+	Name               string     `json:"name" gorm:"type:varchar(100);not null"`
+	Prefix             string     `json:"prefix" gorm:"type:varchar(20);not null;uniqueIndex"`
+	KeyHash            string     `json:"-" gorm:"type:varchar(64);not null"`
+	Scopes             []string   `json:"scopes" gorm:"type:jsonb;serializer:json;not null"`
+	RateLimitPerMinute int        `json:"rate_limit_per_minute" gorm:"not null;default:60"`
+	CreatedBy          uuid.UUID  `json:"created_by" gorm:"type:uuid;not null"`
+	ExpiresAt          *time.Time `json:"expires_at,omitempty"`
+	LastUsedAt         *time.Time `json:"last_used_at,omitempty"`
+	RevokedAt          *time.Time `json:"revoked_at,omitempty"`
+	CreatedAt          time.Time  `json:"created_at" gorm:"not null;default:CURRENT_TIMESTAMP"`
+	UpdatedAt          time.Time  `json:"updated_at" gorm:"not null;default:CURRENT_TIMESTAMP"`
+}
+
+func (APIKey) TableName() string {
+	return "api_keys"
+}
+
+func (k *APIKey) IsActive(now time.Time) bool {
+	if k.RevokedAt != nil {
+		return false
+	}
+	return k.ExpiresAt == nil || now.Before(*k.ExpiresAt)
+}
+
+// IsValidAPIKeyScope reports whether scope has the service:resource:action
+// format with a read-only action.
+func IsValidAPIKeyScope(scope string) bool {
+	parts := strings.Split(scope, ":")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" {
+		return false
+	}
+
+	for _, action := range APIKeyScopeActions {
+		if parts[2] == action {
+			return true
+		}
+	}
+	return false
+}
+
+// GenerateAPIKey returns a new key "giia_<prefix>_<secret>", its lookup
+// prefix and its hash.
+func GenerateAPIKey() (key, prefix, hash string, err error) {
+	lookup := make([]byte, apiKeyLookupLen/2)
+	if _, err := rand.Read(lookup); err != nil {
+		return "", "", "", err
+	}
+
+	secret := make([]byte, apiKeySecretSize)
+	if _, err := rand.Read(secret); err != nil {
+		return "", "", "", err
+	}
+
+	prefix = hex.EncodeToString(lookup)
+	key = apiKeyPrefix + prefix + "_" + hex.EncodeToString(secret)
+	return key, prefix, HashAPIKey(key), nil
+}
+
+func HashAPIKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+// ParseAPIKeyPrefix extracts the lookup prefix from a plaintext key.
+func ParseAPIKeyPrefix(key string) (string, bool) {
+	rest, ok := strings.CutPrefix(key, apiKeyPrefix)
+	if !ok {
+		return "", false
+	}
+
+	prefix, secret, ok := strings.Cut(rest, "_")
+	if !ok || len(prefix) != apiKeyLookupLen || secret == "" {
+		return "", false
+	}
+	return prefix, true
+}
+
+type CreateAPIKeyRequest struct {
+	Name               string   `json:"name" binding:"required"`
+	Scopes             []string `json:"scopes" binding:"required"`
+	RateLimitPerMinute int      `json:"rate_limit_per_minute"`
+	ExpiresInDays      int      `json:"expires_in_days"`
+}
+
+// CreateAPIKeyResponse carries the plaintext key, which is never shown again.
+type CreateAPIKeyResponse struct {
+	APIKey *APIKey `json:"api_key"`
+	Key    string  `json:"key"`
+}
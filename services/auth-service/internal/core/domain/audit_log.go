@@ -0,0 +1,59 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	AuditActionImpersonationRequested = "impersonation.requested"
+	AuditActionImpersonationApproved  = "impersonation.approved"
+	AuditActionImpersonationDenied    = "impersonation.denied"
+	AuditActionImpersonationStarted   = "impersonation.started"
+	AuditActionImpersonationEnded     = "impersonation.ended"
+	AuditActionRequest                = "http.request"
+	AuditActionAPIKeyCreated          = "api_key.created"
+	AuditActionAPIKeyRevoked          = "api_key.revoked"
+	AuditActionOrganizationExported   = "organization.exported"
+	AuditActionOrganizationImported   = "organization.imported"
+	AuditActionMemberAdded            = "membership.added"
+	AuditActionMemberRemoved          = "membership.removed"
+	AuditActionOrganizationSwitched   = "organization.switched"
+	AuditActionManagerChanged         = "user.manager_changed"
+	AuditActionSessionsRevoked        = "user.sessions_revoked"
+)
+
+type AuditLog struct {
+	ID              uuid.UUID  `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	OrganizationID  uuid.UUID  `json:"organization_id" gorm:"type:uuid;not null;index"`
+	ActorID         uuid.UUID  `json:"actor_id" gorm:"type:uuid;not null;index"`
+	ImpersonatorID  *uuid.UUID `json:"impersonator_id,omitempty" gorm:"type:uuid;index"`
+	ImpersonationID *uuid.UUID `json:"impersonation_id,omitempty" gorm:"type:uuid"`
+	Action          string     `json:"action" gorm:"type:varchar(100);not null"`
+	Resource        string     `json:"resource" gorm:"type:varchar(255)"`
+	Method          string     `json:"method,omitempty" gorm:"type:varchar(10)"`
+	StatusCode      int        `json:"status_code,omitempty"`
+	IPAddress       string     `json:"ip_address,omitempty" gorm:"type:varchar(45)"`
+	Details         string     `json:"details,omitempty" gorm:"type:text"`
+	CreatedAt       time.Time  `json:"created_at" gorm:"not null;default:CURRENT_TIMESTAMP;index"`
+}
+
+func (AuditLog) TableName() string {
+	return "audit_logs"
+}
+
+const (
+	DefaultAuditLogLimit = 100
+	MaxAuditLogLimit     = 1000
+)
+
+// AuditLogFilter narrows an audit log query. Entries are returned newest
+// first; to page, repeat the query with To set to the oldest CreatedAt seen.
+type AuditLogFilter struct {
+	ActorID *uuid.UUID
+	Action  string
+	From    *time.Time
+	To      *time.Time
+	Limit   int
+}
@@ -0,0 +1,88 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type EmailChangeStatus string
+
+const (
+	EmailChangeStatusPending   EmailChangeStatus = "pending"
+	EmailChangeStatusCompleted EmailChangeStatus = "completed"
+	EmailChangeStatusCancelled EmailChangeStatus = "cancelled"
+)
+
+// EmailChangeRequest tracks a login email change. Both the current and the new
+// address receive a link and the change applies once both are confirmed.
+type EmailChangeRequest struct {
+	ID             uuid.UUID         `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	UserID         uuid.UUID         `json:"user_id" gorm:"type:uuid;not null;index"`
+	OrganizationID uuid.UUID         `json:"organization_id" gorm:"type:uuid;not null"`
+	OldEmail       string            `json:"old_email" gorm:"type:varchar(255);not null"`
+	NewEmail       string            `json:"new_email" gorm:"type:varchar(255);not null"`
+	OldTokenHash   string            `json:"-" gorm:"type:varchar(64);not null;uniqueIndex"`
+	NewTokenHash   string            `json:"-" gorm:"type:varchar(64);not null;uniqueIndex"`
+	OldConfirmedAt *time.Time        `json:"old_confirmed_at,omitempty"`
+	NewConfirmedAt *time.Time        `json:"new_confirmed_at,omitempty"`
+	Status         EmailChangeStatus `json:"status" gorm:"type:varchar(20);not null;default:'pending'"`
+	ExpiresAt      time.Time         `json:"expires_at" gorm:"not null"`
+	CompletedAt    *time.Time        `json:"completed_at,omitempty"`
+	CreatedAt      time.Time         `json:"created_at" gorm:"not null;default:CURRENT_TIMESTAMP"`
+	UpdatedAt      time.Time         `json:"updated_at" gorm:"not null;default:CURRENT_TIMESTAMP"`
+}
+
+func (EmailChangeRequest) TableName() string {
+	return "email_change_requests"
+}
+
+func (r *EmailChangeRequest) IsPending(now time.Time) bool {
+	return r.Status == EmailChangeStatusPending && now.Before(r.ExpiresAt)
+}
+
+func (r *EmailChangeRequest) BothConfirmed() bool {
+	return r.OldConfirmedAt != nil && r.NewConfirmedAt != nil
+}
+
+type StartEmailChangeRequest struct {
+	NewEmail        string `json:"new_email" binding:"required,email"`
+	CurrentPassword string `json:"current_password" binding:"required"`
+	IPAddress       string `json:"-"`
+	UserAgent       string `json:"-"`
+}
+
+type ConfirmEmailChangeRequest struct {
+	Token     string `json:"token" binding:"required"`
+	IPAddress string `json:"-"`
+	UserAgent string `json:"-"`
+}
+
+type EmailChangeResponse struct {
+	Status            EmailChangeStatus `json:"status"`
+	NewEmail          string            `json:"new_email"`
+	OldEmailConfirmed bool              `json:"old_email_confirmed"`
+	NewEmailConfirmed bool              `json:"new_email_confirmed"`
+	ExpiresAt         time.Time         `json:"expires_at"`
+}
+
+func (r *EmailChangeRequest) ToResponse() *EmailChangeResponse {
+	return &EmailChangeResponse{
+		Status:            r.Status,
+		NewEmail:          r.NewEmail,
+		OldEmailConfirmed: r.OldConfirmedAt != nil,
+		NewEmailConfirmed: r.NewConfirmedAt != nil,
+		ExpiresAt:         r.ExpiresAt,
+	}
+}
+
+// EmailChangedEvent is published on auth.user.email_changed so notification
+// routing can move the user's delivery address.
+type EmailChangedEvent struct {
+	UserID                 uuid.UUID
+	OrganizationID         uuid.UUID
+	OldEmail               string
+	NewEmail               string
+	RecoveryEmailExpiresAt time.Time
+	OccurredAt             time.Time
+}
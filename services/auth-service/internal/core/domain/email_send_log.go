@@ -0,0 +1,24 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type EmailSendLog struct {
+	ID                uuid.UUID `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	Provider          string    `json:"provider" gorm:"type:varchar(20);not null"`
+	ProviderMessageID string    `json:"provider_message_id" gorm:"type:varchar(255);index"`
+	Recipient         string    `json:"recipient" gorm:"type:varchar(255);not null;index"`
+	Subject           string    `json:"subject" gorm:"type:varchar(500);not null"`
+	Category          string    `json:"category" gorm:"type:varchar(100)"`
+	Status            string    `json:"status" gorm:"type:varchar(20);not null"`
+	Error             string    `json:"error,omitempty" gorm:"type:text"`
+	SentAt            time.Time `json:"sent_at" gorm:"not null"`
+	UpdatedAt         time.Time `json:"updated_at" gorm:"not null;default:CURRENT_TIMESTAMP"`
+}
+
+func (EmailSendLog) TableName() string {
+	return "email_send_logs"
+}
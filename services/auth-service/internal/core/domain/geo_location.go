@@ -0,0 +1,25 @@
+package domain
+
+import "math"
+
+const earthRadiusKm = 6371.0
+
+type GeoLocation struct {
+	Country   string  `json:"country"`
+	City      string  `json:"city"`
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+}
+
+// DistanceKm returns the great-circle distance between two locations.
+func (g *GeoLocation) DistanceKm(other *GeoLocation) float64 {
+	lat1 := g.Latitude * math.Pi / 180
+	lat2 := other.Latitude * math.Pi / 180
+	dLat := (other.Latitude - g.Latitude) * math.Pi / 180
+	dLon := (other.Longitude - g.Longitude) * math.Pi / 180
+
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLon/2)*math.Sin(dLon/2)
+
+	return 2 * earthRadiusKm * math.Atan2(math.Sqrt(a), math.Sqrt(1-a))
+}
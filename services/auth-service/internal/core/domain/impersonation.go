@@ -0,0 +1,56 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ImpersonationStatus string
+
+const (
+	ImpersonationStatusPending  ImpersonationStatus = "pending"
+	ImpersonationStatusApproved ImpersonationStatus = "approved"
+	ImpersonationStatusDenied   ImpersonationStatus = "denied"
+	ImpersonationStatusActive   ImpersonationStatus = "active"
+	ImpersonationStatusEnded    ImpersonationStatus = "ended"
+)
+
+// ImpersonationSession tracks a support user acting as a customer. The target
+// user must consent before a token is issued.
+type ImpersonationSession struct {
+	ID             uuid.UUID           `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	OrganizationID uuid.UUID           `json:"organization_id" gorm:"type:uuid;not null;index"`
+	ImpersonatorID uuid.UUID           `json:"impersonator_id" gorm:"type:uuid;not null;index"`
+	TargetUserID   uuid.UUID           `json:"target_user_id" gorm:"type:uuid;not null;index"`
+	Reason         string              `json:"reason" gorm:"type:text;not null"`
+	ReadOnly       bool                `json:"read_only" gorm:"not null;default:true"`
+	Status         ImpersonationStatus `json:"status" gorm:"type:varchar(20);not null;default:'pending'"`
+	ConsentExpires time.Time           `json:"consent_expires_at" gorm:"not null"`
+	RespondedAt    *time.Time          `json:"responded_at,omitempty"`
+	StartedAt      *time.Time          `json:"started_at,omitempty"`
+	ExpiresAt      *time.Time          `json:"expires_at,omitempty"`
+	EndedAt        *time.Time          `json:"ended_at,omitempty"`
+	CreatedAt      time.Time           `json:"created_at" gorm:"not null;default:CURRENT_TIMESTAMP"`
+	UpdatedAt      time.Time           `json:"updated_at" gorm:"not null;default:CURRENT_TIMESTAMP"`
+}
+
+func (ImpersonationSession) TableName() string {
+	return "impersonation_sessions"
+}
+
+type RequestImpersonationRequest struct {
+	TargetUserID string `json:"target_user_id" binding:"required,uuid"`
+	Reason       string `json:"reason" binding:"required"`
+	ReadOnly     *bool  `json:"read_only"`
+}
+
+type RespondImpersonationRequest struct {
+	Approve bool `json:"approve"`
+}
+
+type ImpersonationTokenResponse struct {
+	AccessToken string    `json:"access_token"`
+	ExpiresAt   time.Time `json:"expires_at"`
+	ReadOnly    bool      `json:"read_only"`
+}
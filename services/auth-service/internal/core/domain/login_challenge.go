@@ -0,0 +1,31 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type SuspiciousLoginReason string
+
+const (
+	SuspiciousLoginNewDevice        SuspiciousLoginReason = "new_device"
+	SuspiciousLoginImpossibleTravel SuspiciousLoginReason = "impossible_travel"
+)
+
+// LoginChallenge holds a password-verified login that still needs a second
+// factor before a session is issued.
+type LoginChallenge struct {
+	UserID    uuid.UUID               `json:"user_id"`
+	DeviceID  string                  `json:"device_id"`
+	IPAddress string                  `json:"ip_address"`
+	UserAgent string                  `json:"user_agent"`
+	Location  *GeoLocation            `json:"location,omitempty"`
+	Reasons   []SuspiciousLoginReason `json:"reasons"`
+	CreatedAt time.Time               `json:"created_at"`
+}
+
+type VerifyLoginChallengeRequest struct {
+	ChallengeToken string `json:"challenge_token" binding:"required"`
+	Code           string `json:"code" binding:"required"`
+}
@@ -0,0 +1,26 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MagicLink is a pending passwordless login. It is stored under the hash of
+// the emailed token until it is exchanged or expires.
+type MagicLink struct {
+	UserID    uuid.UUID `json:"user_id"`
+	IPAddress string    `json:"ip_address"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type RequestMagicLinkRequest struct {
+	Email     string `json:"email" binding:"required,email"`
+	IPAddress string `json:"-"`
+}
+
+type ExchangeMagicLinkRequest struct {
+	Token     string `json:"token" binding:"required"`
+	IPAddress string `json:"-"`
+	UserAgent string `json:"-"`
+}
@@ -0,0 +1,67 @@
+package domain
+
+import (
+	"time"
+)
+
+// OrganizationExportVersion is bumped whenever the archive layout changes in
+// a way older importers cannot read.
+const OrganizationExportVersion = 1
+
+// OrganizationExport is the portable archive used to promote a configured
+// organization between environments (e.g. sandbox to production). IDs from
+// the source environment only appear as refs and are remapped on import.
+type OrganizationExport struct {
+	Version      int                  `json:"version"`
+	ExportedAt   time.Time            `json:"exported_at"`
+	Organization ExportedOrganization `json:"organization"`
+	Roles        []ExportedRole       `json:"roles"`
+	Users        []ExportedUser       `json:"users"`
+}
+
+type ExportedOrganization struct {
+	Name     string                 `json:"name"`
+	Slug     string                 `json:"slug"`
+	Settings map[string]interface{} `json:"settings,omitempty"`
+}
+
+// ExportedRole is an organization-specific role. System roles are not
+// exported; users and parents reference them as "system:<name>".
+type ExportedRole struct {
+	Ref         string   `json:"ref"`
+	Name        string   `json:"name"`
+	Description string   `json:"description,omitempty"`
+	ParentRef   string   `json:"parent_ref,omitempty"`
+	Permissions []string `json:"permissions"`
+}
+
+// ExportedUser carries profile data only. Passwords and 2FA secrets never
+// leave the source environment.
+type ExportedUser struct {
+	Email     string     `json:"email"`
+	FirstName string     `json:"first_name,omitempty"`
+	LastName  string     `json:"last_name,omitempty"`
+	Phone     string     `json:"phone,omitempty"`
+	Status    UserStatus `json:"status"`
+	RoleRefs  []string   `json:"role_refs,omitempty"`
+}
+
+const SystemRoleRefPrefix = "system:"
+
+type ImportOrganizationRequest struct {
+	Archive OrganizationExport `json:"archive"`
+	DryRun  bool               `json:"dry_run"`
+}
+
+// ImportOrganizationResult reports what the import did, or would do on a
+// dry run. RoleMapping maps archive refs to role IDs in this environment.
+type ImportOrganizationResult struct {
+	DryRun          bool              `json:"dry_run"`
+	SettingsApplied int               `json:"settings_applied"`
+	RolesCreated    int               `json:"roles_created"`
+	RolesReused     int               `json:"roles_reused"`
+	UsersCreated    int               `json:"users_created"`
+	UsersReused     int               `json:"users_reused"`
+	UsersInvited    int               `json:"users_invited"`
+	RoleMapping     map[string]string `json:"role_mapping"`
+}
@@ -0,0 +1,71 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type MembershipStatus string
+
+const (
+	MembershipStatusActive  MembershipStatus = "active"
+	MembershipStatusRevoked MembershipStatus = "revoked"
+)
+
+// OrganizationMembership grants a user access to an organization other than
+// their home organization. Roles inside that organization are assigned
+// separately through user_roles rows carrying the organization ID.
+type OrganizationMembership struct {
+	ID             uuid.UUID        `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	UserID         uuid.UUID        `json:"user_id" gorm:"type:uuid;not null"`
+	OrganizationID uuid.UUID        `json:"organization_id" gorm:"type:uuid;not null;index"`
+	Status         MembershipStatus `json:"status" gorm:"type:varchar(20);not null;default:'active'"`
+	InvitedBy      *uuid.UUID       `json:"invited_by,omitempty" gorm:"type:uuid"`
+	RevokedAt      *time.Time       `json:"revoked_at,omitempty"`
+	CreatedAt      time.Time        `json:"created_at" gorm:"not null;default:CURRENT_TIMESTAMP"`
+	UpdatedAt      time.Time        `json:"updated_at" gorm:"not null;default:CURRENT_TIMESTAMP"`
+
+	User *User `json:"-" gorm:"foreignKey:UserID"`
+}
+
+func (OrganizationMembership) TableName() string {
+	return "organization_memberships"
+}
+
+func (m *OrganizationMembership) IsActive() bool {
+	return m.Status == MembershipStatusActive
+}
+
+type AddMemberRequest struct {
+	Email   string   `json:"email" binding:"required,email"`
+	RoleIDs []string `json:"role_ids" binding:"required,min=1"`
+}
+
+type MemberResponse struct {
+	UserID    uuid.UUID `json:"user_id"`
+	Email     string    `json:"email"`
+	FirstName string    `json:"first_name"`
+	LastName  string    `json:"last_name"`
+	Roles     []string  `json:"roles"`
+	JoinedAt  time.Time `json:"joined_at"`
+}
+
+// UserOrganization is one organization a user can switch into.
+type UserOrganization struct {
+	OrganizationID uuid.UUID `json:"organization_id"`
+	Name           string    `json:"name"`
+	Slug           string    `json:"slug"`
+	Home           bool      `json:"home"`
+}
+
+type SwitchOrganizationRequest struct {
+	OrganizationID string `json:"organization_id" binding:"required,uuid"`
+}
+
+type SwitchOrganizationResponse struct {
+	AccessToken    string    `json:"access_token"`
+	ExpiresIn      int       `json:"expires_in"`
+	OrganizationID uuid.UUID `json:"organization_id"`
+	Roles          []string  `json:"roles"`
+}
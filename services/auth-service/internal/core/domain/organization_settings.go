@@ -0,0 +1,169 @@
+package domain
+
+import (
+	"encoding/json"
+)
+
+const (
+	localeSettingsKey   = "locale"
+	authSettingsKey     = "auth"
+	calendarSettingsKey = "calendar"
+)
+
+var (
+	SupportedLanguages   = []string{"en", "es", "pt"}
+	SupportedDateFormats = []string{"YYYY-MM-DD", "DD/MM/YYYY", "MM/DD/YYYY"}
+)
+
+// LocaleSettings controls how values are formatted in KPIs, exports and
+// notifications. Empty fields inherit from the next level.
+type LocaleSettings struct {
+	Currency   string `json:"currency,omitempty"`
+	Language   string `json:"language,omitempty"`
+	DateFormat string `json:"date_format,omitempty"`
+	Timezone   string `json:"timezone,omitempty"`
+}
+
+// SystemLocale is the last fallback after user and organization settings.
+var SystemLocale = LocaleSettings{
+	Currency:   "USD",
+	Language:   "en",
+	DateFormat: "YYYY-MM-DD",
+	Timezone:   "UTC",
+}
+
+// ResolveLocale takes each field from the first level that sets it, ending
+// with SystemLocale. Pass levels from most to least specific (user, org).
+func ResolveLocale(levels ...*LocaleSettings) LocaleSettings {
+	resolved := LocaleSettings{}
+	for _, level := range append(levels, &SystemLocale) {
+		if level == nil {
+			continue
+		}
+		if resolved.Currency == "" {
+			resolved.Currency = level.Currency
+		}
+		if resolved.Language == "" {
+			resolved.Language = level.Language
+		}
+		if resolved.DateFormat == "" {
+			resolved.DateFormat = level.DateFormat
+		}
+		if resolved.Timezone == "" {
+			resolved.Timezone = level.Timezone
+		}
+	}
+	return resolved
+}
+
+// AuthSettings controls which sign-in methods the organization allows.
+// Password login is always available. CaptchaRequired turns on captcha checks
+// for the organization's users even where the environment does not require
+// them.
+type AuthSettings struct {
+	MagicLinkEnabled bool `json:"magic_link_enabled"`
+	CaptchaRequired  bool `json:"captcha_required"`
+}
+
+// CalendarSettings is the organization's working calendar. Lead times, ADU
+// windows, promise dates and past-due checks count working days with it.
+// Dates use YYYY-MM-DD and are read in the organization's timezone.
+type CalendarSettings struct {
+	// Workdays lists worked weekdays in lowercase English ("monday"). Empty
+	// means Monday to Friday.
+	Workdays  []string           `json:"workdays"`
+	Holidays  []CalendarHoliday  `json:"holidays"`
+	Shutdowns []CalendarShutdown `json:"shutdowns"`
+}
+
+type CalendarHoliday struct {
+	Date string `json:"date"`
+	Name string `json:"name,omitempty"`
+}
+
+// CalendarShutdown closes every day from Start to End inclusive, e.g. a plant
+// shutdown.
+type CalendarShutdown struct {
+	Start string `json:"start"`
+	End   string `json:"end"`
+	Name  string `json:"name,omitempty"`
+}
+
+// LocaleSettings reads the locale block from the organization's settings.
+func (o *Organization) LocaleSettings() *LocaleSettings {
+	var settings LocaleSettings
+	if !o.decodeSettings(localeSettingsKey, &settings) {
+		return &LocaleSettings{}
+	}
+	return &settings
+}
+
+// AuthSettings reads the auth block from the organization's settings.
+func (o *Organization) AuthSettings() *AuthSettings {
+	var settings AuthSettings
+	if !o.decodeSettings(authSettingsKey, &settings) {
+		return &AuthSettings{}
+	}
+	return &settings
+}
+
+func (o *Organization) SetAuthSettings(settings *AuthSettings) {
+	if o.Settings == nil {
+		o.Settings = make(map[string]interface{})
+	}
+	o.Settings[authSettingsKey] = map[string]interface{}{
+		"magic_link_enabled": settings.MagicLinkEnabled,
+		"captcha_required":   settings.CaptchaRequired,
+	}
+}
+
+// CalendarSettings reads the calendar block from the organization's settings.
+func (o *Organization) CalendarSettings() *CalendarSettings {
+	var settings CalendarSettings
+	if !o.decodeSettings(calendarSettingsKey, &settings) {
+		return &CalendarSettings{}
+	}
+	return &settings
+}
+
+func (o *Organization) SetCalendarSettings(settings *CalendarSettings) {
+	if o.Settings == nil {
+		o.Settings = make(map[string]interface{})
+	}
+	o.Settings[calendarSettingsKey] = *settings
+}
+
+// decodeSettings unmarshals the settings block under key into out. It
+// reports false when the block is missing or malformed.
+func (o *Organization) decodeSettings(key string, out interface{}) bool {
+	raw, ok := o.Settings[key]
+	if !ok {
+		return false
+	}
+
+	data, err := json.Marshal(raw)
+	if err != nil {
+		return false
+	}
+
+	return json.Unmarshal(data, out) == nil
+}
+
+func (o *Organization) SetLocaleSettings(settings *LocaleSettings) {
+	if o.Settings == nil {
+		o.Settings = make(map[string]interface{})
+	}
+	o.Settings[localeSettingsKey] = map[string]interface{}{
+		"currency":    settings.Currency,
+		"language":    settings.Language,
+		"date_format": settings.DateFormat,
+		"timezone":    settings.Timezone,
+	}
+}
+
+type OrganizationSettingsResponse struct {
+	OrganizationID string         `json:"organization_id"`
+	Locale         LocaleSettings `json:"locale"`
+	Effective      LocaleSettings `json:"effective"`
+	Auth           AuthSettings   `json:"auth"`
+}
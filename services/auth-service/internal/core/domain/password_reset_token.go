@@ -1,6 +1,9 @@
 package domain
 
 import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
 	"time"
 
 	"github.com/google/uuid"
@@ -18,3 +21,38 @@ type PasswordResetToken struct {
 func (PasswordResetToken) TableName() string {
 	return "password_reset_tokens"
 }
+
+// GeneratePasswordResetToken returns a new plaintext token and the record
+// that stores its hash. Imported users receive one as their invitation.
+func GeneratePasswordResetToken(userID uuid.UUID, ttl time.Duration) (string, *PasswordResetToken, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", nil, err
+	}
+
+	token := hex.EncodeToString(secret)
+	now := time.Now().UTC()
+	return token, &PasswordResetToken{
+		TokenHash: HashPasswordResetToken(token),
+		UserID:    userID,
+		ExpiresAt: now.Add(ttl),
+		CreatedAt: now,
+	}, nil
+}
+
+func HashPasswordResetToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+type ForgotPasswordRequest struct {
+	Email     string `json:"email" binding:"required,email"`
+	IPAddress string `json:"-"`
+}
+
+type ResetPasswordRequest struct {
+	Token       string `json:"token" binding:"required"`
+	NewPassword string `json:"new_password" binding:"required"`
+	IPAddress   string `json:"-"`
+	UserAgent   string `json:"-"`
+}
@@ -6,15 +6,48 @@ import (
 	"github.com/google/uuid"
 )
 
+// RefreshToken doubles as the session record: each login issues one, tagged
+// with the device and network location it was issued to.
 type RefreshToken struct {
 	ID        uuid.UUID `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
 	TokenHash string    `json:"-" gorm:"type:varchar(255);unique;not null"`
 	UserID    uuid.UUID `json:"user_id" gorm:"type:uuid;not null;index"`
 	ExpiresAt time.Time `json:"expires_at" gorm:"not null;index"`
 	Revoked   bool      `json:"revoked" gorm:"not null;default:false"`
+	DeviceID  string    `json:"-" gorm:"type:varchar(64);index"`
+	UserAgent string    `json:"user_agent,omitempty" gorm:"type:varchar(500)"`
+	IPAddress string    `json:"ip_address,omitempty" gorm:"type:varchar(45)"`
+	Country   string    `json:"country,omitempty" gorm:"type:varchar(2)"`
+	City      string    `json:"city,omitempty" gorm:"type:varchar(100)"`
+	Latitude  *float64  `json:"latitude,omitempty"`
+	Longitude *float64  `json:"longitude,omitempty"`
 	CreatedAt time.Time `json:"created_at" gorm:"not null;default:CURRENT_TIMESTAMP"`
 }
 
 func (RefreshToken) TableName() string {
 	return "refresh_tokens"
 }
+
+func (t *RefreshToken) Location() *GeoLocation {
+	if t.Latitude == nil || t.Longitude == nil {
+		return nil
+	}
+
+	return &GeoLocation{
+		Country:   t.Country,
+		City:      t.City,
+		Latitude:  *t.Latitude,
+		Longitude: *t.Longitude,
+	}
+}
+
+func (t *RefreshToken) SetLocation(location *GeoLocation) {
+	if location == nil {
+		return
+	}
+
+	t.Country = location.Country
+	t.City = location.City
+	t.Latitude = &location.Latitude
+	t.Longitude = &location.Longitude
+}
@@ -0,0 +1,26 @@
+package domain
+
+import (
+	"github.com/google/uuid"
+)
+
+// SetManagerRequest sets who a user reports to. An empty ManagerID clears it.
+type SetManagerRequest struct {
+	ManagerID string `json:"manager_id"`
+}
+
+// EscalationContact is one step of a user's escalation chain. Level 1 is the
+// first contact to escalate to, normally the direct manager.
+type EscalationContact struct {
+	Level     int       `json:"level"`
+	UserID    uuid.UUID `json:"user_id"`
+	Email     string    `json:"email"`
+	FirstName string    `json:"first_name"`
+	LastName  string    `json:"last_name"`
+	Phone     string    `json:"phone,omitempty"`
+}
+
+type EscalationChainResponse struct {
+	UserID uuid.UUID            `json:"user_id"`
+	Chain  []*EscalationContact `json:"chain"`
+}
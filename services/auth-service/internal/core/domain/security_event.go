@@ -0,0 +1,50 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type SecurityEventType string
+
+const (
+	SecurityEventNewDeviceLogin         SecurityEventType = "new_device_login"
+	SecurityEventPasswordChanged        SecurityEventType = "password_changed"
+	SecurityEventTwoFactorEnabled       SecurityEventType = "two_factor_enabled"
+	SecurityEventTwoFactorDisabled      SecurityEventType = "two_factor_disabled"
+	SecurityEventImpossibleTravel       SecurityEventType = "impossible_travel"
+	SecurityEventImpersonationRequested SecurityEventType = "impersonation_requested"
+)
+
+type SecurityEventPriority string
+
+const (
+	SecurityEventPriorityMedium   SecurityEventPriority = "medium"
+	SecurityEventPriorityHigh     SecurityEventPriority = "high"
+	SecurityEventPriorityCritical SecurityEventPriority = "critical"
+)
+
+// SecurityEvent is published for account activity the user should be told about
+// so it can surface in the notification center.
+type SecurityEvent struct {
+	Type           SecurityEventType
+	UserID         uuid.UUID
+	OrganizationID uuid.UUID
+	Email          string
+	IPAddress      string
+	UserAgent      string
+	Location       *GeoLocation
+	OccurredAt     time.Time
+}
+
+func (e *SecurityEvent) Priority() SecurityEventPriority {
+	switch e.Type {
+	case SecurityEventTwoFactorDisabled, SecurityEventImpossibleTravel:
+		return SecurityEventPriorityCritical
+	case SecurityEventNewDeviceLogin, SecurityEventPasswordChanged, SecurityEventTwoFactorEnabled, SecurityEventImpersonationRequested:
+		return SecurityEventPriorityHigh
+	default:
+		return SecurityEventPriorityMedium
+	}
+}
@@ -0,0 +1,27 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// UsagePeriodLayout formats billing periods as calendar months in UTC, e.g.
+// "2026-10".
+const UsagePeriodLayout = "2006-01"
+
+// UsagePeriod returns the billing period containing t.
+func UsagePeriod(t time.Time) string {
+	return t.UTC().Format(UsagePeriodLayout)
+}
+
+// UsageReport is the billable usage metered by auth-service for one
+// organization and period. ActiveUsers is counted when the report is built;
+// APICalls counts authenticated API key requests during the period.
+type UsageReport struct {
+	OrganizationID uuid.UUID `json:"organization_id"`
+	Period         string    `json:"period"`
+	ActiveUsers    int64     `json:"active_users"`
+	APICalls       int64     `json:"api_calls"`
+	GeneratedAt    time.Time `json:"generated_at"`
+}
@@ -7,19 +7,24 @@ import (
 )
 
 type User struct {
-	ID             uuid.UUID    `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
-	Email          string       `json:"email" gorm:"type:varchar(255);not null;index:idx_users_email_org"`
-	Password       string       `json:"-" gorm:"type:varchar(255);not null"`
-	FirstName      string       `json:"first_name" gorm:"type:varchar(100)"`
-	LastName       string       `json:"last_name" gorm:"type:varchar(100)"`
-	Phone          string       `json:"phone" gorm:"type:varchar(20)"`
-	Avatar         string       `json:"avatar,omitempty" gorm:"type:varchar(500)"`
-	Status         UserStatus   `json:"status" gorm:"type:varchar(20);not null;default:'inactive'"`
-	OrganizationID uuid.UUID    `json:"organization_id" gorm:"type:uuid;not null;index:idx_users_organization_id,idx_users_email_org"`
-	LastLoginAt    *time.Time   `json:"last_login_at,omitempty" gorm:"type:timestamp"`
-	CreatedAt      time.Time    `json:"created_at" gorm:"not null;default:CURRENT_TIMESTAMP"`
-	UpdatedAt      time.Time    `json:"updated_at" gorm:"not null;default:CURRENT_TIMESTAMP"`
-	Organization   Organization `json:"organization,omitempty" gorm:"foreignKey:OrganizationID"`
+	ID                     uuid.UUID    `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	Email                  string       `json:"email" gorm:"type:varchar(255);not null;index:idx_users_email_org"`
+	Password               string       `json:"-" gorm:"type:varchar(255);not null"`
+	FirstName              string       `json:"first_name" gorm:"type:varchar(100)"`
+	LastName               string       `json:"last_name" gorm:"type:varchar(100)"`
+	Phone                  string       `json:"phone" gorm:"type:varchar(20)"`
+	Avatar                 string       `json:"avatar,omitempty" gorm:"type:varchar(500)"`
+	Status                 UserStatus   `json:"status" gorm:"type:varchar(20);not null;default:'inactive'"`
+	TwoFactorEnabled       bool         `json:"two_factor_enabled" gorm:"not null;default:false"`
+	TwoFactorSecret        string       `json:"-" gorm:"type:varchar(64)"`
+	OrganizationID         uuid.UUID    `json:"organization_id" gorm:"type:uuid;not null;index:idx_users_organization_id,idx_users_email_org"`
+	ManagerID              *uuid.UUID   `json:"manager_id,omitempty" gorm:"type:uuid;index:idx_users_manager_id"`
+	LastLoginAt            *time.Time   `json:"last_login_at,omitempty" gorm:"type:timestamp"`
+	RecoveryEmail          string       `json:"-" gorm:"type:varchar(255)"`
+	RecoveryEmailExpiresAt *time.Time   `json:"-" gorm:"type:timestamp"`
+	CreatedAt              time.Time    `json:"created_at" gorm:"not null;default:CURRENT_TIMESTAMP"`
+	UpdatedAt              time.Time    `json:"updated_at" gorm:"not null;default:CURRENT_TIMESTAMP"`
+	Organization           Organization `json:"organization,omitempty" gorm:"foreignKey:OrganizationID"`
 }
 
 type UserStatus string
@@ -34,6 +39,15 @@ func (User) TableName() string {
 	return "users"
 }
 
+// ActiveRecoveryEmail returns the previous login email kept after an email
+// change, or "" once its recovery window has passed.
+func (u *User) ActiveRecoveryEmail(now time.Time) string {
+	if u.RecoveryEmail == "" || u.RecoveryEmailExpiresAt == nil || now.After(*u.RecoveryEmailExpiresAt) {
+		return ""
+	}
+	return u.RecoveryEmail
+}
+
 type UserResponse struct {
 	ID             uuid.UUID  `json:"id"`
 	Email          string     `json:"email"`
@@ -43,6 +57,7 @@ type UserResponse struct {
 	Avatar         string     `json:"avatar,omitempty"`
 	Status         UserStatus `json:"status"`
 	OrganizationID uuid.UUID  `json:"organization_id"`
+	ManagerID      *uuid.UUID `json:"manager_id,omitempty"`
 	LastLoginAt    *time.Time `json:"last_login_at,omitempty"`
 	CreatedAt      time.Time  `json:"created_at"`
 }
@@ -57,6 +72,7 @@ func (u *User) ToResponse() *UserResponse {
 		Avatar:         u.Avatar,
 		Status:         u.Status,
 		OrganizationID: u.OrganizationID,
+		ManagerID:      u.ManagerID,
 		LastLoginAt:    u.LastLoginAt,
 		CreatedAt:      u.CreatedAt,
 	}
@@ -72,15 +88,20 @@ type RegisterRequest struct {
 }
 
 type LoginRequest struct {
-	Email    string `json:"email" binding:"required,email"`
-	Password string `json:"password" binding:"required"`
+	Email     string `json:"email" binding:"required,email"`
+	Password  string `json:"password" binding:"required"`
+	IPAddress string `json:"-"`
+	UserAgent string `json:"-"`
 }
 
 type LoginResponse struct {
-	AccessToken  string        `json:"access_token"`
-	RefreshToken string        `json:"refresh_token"`
-	ExpiresIn    int           `json:"expires_in"`
-	User         *UserResponse `json:"user"`
+	AccessToken    string                  `json:"access_token,omitempty"`
+	RefreshToken   string                  `json:"refresh_token,omitempty"`
+	ExpiresIn      int                     `json:"expires_in,omitempty"`
+	User           *UserResponse           `json:"user,omitempty"`
+	StepUpRequired bool                    `json:"step_up_required,omitempty"`
+	ChallengeToken string                  `json:"challenge_token,omitempty"`
+	StepUpReasons  []SuspiciousLoginReason `json:"step_up_reasons,omitempty"`
 }
 
 type RefreshTokenRequest struct {
@@ -90,6 +111,31 @@ type RefreshTokenRequest struct {
 type ChangePasswordRequest struct {
 	CurrentPassword string `json:"current_password" binding:"required"`
 	NewPassword     string `json:"new_password" binding:"required,min=8"`
+	IPAddress       string `json:"-"`
+	UserAgent       string `json:"-"`
+}
+
+type DisableTwoFactorRequest struct {
+	Password  string `json:"password" binding:"required"`
+	IPAddress string `json:"-"`
+	UserAgent string `json:"-"`
+}
+
+type StartTwoFactorEnrollmentRequest struct {
+	Password string `json:"password" binding:"required"`
+}
+
+// TwoFactorEnrollmentResponse carries the pending secret; 2FA stays off until
+// a code generated from it is confirmed.
+type TwoFactorEnrollmentResponse struct {
+	Secret     string `json:"secret"`
+	OTPAuthURL string `json:"otpauth_url"`
+}
+
+type ConfirmTwoFactorEnrollmentRequest struct {
+	Code      string `json:"code" binding:"required"`
+	IPAddress string `json:"-"`
+	UserAgent string `json:"-"`
 }
 
 type UpdateProfileRequest struct {
@@ -13,6 +13,10 @@ type UserRole struct {
 	AssignedAt time.Time  `json:"assigned_at" gorm:"not null;default:CURRENT_TIMESTAMP;index:idx_user_roles_assigned_at"`
 	AssignedBy *uuid.UUID `json:"assigned_by,omitempty" gorm:"type:uuid;index:idx_user_roles_assigned_by"`
 
+	// OrganizationID is set for assignments made in a guest organization; nil
+	// assignments apply in the user's home organization.
+	OrganizationID *uuid.UUID `json:"organization_id,omitempty" gorm:"type:uuid"`
+
 	User     *User `json:"user,omitempty" gorm:"foreignKey:UserID"`
 	Role     *Role `json:"role,omitempty" gorm:"foreignKey:RoleID"`
 	Assigner *User `json:"assigner,omitempty" gorm:"foreignKey:AssignedBy"`
@@ -0,0 +1,17 @@
+package providers
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/domain"
+)
+
+type APIKeyRepository interface {
+	Create(ctx context.Context, key *domain.APIKey) error
+	GetByID(ctx context.Context, id uuid.UUID) (*domain.APIKey, error)
+	GetByPrefix(ctx context.Context, prefix string) (*domain.APIKey, error)
+	ListByOrganization(ctx context.Context, orgID uuid.UUID) ([]*domain.APIKey, error)
+	Update(ctx context.Context, key *domain.APIKey) error
+}
@@ -0,0 +1,9 @@
+package providers
+
+import "context"
+
+type CaptchaVerifier interface {
+	// Verify reports whether the client-side captcha response is valid. An
+	// error means the provider could not be reached.
+	Verify(ctx context.Context, token, remoteIP string) (bool, error)
+}
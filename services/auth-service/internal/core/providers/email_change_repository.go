@@ -0,0 +1,17 @@
+package providers
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/domain"
+)
+
+type EmailChangeRepository interface {
+	Create(ctx context.Context, request *domain.EmailChangeRequest) error
+	// GetByTokenHash matches either the old-address or the new-address token.
+	GetByTokenHash(ctx context.Context, tokenHash string) (*domain.EmailChangeRequest, error)
+	Update(ctx context.Context, request *domain.EmailChangeRequest) error
+	CancelPending(ctx context.Context, userID uuid.UUID) error
+}
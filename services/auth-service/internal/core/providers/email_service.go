@@ -6,4 +6,7 @@ type EmailService interface {
 	SendActivationEmail(ctx context.Context, to, token, userName string) error
 	SendPasswordResetEmail(ctx context.Context, to, token, userName string) error
 	SendWelcomeEmail(ctx context.Context, to, userName string) error
+	SendInvitationEmail(ctx context.Context, to, token, inviterName, organizationName string) error
+	SendMagicLinkEmail(ctx context.Context, to, token, userName string) error
+	SendEmailChangeConfirmationEmail(ctx context.Context, to, token, userName, newEmail string) error
 }
@@ -0,0 +1,12 @@
+package providers
+
+import (
+	"context"
+
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/domain"
+)
+
+type GeoIPResolver interface {
+	// Resolve returns nil without error when the address cannot be located.
+	Resolve(ctx context.Context, ipAddress string) (*domain.GeoLocation, error)
+}
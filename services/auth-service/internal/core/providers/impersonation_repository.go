@@ -0,0 +1,24 @@
+package providers
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/domain"
+)
+
+type ImpersonationRepository interface {
+	Create(ctx context.Context, session *domain.ImpersonationSession) error
+	GetByID(ctx context.Context, id uuid.UUID) (*domain.ImpersonationSession, error)
+	Update(ctx context.Context, session *domain.ImpersonationSession) error
+	// StartApproved moves session to active with its StartedAt and ExpiresAt
+	// only while the stored row is still approved and its consent unexpired.
+	// It reports false when a concurrent start consumed the approval first.
+	StartApproved(ctx context.Context, session *domain.ImpersonationSession) (bool, error)
+}
+
+type AuditLogRepository interface {
+	Create(ctx context.Context, entry *domain.AuditLog) error
+	ListByOrganization(ctx context.Context, orgID uuid.UUID, filter domain.AuditLogFilter) ([]*domain.AuditLog, error)
+}
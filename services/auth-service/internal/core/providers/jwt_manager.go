@@ -12,12 +12,28 @@ type Claims struct {
 	Email          string   `json:"email"`
 	OrganizationID string   `json:"organization_id"`
 	Roles          []string `json:"roles,omitempty"`
+	// Permissions granted in OrganizationID when the token was issued, so
+	// services can authorize without calling CheckPermission
+	Permissions []string `json:"permissions,omitempty"`
+
+	// Set only on impersonation tokens
+	Impersonator    string `json:"impersonator,omitempty"`
+	ImpersonationID string `json:"impersonation_id,omitempty"`
+	ReadOnly        bool   `json:"read_only,omitempty"`
 	jwt.RegisteredClaims
 }
 
+type ImpersonationClaims struct {
+	ImpersonatorID  uuid.UUID
+	ImpersonationID uuid.UUID
+	ReadOnly        bool
+	Expiry          time.Duration
+}
+
 type JWTManager interface {
-	GenerateAccessToken(userID, orgID uuid.UUID, email string, roles []string) (string, error)
+	GenerateAccessToken(userID, orgID uuid.UUID, email string, roles, permissions []string) (string, error)
 	GenerateRefreshToken(userID uuid.UUID) (string, error)
+	GenerateImpersonationToken(userID, orgID uuid.UUID, email string, roles []string, impersonation *ImpersonationClaims) (string, error)
 	ValidateAccessToken(tokenString string) (*Claims, error)
 	ValidateRefreshToken(tokenString string) (*jwt.RegisteredClaims, error)
 	GetAccessExpiry() time.Duration
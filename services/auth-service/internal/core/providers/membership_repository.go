@@ -0,0 +1,17 @@
+package providers
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/domain"
+)
+
+type MembershipRepository interface {
+	Create(ctx context.Context, membership *domain.OrganizationMembership) error
+	Get(ctx context.Context, userID, orgID uuid.UUID) (*domain.OrganizationMembership, error)
+	Update(ctx context.Context, membership *domain.OrganizationMembership) error
+	ListByOrganization(ctx context.Context, orgID uuid.UUID) ([]*domain.OrganizationMembership, error)
+	ListByUser(ctx context.Context, userID uuid.UUID) ([]*domain.OrganizationMembership, error)
+}
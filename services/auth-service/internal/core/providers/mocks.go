@@ -69,6 +69,19 @@ func (m *MockUserRepository) List(ctx context.Context, offset, limit int) ([]*do
 	return args.Get(0).([]*domain.User), args.Error(1)
 }
 
+func (m *MockUserRepository) ListByOrganization(ctx context.Context, orgID uuid.UUID, offset, limit int) ([]*domain.User, error) {
+	args := m.Called(ctx, orgID, offset, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.User), args.Error(1)
+}
+
+func (m *MockUserRepository) CountActiveByOrganization(ctx context.Context, orgID uuid.UUID) (int64, error) {
+	args := m.Called(ctx, orgID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
 // MockRoleRepository is a mock implementation of RoleRepository
 type MockRoleRepository struct {
 	mock.Mock
@@ -129,6 +142,24 @@ func (m *MockRoleRepository) GetUserRoles(ctx context.Context, userID uuid.UUID)
 	return args.Get(0).([]*domain.Role), args.Error(1)
 }
 
+func (m *MockRoleRepository) GetUserRolesInOrganization(ctx context.Context, userID, orgID uuid.UUID) ([]*domain.Role, error) {
+	args := m.Called(ctx, userID, orgID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Role), args.Error(1)
+}
+
+func (m *MockRoleRepository) AssignRoleToUserInOrganization(ctx context.Context, userID, roleID, orgID, assignedBy uuid.UUID) error {
+	args := m.Called(ctx, userID, roleID, orgID, assignedBy)
+	return args.Error(0)
+}
+
+func (m *MockRoleRepository) RemoveUserRolesInOrganization(ctx context.Context, userID, orgID uuid.UUID) error {
+	args := m.Called(ctx, userID, orgID)
+	return args.Error(0)
+}
+
 func (m *MockRoleRepository) AssignRoleToUser(ctx context.Context, userID, roleID, assignedBy uuid.UUID) error {
 	args := m.Called(ctx, userID, roleID, assignedBy)
 	return args.Error(0)
@@ -281,6 +312,50 @@ func (m *MockTokenRepository) RevokeAllUserTokens(ctx context.Context, userID uu
 	return args.Error(0)
 }
 
+func (m *MockTokenRepository) HasDeviceLogin(ctx context.Context, userID uuid.UUID, deviceID string) (bool, error) {
+	args := m.Called(ctx, userID, deviceID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockTokenRepository) GetLatestRefreshToken(ctx context.Context, userID uuid.UUID) (*domain.RefreshToken, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.RefreshToken), args.Error(1)
+}
+
+func (m *MockTokenRepository) StoreLoginChallenge(ctx context.Context, tokenHash string, challenge *domain.LoginChallenge, ttl time.Duration) error {
+	args := m.Called(ctx, tokenHash, challenge, ttl)
+	return args.Error(0)
+}
+
+func (m *MockTokenRepository) GetLoginChallenge(ctx context.Context, tokenHash string) (*domain.LoginChallenge, error) {
+	args := m.Called(ctx, tokenHash)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.LoginChallenge), args.Error(1)
+}
+
+func (m *MockTokenRepository) RecordLoginChallengeAttempt(ctx context.Context, tokenHash string, ttl time.Duration) (int64, error) {
+	args := m.Called(ctx, tokenHash, ttl)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockTokenRepository) ConsumeLoginChallenge(ctx context.Context, tokenHash string) (*domain.LoginChallenge, error) {
+	args := m.Called(ctx, tokenHash)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.LoginChallenge), args.Error(1)
+}
+
+func (m *MockTokenRepository) DeleteLoginChallenge(ctx context.Context, tokenHash string) error {
+	args := m.Called(ctx, tokenHash)
+	return args.Error(0)
+}
+
 func (m *MockTokenRepository) StorePasswordResetToken(ctx context.Context, token *domain.PasswordResetToken) error {
 	args := m.Called(ctx, token)
 	return args.Error(0)
@@ -317,6 +392,19 @@ func (m *MockTokenRepository) MarkActivationTokenUsed(ctx context.Context, token
 	return args.Error(0)
 }
 
+func (m *MockTokenRepository) StoreMagicLink(ctx context.Context, tokenHash string, link *domain.MagicLink, ttl time.Duration) error {
+	args := m.Called(ctx, tokenHash, link, ttl)
+	return args.Error(0)
+}
+
+func (m *MockTokenRepository) ConsumeMagicLink(ctx context.Context, tokenHash string) (*domain.MagicLink, error) {
+	args := m.Called(ctx, tokenHash)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.MagicLink), args.Error(1)
+}
+
 func (m *MockTokenRepository) BlacklistToken(ctx context.Context, token string, ttl time.Duration) error {
 	args := m.Called(ctx, token, ttl)
 	return args.Error(0)
@@ -327,6 +415,19 @@ func (m *MockTokenRepository) IsTokenBlacklisted(ctx context.Context, token stri
 	return args.Bool(0), args.Error(1)
 }
 
+func (m *MockTokenRepository) RevokeUserSessions(ctx context.Context, userID uuid.UUID, revokedAt time.Time, ttl time.Duration) error {
+	args := m.Called(ctx, userID, revokedAt, ttl)
+	return args.Error(0)
+}
+
+func (m *MockTokenRepository) GetUserSessionsRevokedAt(ctx context.Context, userID uuid.UUID) (*time.Time, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*time.Time), args.Error(1)
+}
+
 // MockOrganizationRepository is a mock implementation of OrganizationRepository
 type MockOrganizationRepository struct {
 	mock.Mock
@@ -396,8 +497,8 @@ type MockJWTManager struct {
 	mock.Mock
 }
 
-func (m *MockJWTManager) GenerateAccessToken(userID, orgID uuid.UUID, email string, roles []string) (string, error) {
-	args := m.Called(userID, orgID, email, roles)
+func (m *MockJWTManager) GenerateAccessToken(userID, orgID uuid.UUID, email string, roles, permissions []string) (string, error) {
+	args := m.Called(userID, orgID, email, roles, permissions)
 	return args.String(0), args.Error(1)
 }
 
@@ -406,6 +507,11 @@ func (m *MockJWTManager) GenerateRefreshToken(userID uuid.UUID) (string, error)
 	return args.String(0), args.Error(1)
 }
 
+func (m *MockJWTManager) GenerateImpersonationToken(userID, orgID uuid.UUID, email string, roles []string, impersonation *ImpersonationClaims) (string, error) {
+	args := m.Called(userID, orgID, email, roles, impersonation)
+	return args.String(0), args.Error(1)
+}
+
 func (m *MockJWTManager) ValidateAccessToken(tokenString string) (*Claims, error) {
 	args := m.Called(tokenString)
 	if args.Get(0) == nil {
@@ -431,3 +537,281 @@ func (m *MockJWTManager) GetRefreshExpiry() time.Duration {
 	args := m.Called()
 	return args.Get(0).(time.Duration)
 }
+
+// MockSecurityEventPublisher is a mock implementation of SecurityEventPublisher
+type MockSecurityEventPublisher struct {
+	mock.Mock
+}
+
+func (m *MockSecurityEventPublisher) PublishSecurityEvent(ctx context.Context, event *domain.SecurityEvent) error {
+	args := m.Called(ctx, event)
+	return args.Error(0)
+}
+
+// MockGeoIPResolver is a mock implementation of GeoIPResolver
+type MockGeoIPResolver struct {
+	mock.Mock
+}
+
+func (m *MockGeoIPResolver) Resolve(ctx context.Context, ipAddress string) (*domain.GeoLocation, error) {
+	args := m.Called(ctx, ipAddress)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.GeoLocation), args.Error(1)
+}
+
+// MockTOTPValidator is a mock implementation of TOTPValidator
+type MockTOTPValidator struct {
+	mock.Mock
+}
+
+func (m *MockTOTPValidator) ValidateCode(secret, code string) bool {
+	args := m.Called(secret, code)
+	return args.Bool(0)
+}
+
+// MockTOTPEnroller is a mock implementation of TOTPEnroller
+type MockTOTPEnroller struct {
+	mock.Mock
+}
+
+func (m *MockTOTPEnroller) GenerateEnrollment(accountName string) (string, string, error) {
+	args := m.Called(accountName)
+	return args.String(0), args.String(1), args.Error(2)
+}
+
+// MockImpersonationRepository is a mock implementation of ImpersonationRepository
+type MockImpersonationRepository struct {
+	mock.Mock
+}
+
+func (m *MockImpersonationRepository) Create(ctx context.Context, session *domain.ImpersonationSession) error {
+	args := m.Called(ctx, session)
+	return args.Error(0)
+}
+
+func (m *MockImpersonationRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.ImpersonationSession, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.ImpersonationSession), args.Error(1)
+}
+
+func (m *MockImpersonationRepository) Update(ctx context.Context, session *domain.ImpersonationSession) error {
+	args := m.Called(ctx, session)
+	return args.Error(0)
+}
+
+func (m *MockImpersonationRepository) StartApproved(ctx context.Context, session *domain.ImpersonationSession) (bool, error) {
+	args := m.Called(ctx, session)
+	return args.Bool(0), args.Error(1)
+}
+
+// MockAuditLogRepository is a mock implementation of AuditLogRepository
+type MockAuditLogRepository struct {
+	mock.Mock
+}
+
+func (m *MockAuditLogRepository) Create(ctx context.Context, entry *domain.AuditLog) error {
+	args := m.Called(ctx, entry)
+	return args.Error(0)
+}
+
+func (m *MockAuditLogRepository) ListByOrganization(ctx context.Context, orgID uuid.UUID, filter domain.AuditLogFilter) ([]*domain.AuditLog, error) {
+	args := m.Called(ctx, orgID, filter)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.AuditLog), args.Error(1)
+}
+
+// MockAPIKeyRepository is a mock implementation of APIKeyRepository
+type MockAPIKeyRepository struct {
+	mock.Mock
+}
+
+func (m *MockAPIKeyRepository) Create(ctx context.Context, key *domain.APIKey) error {
+	args := m.Called(ctx, key)
+	return args.Error(0)
+}
+
+func (m *MockAPIKeyRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.APIKey, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.APIKey), args.Error(1)
+}
+
+func (m *MockAPIKeyRepository) GetByPrefix(ctx context.Context, prefix string) (*domain.APIKey, error) {
+	args := m.Called(ctx, prefix)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.APIKey), args.Error(1)
+}
+
+func (m *MockAPIKeyRepository) ListByOrganization(ctx context.Context, orgID uuid.UUID) ([]*domain.APIKey, error) {
+	args := m.Called(ctx, orgID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.APIKey), args.Error(1)
+}
+
+func (m *MockAPIKeyRepository) Update(ctx context.Context, key *domain.APIKey) error {
+	args := m.Called(ctx, key)
+	return args.Error(0)
+}
+
+// MockMembershipRepository is a mock implementation of MembershipRepository
+type MockMembershipRepository struct {
+	mock.Mock
+}
+
+func (m *MockMembershipRepository) Create(ctx context.Context, membership *domain.OrganizationMembership) error {
+	args := m.Called(ctx, membership)
+	return args.Error(0)
+}
+
+func (m *MockMembershipRepository) Get(ctx context.Context, userID, orgID uuid.UUID) (*domain.OrganizationMembership, error) {
+	args := m.Called(ctx, userID, orgID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.OrganizationMembership), args.Error(1)
+}
+
+func (m *MockMembershipRepository) Update(ctx context.Context, membership *domain.OrganizationMembership) error {
+	args := m.Called(ctx, membership)
+	return args.Error(0)
+}
+
+func (m *MockMembershipRepository) ListByOrganization(ctx context.Context, orgID uuid.UUID) ([]*domain.OrganizationMembership, error) {
+	args := m.Called(ctx, orgID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.OrganizationMembership), args.Error(1)
+}
+
+func (m *MockMembershipRepository) ListByUser(ctx context.Context, userID uuid.UUID) ([]*domain.OrganizationMembership, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.OrganizationMembership), args.Error(1)
+}
+
+// MockEmailService is a mock implementation of EmailService
+type MockEmailService struct {
+	mock.Mock
+}
+
+func (m *MockEmailService) SendActivationEmail(ctx context.Context, to, token, userName string) error {
+	args := m.Called(ctx, to, token, userName)
+	return args.Error(0)
+}
+
+func (m *MockEmailService) SendPasswordResetEmail(ctx context.Context, to, token, userName string) error {
+	args := m.Called(ctx, to, token, userName)
+	return args.Error(0)
+}
+
+func (m *MockEmailService) SendWelcomeEmail(ctx context.Context, to, userName string) error {
+	args := m.Called(ctx, to, userName)
+	return args.Error(0)
+}
+
+func (m *MockEmailService) SendInvitationEmail(ctx context.Context, to, token, inviterName, organizationName string) error {
+	args := m.Called(ctx, to, token, inviterName, organizationName)
+	return args.Error(0)
+}
+
+func (m *MockEmailService) SendMagicLinkEmail(ctx context.Context, to, token, userName string) error {
+	args := m.Called(ctx, to, token, userName)
+	return args.Error(0)
+}
+
+func (m *MockEmailService) SendEmailChangeConfirmationEmail(ctx context.Context, to, token, userName, newEmail string) error {
+	args := m.Called(ctx, to, token, userName, newEmail)
+	return args.Error(0)
+}
+
+// MockEmailChangeRepository is a mock implementation of EmailChangeRepository
+type MockEmailChangeRepository struct {
+	mock.Mock
+}
+
+func (m *MockEmailChangeRepository) Create(ctx context.Context, request *domain.EmailChangeRequest) error {
+	args := m.Called(ctx, request)
+	return args.Error(0)
+}
+
+func (m *MockEmailChangeRepository) GetByTokenHash(ctx context.Context, tokenHash string) (*domain.EmailChangeRequest, error) {
+	args := m.Called(ctx, tokenHash)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.EmailChangeRequest), args.Error(1)
+}
+
+func (m *MockEmailChangeRepository) Update(ctx context.Context, request *domain.EmailChangeRequest) error {
+	args := m.Called(ctx, request)
+	return args.Error(0)
+}
+
+func (m *MockEmailChangeRepository) CancelPending(ctx context.Context, userID uuid.UUID) error {
+	args := m.Called(ctx, userID)
+	return args.Error(0)
+}
+
+// MockUserEventPublisher is a mock implementation of UserEventPublisher
+type MockUserEventPublisher struct {
+	mock.Mock
+}
+
+func (m *MockUserEventPublisher) PublishEmailChanged(ctx context.Context, event *domain.EmailChangedEvent) error {
+	args := m.Called(ctx, event)
+	return args.Error(0)
+}
+
+// MockPermissionResolver is a mock implementation of PermissionResolver
+type MockPermissionResolver struct {
+	mock.Mock
+}
+
+func (m *MockPermissionResolver) ResolvePermissions(ctx context.Context, userID, orgID uuid.UUID) ([]string, error) {
+	args := m.Called(ctx, userID, orgID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]string), args.Error(1)
+}
+
+// MockUsageRepository is a mock implementation of UsageRepository
+type MockUsageRepository struct {
+	mock.Mock
+}
+
+func (m *MockUsageRepository) IncrementAPICalls(ctx context.Context, orgID uuid.UUID, period string) error {
+	args := m.Called(ctx, orgID, period)
+	return args.Error(0)
+}
+
+func (m *MockUsageRepository) GetAPICalls(ctx context.Context, orgID uuid.UUID, period string) (int64, error) {
+	args := m.Called(ctx, orgID, period)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+// MockUsageEventPublisher is a mock implementation of UsageEventPublisher
+type MockUsageEventPublisher struct {
+	mock.Mock
+}
+
+func (m *MockUsageEventPublisher) PublishUsageMetered(ctx context.Context, report *domain.UsageReport) error {
+	args := m.Called(ctx, report)
+	return args.Error(0)
+}
@@ -3,11 +3,27 @@ package providers
 import (
 	"context"
 	"time"
+
+	"github.com/google/uuid"
 )
 
+// OrganizationScopedCacheKey is the cache key for a user's permissions in a
+// specific organization. InvalidateUserPermissions and InvalidateUsersWithRole
+// also drop these entries.
+func OrganizationScopedCacheKey(userID, orgID string) string {
+	return userID + "@" + orgID
+}
+
 type PermissionCache interface {
 	GetUserPermissions(ctx context.Context, userID string) ([]string, error)
 	SetUserPermissions(ctx context.Context, userID string, permissions []string, ttl time.Duration) error
 	InvalidateUserPermissions(ctx context.Context, userID string) error
 	InvalidateUsersWithRole(ctx context.Context, userIDs []string) error
 }
+
+// PermissionResolver returns the effective permissions a user holds in an
+// organization, including inherited ones. Token issuance uses it to embed
+// permissions in access tokens.
+type PermissionResolver interface {
+	ResolvePermissions(ctx context.Context, userID, orgID uuid.UUID) ([]string, error)
+}
@@ -20,4 +20,10 @@ type RoleRepository interface {
 	AssignRoleToUser(ctx context.Context, userID, roleID, assignedBy uuid.UUID) error
 	RemoveRoleFromUser(ctx context.Context, userID, roleID uuid.UUID) error
 	GetUsersWithRole(ctx context.Context, roleID uuid.UUID) ([]uuid.UUID, error)
+
+	// Organization-scoped assignments for guest memberships. GetUserRoles only
+	// returns home-organization assignments.
+	GetUserRolesInOrganization(ctx context.Context, userID, orgID uuid.UUID) ([]*domain.Role, error)
+	AssignRoleToUserInOrganization(ctx context.Context, userID, roleID, orgID, assignedBy uuid.UUID) error
+	RemoveUserRolesInOrganization(ctx context.Context, userID, orgID uuid.UUID) error
 }
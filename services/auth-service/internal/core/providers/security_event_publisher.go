@@ -0,0 +1,11 @@
+package providers
+
+import (
+	"context"
+
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/domain"
+)
+
+type SecurityEventPublisher interface {
+	PublishSecurityEvent(ctx context.Context, event *domain.SecurityEvent) error
+}
@@ -15,6 +15,8 @@ type TokenRepository interface {
 	GetRefreshToken(ctx context.Context, tokenHash string) (*domain.RefreshToken, error)
 	RevokeRefreshToken(ctx context.Context, tokenHash string) error
 	RevokeAllUserTokens(ctx context.Context, userID uuid.UUID) error
+	HasDeviceLogin(ctx context.Context, userID uuid.UUID, deviceID string) (bool, error)
+	GetLatestRefreshToken(ctx context.Context, userID uuid.UUID) (*domain.RefreshToken, error)
 
 	// Password Reset Token Operations
 	StorePasswordResetToken(ctx context.Context, token *domain.PasswordResetToken) error
@@ -26,7 +28,26 @@ type TokenRepository interface {
 	GetActivationToken(ctx context.Context, tokenHash string) (*domain.ActivationToken, error)
 	MarkActivationTokenUsed(ctx context.Context, tokenHash string) error
 
+	// Login Challenge Operations (step-up verification). RecordAttempt returns
+	// the number of codes tried against the challenge, Consume deletes it so
+	// it can only be exchanged once and Delete drops it with its attempts.
+	StoreLoginChallenge(ctx context.Context, tokenHash string, challenge *domain.LoginChallenge, ttl time.Duration) error
+	GetLoginChallenge(ctx context.Context, tokenHash string) (*domain.LoginChallenge, error)
+	RecordLoginChallengeAttempt(ctx context.Context, tokenHash string, ttl time.Duration) (int64, error)
+	ConsumeLoginChallenge(ctx context.Context, tokenHash string) (*domain.LoginChallenge, error)
+	DeleteLoginChallenge(ctx context.Context, tokenHash string) error
+
+	// Magic Link Operations (passwordless login). Consume deletes the link so
+	// it can only be exchanged once.
+	StoreMagicLink(ctx context.Context, tokenHash string, link *domain.MagicLink, ttl time.Duration) error
+	ConsumeMagicLink(ctx context.Context, tokenHash string) (*domain.MagicLink, error)
+
 	// Blacklist Operations (for access tokens)
 	BlacklistToken(ctx context.Context, token string, ttl time.Duration) error
 	IsTokenBlacklisted(ctx context.Context, token string) (bool, error)
+
+	// Session Revocation Operations. Access tokens of the user issued before
+	// revokedAt are rejected until the cutoff expires after ttl.
+	RevokeUserSessions(ctx context.Context, userID uuid.UUID, revokedAt time.Time, ttl time.Duration) error
+	GetUserSessionsRevokedAt(ctx context.Context, userID uuid.UUID) (*time.Time, error)
 }
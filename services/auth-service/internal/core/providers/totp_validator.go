@@ -0,0 +1,11 @@
+package providers
+
+type TOTPValidator interface {
+	ValidateCode(secret, code string) bool
+}
+
+// TOTPEnroller creates the secret for a new authenticator and the otpauth://
+// URL apps scan to add it.
+type TOTPEnroller interface {
+	GenerateEnrollment(accountName string) (secret, otpauthURL string, err error)
+}
@@ -0,0 +1,13 @@
+package providers
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// UsageRepository keeps per-organization usage counters by billing period.
+type UsageRepository interface {
+	IncrementAPICalls(ctx context.Context, orgID uuid.UUID, period string) error
+	GetAPICalls(ctx context.Context, orgID uuid.UUID, period string) (int64, error)
+}
@@ -0,0 +1,11 @@
+package providers
+
+import (
+	"context"
+
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/domain"
+)
+
+type UserEventPublisher interface {
+	PublishEmailChanged(ctx context.Context, event *domain.EmailChangedEvent) error
+}
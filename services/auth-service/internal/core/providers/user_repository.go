@@ -17,4 +17,6 @@ type UserRepository interface {
 	Delete(ctx context.Context, id uuid.UUID) error
 	UpdateLastLogin(ctx context.Context, userID uuid.UUID) error
 	List(ctx context.Context, offset, limit int) ([]*domain.User, error)
+	ListByOrganization(ctx context.Context, orgID uuid.UUID, offset, limit int) ([]*domain.User, error)
+	CountActiveByOrganization(ctx context.Context, orgID uuid.UUID) (int64, error)
 }
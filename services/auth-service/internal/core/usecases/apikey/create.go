@@ -0,0 +1,108 @@
+package apikey
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	pkgErrors "github.com/giia/giia-core-engine/pkg/errors"
+	pkgLogger "github.com/giia/giia-core-engine/pkg/logger"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/domain"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/providers"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/usecases/audit"
+)
+
+type CreateAPIKeyUseCase struct {
+	apiKeyRepo providers.APIKeyRepository
+	auditRepo  providers.AuditLogRepository
+	logger     pkgLogger.Logger
+}
+
+func NewCreateAPIKeyUseCase(
+	apiKeyRepo providers.APIKeyRepository,
+	auditRepo providers.AuditLogRepository,
+	logger pkgLogger.Logger,
+) *CreateAPIKeyUseCase {
+	return &CreateAPIKeyUseCase{
+		apiKeyRepo: apiKeyRepo,
+		auditRepo:  auditRepo,
+		logger:     logger,
+	}
+}
+
+func (uc *CreateAPIKeyUseCase) Execute(ctx context.Context, orgID, userID uuid.UUID, req *domain.CreateAPIKeyRequest) (*domain.CreateAPIKeyResponse, error) {
+	if orgID == uuid.Nil {
+		return nil, pkgErrors.NewBadRequest("organization ID cannot be empty")
+	}
+
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return nil, pkgErrors.NewBadRequest("name is required")
+	}
+
+	if len(req.Scopes) == 0 {
+		return nil, pkgErrors.NewBadRequest("at least one scope is required")
+	}
+	for _, scope := range req.Scopes {
+		if !domain.IsValidAPIKeyScope(scope) {
+			return nil, pkgErrors.NewBadRequest("invalid scope: " + scope + " (API keys are limited to read, list and export actions)")
+		}
+	}
+
+	rateLimit := req.RateLimitPerMinute
+	if rateLimit == 0 {
+		rateLimit = domain.DefaultAPIKeyRateLimit
+	}
+	if rateLimit < 0 || rateLimit > domain.MaxAPIKeyRateLimit {
+		return nil, pkgErrors.NewBadRequest("rate limit must be between 1 and 600 requests per minute")
+	}
+
+	if req.ExpiresInDays < 0 {
+		return nil, pkgErrors.NewBadRequest("expires_in_days cannot be negative")
+	}
+
+	plaintext, prefix, hash, err := domain.GenerateAPIKey()
+	if err != nil {
+		uc.logger.Error(ctx, err, "Failed to generate API key", pkgLogger.Tags{
+			"organization_id": orgID.String(),
+		})
+		return nil, pkgErrors.NewInternalServerError("failed to generate API key")
+	}
+
+	key := &domain.APIKey{
+		ID:                 uuid.New(),
+		OrganizationID:     orgID,
+		Name:               name,
+		Prefix:             prefix,
+		KeyHash:            hash,
+		Scopes:             req.Scopes,
+		RateLimitPerMinute: rateLimit,
+		CreatedBy:          userID,
+	}
+	if req.ExpiresInDays > 0 {
+		expiresAt := time.Now().UTC().AddDate(0, 0, req.ExpiresInDays)
+		key.ExpiresAt = &expiresAt
+	}
+
+	if err := uc.apiKeyRepo.Create(ctx, key); err != nil {
+		uc.logger.Error(ctx, err, "Failed to create API key", pkgLogger.Tags{
+			"organization_id": orgID.String(),
+		})
+		return nil, pkgErrors.NewInternalServerError("failed to create API key")
+	}
+
+	audit.Record(ctx, uc.auditRepo, uc.logger, key.OrganizationID, userID, domain.AuditActionAPIKeyCreated, "api_key:"+key.ID.String(), key.Name)
+
+	uc.logger.Info(ctx, "API key created", pkgLogger.Tags{
+		"api_key_id":      key.ID.String(),
+		"organization_id": orgID.String(),
+		"scopes":          key.Scopes,
+	})
+
+	return &domain.CreateAPIKeyResponse{
+		APIKey: key,
+		Key:    plaintext,
+	}, nil
+}
@@ -0,0 +1,95 @@
+package apikey
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/domain"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/providers"
+)
+
+func TestCreateAPIKeyUseCase_Execute_WithReadScopes_StoresHashAndReturnsPlaintextOnce(t *testing.T) {
+	// Given
+	givenOrgID := uuid.New()
+	givenUserID := uuid.New()
+
+	mockAPIKeyRepo := new(providers.MockAPIKeyRepository)
+	mockAuditRepo := new(providers.MockAuditLogRepository)
+	mockLogger := new(providers.MockLogger)
+
+	useCase := NewCreateAPIKeyUseCase(mockAPIKeyRepo, mockAuditRepo, mockLogger)
+
+	var storedKey *domain.APIKey
+	mockAPIKeyRepo.On("Create", mock.Anything, mock.MatchedBy(func(k *domain.APIKey) bool {
+		storedKey = k
+		return k.OrganizationID == givenOrgID && k.RateLimitPerMinute == domain.DefaultAPIKeyRateLimit
+	})).Return(nil)
+	mockAuditRepo.On("Create", mock.Anything, mock.MatchedBy(func(l *domain.AuditLog) bool {
+		return l.Action == domain.AuditActionAPIKeyCreated && l.ActorID == givenUserID
+	})).Return(nil)
+	mockLogger.On("Info", mock.Anything, "API key created", mock.Anything).Return()
+
+	// When
+	response, err := useCase.Execute(context.Background(), givenOrgID, givenUserID, &domain.CreateAPIKeyRequest{
+		Name:          "PowerBI",
+		Scopes:        []string{"analytics:kpis:read", "analytics:kpis:export"},
+		ExpiresInDays: 90,
+	})
+
+	// Then
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(response.Key, "giia_"+storedKey.Prefix+"_"))
+	assert.Equal(t, domain.HashAPIKey(response.Key), storedKey.KeyHash)
+	assert.NotContains(t, storedKey.KeyHash, response.Key)
+	assert.NotNil(t, storedKey.ExpiresAt)
+	mockAPIKeyRepo.AssertExpectations(t)
+	mockAuditRepo.AssertExpectations(t)
+}
+
+func TestCreateAPIKeyUseCase_Execute_WithWriteScope_ReturnsBadRequest(t *testing.T) {
+	// Given
+	mockAPIKeyRepo := new(providers.MockAPIKeyRepository)
+	mockAuditRepo := new(providers.MockAuditLogRepository)
+	mockLogger := new(providers.MockLogger)
+
+	useCase := NewCreateAPIKeyUseCase(mockAPIKeyRepo, mockAuditRepo, mockLogger)
+
+	// When
+	response, err := useCase.Execute(context.Background(), uuid.New(), uuid.New(), &domain.CreateAPIKeyRequest{
+		Name:   "PowerBI",
+		Scopes: []string{"execution:po:confirm"},
+	})
+
+	// Then
+	assert.Error(t, err)
+	assert.Nil(t, response)
+	assert.Contains(t, err.Error(), "invalid scope")
+	mockAPIKeyRepo.AssertNotCalled(t, "Create")
+}
+
+func TestCreateAPIKeyUseCase_Execute_WithRateLimitAboveMax_ReturnsBadRequest(t *testing.T) {
+	// Given
+	mockAPIKeyRepo := new(providers.MockAPIKeyRepository)
+	mockAuditRepo := new(providers.MockAuditLogRepository)
+	mockLogger := new(providers.MockLogger)
+
+	useCase := NewCreateAPIKeyUseCase(mockAPIKeyRepo, mockAuditRepo, mockLogger)
+
+	// When
+	response, err := useCase.Execute(context.Background(), uuid.New(), uuid.New(), &domain.CreateAPIKeyRequest{
+		Name:               "Looker",
+		Scopes:             []string{"analytics:kpis:read"},
+		RateLimitPerMinute: domain.MaxAPIKeyRateLimit + 1,
+	})
+
+	// Then
+	assert.Error(t, err)
+	assert.Nil(t, response)
+	assert.Contains(t, err.Error(), "rate limit")
+	mockAPIKeyRepo.AssertNotCalled(t, "Create")
+}
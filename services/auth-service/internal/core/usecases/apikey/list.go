@@ -0,0 +1,40 @@
+package apikey
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	pkgErrors "github.com/giia/giia-core-engine/pkg/errors"
+	pkgLogger "github.com/giia/giia-core-engine/pkg/logger"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/domain"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/providers"
+)
+
+type ListAPIKeysUseCase struct {
+	apiKeyRepo providers.APIKeyRepository
+	logger     pkgLogger.Logger
+}
+
+func NewListAPIKeysUseCase(apiKeyRepo providers.APIKeyRepository, logger pkgLogger.Logger) *ListAPIKeysUseCase {
+	return &ListAPIKeysUseCase{
+		apiKeyRepo: apiKeyRepo,
+		logger:     logger,
+	}
+}
+
+func (uc *ListAPIKeysUseCase) Execute(ctx context.Context, orgID uuid.UUID) ([]*domain.APIKey, error) {
+	if orgID == uuid.Nil {
+		return nil, pkgErrors.NewBadRequest("organization ID cannot be empty")
+	}
+
+	keys, err := uc.apiKeyRepo.ListByOrganization(ctx, orgID)
+	if err != nil {
+		uc.logger.Error(ctx, err, "Failed to list API keys", pkgLogger.Tags{
+			"organization_id": orgID.String(),
+		})
+		return nil, pkgErrors.NewInternalServerError("failed to list API keys")
+	}
+
+	return keys, nil
+}
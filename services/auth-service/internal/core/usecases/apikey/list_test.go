@@ -0,0 +1,50 @@
+package apikey
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/domain"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/providers"
+)
+
+func TestListAPIKeysUseCase_Execute_WithValidOrganization_ReturnsKeys(t *testing.T) {
+	// Given
+	givenOrgID := uuid.New()
+	givenKeys := []*domain.APIKey{{ID: uuid.New(), OrganizationID: givenOrgID, Name: "PowerBI"}}
+
+	mockAPIKeyRepo := new(providers.MockAPIKeyRepository)
+	mockLogger := new(providers.MockLogger)
+
+	useCase := NewListAPIKeysUseCase(mockAPIKeyRepo, mockLogger)
+
+	mockAPIKeyRepo.On("ListByOrganization", mock.Anything, givenOrgID).Return(givenKeys, nil)
+
+	// When
+	keys, err := useCase.Execute(context.Background(), givenOrgID)
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, givenKeys, keys)
+	mockAPIKeyRepo.AssertExpectations(t)
+}
+
+func TestListAPIKeysUseCase_Execute_WithNilOrganization_ReturnsBadRequest(t *testing.T) {
+	// Given
+	mockAPIKeyRepo := new(providers.MockAPIKeyRepository)
+	mockLogger := new(providers.MockLogger)
+
+	useCase := NewListAPIKeysUseCase(mockAPIKeyRepo, mockLogger)
+
+	// When
+	keys, err := useCase.Execute(context.Background(), uuid.Nil)
+
+	// Then
+	assert.Error(t, err)
+	assert.Nil(t, keys)
+	mockAPIKeyRepo.AssertNotCalled(t, "ListByOrganization")
+}
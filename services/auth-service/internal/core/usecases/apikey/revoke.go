@@ -0,0 +1,66 @@
+package apikey
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	pkgErrors "github.com/giia/giia-core-engine/pkg/errors"
+	pkgLogger "github.com/giia/giia-core-engine/pkg/logger"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/domain"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/providers"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/usecases/audit"
+)
+
+type RevokeAPIKeyUseCase struct {
+	apiKeyRepo providers.APIKeyRepository
+	auditRepo  providers.AuditLogRepository
+	logger     pkgLogger.Logger
+}
+
+func NewRevokeAPIKeyUseCase(
+	apiKeyRepo providers.APIKeyRepository,
+	auditRepo providers.AuditLogRepository,
+	logger pkgLogger.Logger,
+) *RevokeAPIKeyUseCase {
+	return &RevokeAPIKeyUseCase{
+		apiKeyRepo: apiKeyRepo,
+		auditRepo:  auditRepo,
+		logger:     logger,
+	}
+}
+
+func (uc *RevokeAPIKeyUseCase) Execute(ctx context.Context, orgID, userID, keyID uuid.UUID) (*domain.APIKey, error) {
+	if keyID == uuid.Nil {
+		return nil, pkgErrors.NewBadRequest("API key ID cannot be empty")
+	}
+
+	key, err := uc.apiKeyRepo.GetByID(ctx, keyID)
+	if err != nil || key.OrganizationID != orgID {
+		return nil, pkgErrors.NewNotFound("API key not found")
+	}
+
+	if key.RevokedAt != nil {
+		return nil, pkgErrors.NewConflict("API key already revoked")
+	}
+
+	now := time.Now().UTC()
+	key.RevokedAt = &now
+
+	if err := uc.apiKeyRepo.Update(ctx, key); err != nil {
+		uc.logger.Error(ctx, err, "Failed to revoke API key", pkgLogger.Tags{
+			"api_key_id": keyID.String(),
+		})
+		return nil, pkgErrors.NewInternalServerError("failed to revoke API key")
+	}
+
+	audit.Record(ctx, uc.auditRepo, uc.logger, key.OrganizationID, userID, domain.AuditActionAPIKeyRevoked, "api_key:"+key.ID.String(), key.Name)
+
+	uc.logger.Info(ctx, "API key revoked", pkgLogger.Tags{
+		"api_key_id":      keyID.String(),
+		"organization_id": orgID.String(),
+	})
+
+	return key, nil
+}
@@ -0,0 +1,67 @@
+package apikey
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/domain"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/providers"
+)
+
+func TestRevokeAPIKeyUseCase_Execute_WithActiveKey_RevokesAndAudits(t *testing.T) {
+	// Given
+	givenOrgID := uuid.New()
+	givenUserID := uuid.New()
+	givenKey := &domain.APIKey{ID: uuid.New(), OrganizationID: givenOrgID, Name: "PowerBI"}
+
+	mockAPIKeyRepo := new(providers.MockAPIKeyRepository)
+	mockAuditRepo := new(providers.MockAuditLogRepository)
+	mockLogger := new(providers.MockLogger)
+
+	useCase := NewRevokeAPIKeyUseCase(mockAPIKeyRepo, mockAuditRepo, mockLogger)
+
+	mockAPIKeyRepo.On("GetByID", mock.Anything, givenKey.ID).Return(givenKey, nil)
+	mockAPIKeyRepo.On("Update", mock.Anything, mock.MatchedBy(func(k *domain.APIKey) bool {
+		return k.RevokedAt != nil
+	})).Return(nil)
+	mockAuditRepo.On("Create", mock.Anything, mock.MatchedBy(func(l *domain.AuditLog) bool {
+		return l.Action == domain.AuditActionAPIKeyRevoked
+	})).Return(nil)
+	mockLogger.On("Info", mock.Anything, "API key revoked", mock.Anything).Return()
+
+	// When
+	key, err := useCase.Execute(context.Background(), givenOrgID, givenUserID, givenKey.ID)
+
+	// Then
+	assert.NoError(t, err)
+	assert.False(t, key.IsActive(time.Now()))
+	mockAPIKeyRepo.AssertExpectations(t)
+	mockAuditRepo.AssertExpectations(t)
+}
+
+func TestRevokeAPIKeyUseCase_Execute_WithKeyFromOtherOrganization_ReturnsNotFound(t *testing.T) {
+	// Given
+	givenKey := &domain.APIKey{ID: uuid.New(), OrganizationID: uuid.New()}
+
+	mockAPIKeyRepo := new(providers.MockAPIKeyRepository)
+	mockAuditRepo := new(providers.MockAuditLogRepository)
+	mockLogger := new(providers.MockLogger)
+
+	useCase := NewRevokeAPIKeyUseCase(mockAPIKeyRepo, mockAuditRepo, mockLogger)
+
+	mockAPIKeyRepo.On("GetByID", mock.Anything, givenKey.ID).Return(givenKey, nil)
+
+	// When
+	key, err := useCase.Execute(context.Background(), uuid.New(), uuid.New(), givenKey.ID)
+
+	// Then
+	assert.Error(t, err)
+	assert.Nil(t, key)
+	assert.Contains(t, err.Error(), "API key not found")
+	mockAPIKeyRepo.AssertNotCalled(t, "Update")
+}
@@ -0,0 +1,70 @@
+package apikey
+
+import (
+	"context"
+	"crypto/subtle"
+	"time"
+
+	pkgErrors "github.com/giia/giia-core-engine/pkg/errors"
+	pkgLogger "github.com/giia/giia-core-engine/pkg/logger"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/domain"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/providers"
+)
+
+// lastUsedResolution limits last_used_at writes to one per key per minute.
+const lastUsedResolution = time.Minute
+
+type ValidateAPIKeyUseCase struct {
+	apiKeyRepo providers.APIKeyRepository
+	usageRepo  providers.UsageRepository
+	logger     pkgLogger.Logger
+}
+
+func NewValidateAPIKeyUseCase(apiKeyRepo providers.APIKeyRepository, usageRepo providers.UsageRepository, logger pkgLogger.Logger) *ValidateAPIKeyUseCase {
+	return &ValidateAPIKeyUseCase{
+		apiKeyRepo: apiKeyRepo,
+		usageRepo:  usageRepo,
+		logger:     logger,
+	}
+}
+
+func (uc *ValidateAPIKeyUseCase) Execute(ctx context.Context, plaintext string) (*domain.APIKey, error) {
+	prefix, ok := domain.ParseAPIKeyPrefix(plaintext)
+	if !ok {
+		return nil, pkgErrors.NewUnauthorized("invalid API key")
+	}
+
+	key, err := uc.apiKeyRepo.GetByPrefix(ctx, prefix)
+	if err != nil {
+		return nil, pkgErrors.NewUnauthorized("invalid API key")
+	}
+
+	if subtle.ConstantTimeCompare([]byte(key.KeyHash), []byte(domain.HashAPIKey(plaintext))) != 1 {
+		return nil, pkgErrors.NewUnauthorized("invalid API key")
+	}
+
+	now := time.Now().UTC()
+	if !key.IsActive(now) {
+		return nil, pkgErrors.NewUnauthorized("API key is revoked or expired")
+	}
+
+	if key.LastUsedAt == nil || now.Sub(*key.LastUsedAt) >= lastUsedResolution {
+		key.LastUsedAt = &now
+		if err := uc.apiKeyRepo.Update(ctx, key); err != nil {
+			uc.logger.Warn(ctx, "Failed to record API key usage", pkgLogger.Tags{
+				"api_key_id": key.ID.String(),
+				"error":      err.Error(),
+			})
+		}
+	}
+
+	// Every authenticated call is billable usage of the key's organization
+	if err := uc.usageRepo.IncrementAPICalls(ctx, key.OrganizationID, domain.UsagePeriod(now)); err != nil {
+		uc.logger.Warn(ctx, "Failed to meter API call", pkgLogger.Tags{
+			"api_key_id": key.ID.String(),
+			"error":      err.Error(),
+		})
+	}
+
+	return key, nil
+}
@@ -0,0 +1,122 @@
+package apikey
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/domain"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/providers"
+)
+
+func givenStoredKey(t *testing.T) (string, *domain.APIKey) {
+	plaintext, prefix, hash, err := domain.GenerateAPIKey()
+	assert.NoError(t, err)
+
+	return plaintext, &domain.APIKey{
+		ID:             uuid.New(),
+		OrganizationID: uuid.New(),
+		Prefix:         prefix,
+		KeyHash:        hash,
+		Scopes:         []string{"analytics:kpis:read"},
+	}
+}
+
+func TestValidateAPIKeyUseCase_Execute_WithValidKey_ReturnsKeyAndRecordsUsage(t *testing.T) {
+	// Given
+	givenPlaintext, givenKey := givenStoredKey(t)
+
+	mockAPIKeyRepo := new(providers.MockAPIKeyRepository)
+	mockUsageRepo := new(providers.MockUsageRepository)
+	mockLogger := new(providers.MockLogger)
+
+	useCase := NewValidateAPIKeyUseCase(mockAPIKeyRepo, mockUsageRepo, mockLogger)
+
+	mockAPIKeyRepo.On("GetByPrefix", mock.Anything, givenKey.Prefix).Return(givenKey, nil)
+	mockAPIKeyRepo.On("Update", mock.Anything, mock.MatchedBy(func(k *domain.APIKey) bool {
+		return k.LastUsedAt != nil
+	})).Return(nil)
+	mockUsageRepo.On("IncrementAPICalls", mock.Anything, givenKey.OrganizationID, domain.UsagePeriod(time.Now())).Return(nil)
+
+	// When
+	key, err := useCase.Execute(context.Background(), givenPlaintext)
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, givenKey.ID, key.ID)
+	mockAPIKeyRepo.AssertExpectations(t)
+	mockUsageRepo.AssertExpectations(t)
+}
+
+func TestValidateAPIKeyUseCase_Execute_WithRecentUsage_SkipsUsageWrite(t *testing.T) {
+	// Given
+	givenPlaintext, givenKey := givenStoredKey(t)
+	recentlyUsed := time.Now().UTC().Add(-10 * time.Second)
+	givenKey.LastUsedAt = &recentlyUsed
+
+	mockAPIKeyRepo := new(providers.MockAPIKeyRepository)
+	mockUsageRepo := new(providers.MockUsageRepository)
+	mockLogger := new(providers.MockLogger)
+
+	useCase := NewValidateAPIKeyUseCase(mockAPIKeyRepo, mockUsageRepo, mockLogger)
+
+	mockAPIKeyRepo.On("GetByPrefix", mock.Anything, givenKey.Prefix).Return(givenKey, nil)
+	mockUsageRepo.On("IncrementAPICalls", mock.Anything, givenKey.OrganizationID, mock.Anything).Return(nil)
+
+	// When
+	_, err := useCase.Execute(context.Background(), givenPlaintext)
+
+	// Then
+	assert.NoError(t, err)
+	mockAPIKeyRepo.AssertNotCalled(t, "Update")
+}
+
+func TestValidateAPIKeyUseCase_Execute_WithWrongSecret_ReturnsUnauthorized(t *testing.T) {
+	// Given
+	givenPlaintext, givenKey := givenStoredKey(t)
+	tampered := givenPlaintext[:len(givenPlaintext)-1] + "x"
+
+	mockAPIKeyRepo := new(providers.MockAPIKeyRepository)
+	mockUsageRepo := new(providers.MockUsageRepository)
+	mockLogger := new(providers.MockLogger)
+
+	useCase := NewValidateAPIKeyUseCase(mockAPIKeyRepo, mockUsageRepo, mockLogger)
+
+	mockAPIKeyRepo.On("GetByPrefix", mock.Anything, givenKey.Prefix).Return(givenKey, nil)
+
+	// When
+	key, err := useCase.Execute(context.Background(), tampered)
+
+	// Then
+	assert.Error(t, err)
+	assert.Nil(t, key)
+	assert.Contains(t, err.Error(), "invalid API key")
+	mockUsageRepo.AssertNotCalled(t, "IncrementAPICalls")
+}
+
+func TestValidateAPIKeyUseCase_Execute_WithRevokedKey_ReturnsUnauthorized(t *testing.T) {
+	// Given
+	givenPlaintext, givenKey := givenStoredKey(t)
+	revokedAt := time.Now().UTC().Add(-time.Hour)
+	givenKey.RevokedAt = &revokedAt
+
+	mockAPIKeyRepo := new(providers.MockAPIKeyRepository)
+	mockUsageRepo := new(providers.MockUsageRepository)
+	mockLogger := new(providers.MockLogger)
+
+	useCase := NewValidateAPIKeyUseCase(mockAPIKeyRepo, mockUsageRepo, mockLogger)
+
+	mockAPIKeyRepo.On("GetByPrefix", mock.Anything, givenKey.Prefix).Return(givenKey, nil)
+
+	// When
+	key, err := useCase.Execute(context.Background(), givenPlaintext)
+
+	// Then
+	assert.Error(t, err)
+	assert.Nil(t, key)
+	assert.Contains(t, err.Error(), "revoked or expired")
+}
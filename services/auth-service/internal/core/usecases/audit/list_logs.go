@@ -0,0 +1,55 @@
+package audit
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	pkgErrors "github.com/giia/giia-core-engine/pkg/errors"
+	pkgLogger "github.com/giia/giia-core-engine/pkg/logger"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/domain"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/providers"
+)
+
+type ListAuditLogsUseCase struct {
+	auditRepo providers.AuditLogRepository
+	logger    pkgLogger.Logger
+}
+
+func NewListAuditLogsUseCase(auditRepo providers.AuditLogRepository, logger pkgLogger.Logger) *ListAuditLogsUseCase {
+	return &ListAuditLogsUseCase{
+		auditRepo: auditRepo,
+		logger:    logger,
+	}
+}
+
+// Execute lists the organization's audit log newest first. A zero limit
+// uses DefaultAuditLogLimit and larger ones are capped at MaxAuditLogLimit.
+func (uc *ListAuditLogsUseCase) Execute(ctx context.Context, orgID uuid.UUID, filter domain.AuditLogFilter) ([]*domain.AuditLog, error) {
+	if orgID == uuid.Nil {
+		return nil, pkgErrors.NewBadRequest("organization ID cannot be empty")
+	}
+
+	if filter.From != nil && filter.To != nil && !filter.From.Before(*filter.To) {
+		return nil, pkgErrors.NewBadRequest("from must be before to")
+	}
+
+	switch {
+	case filter.Limit < 0:
+		return nil, pkgErrors.NewBadRequest("limit cannot be negative")
+	case filter.Limit == 0:
+		filter.Limit = domain.DefaultAuditLogLimit
+	case filter.Limit > domain.MaxAuditLogLimit:
+		filter.Limit = domain.MaxAuditLogLimit
+	}
+
+	entries, err := uc.auditRepo.ListByOrganization(ctx, orgID, filter)
+	if err != nil {
+		uc.logger.Error(ctx, err, "Failed to list audit logs", pkgLogger.Tags{
+			"organization_id": orgID.String(),
+		})
+		return nil, pkgErrors.NewInternalServerError("failed to list audit logs")
+	}
+
+	return entries, nil
+}
@@ -0,0 +1,101 @@
+package audit
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/domain"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/providers"
+)
+
+func TestListAuditLogsUseCase_Execute_WithoutLimit_UsesDefaultLimit(t *testing.T) {
+	// Given
+	givenOrgID := uuid.New()
+	givenEntries := []*domain.AuditLog{{ID: uuid.New(), OrganizationID: givenOrgID, Action: domain.AuditActionSessionsRevoked}}
+
+	mockAuditRepo := new(providers.MockAuditLogRepository)
+	mockLogger := new(providers.MockLogger)
+
+	useCase := NewListAuditLogsUseCase(mockAuditRepo, mockLogger)
+
+	mockAuditRepo.On("ListByOrganization", mock.Anything, givenOrgID, domain.AuditLogFilter{
+		Action: domain.AuditActionSessionsRevoked,
+		Limit:  domain.DefaultAuditLogLimit,
+	}).Return(givenEntries, nil)
+
+	// When
+	entries, err := useCase.Execute(context.Background(), givenOrgID, domain.AuditLogFilter{Action: domain.AuditActionSessionsRevoked})
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, givenEntries, entries)
+	mockAuditRepo.AssertExpectations(t)
+}
+
+func TestListAuditLogsUseCase_Execute_WithLimitAboveMax_CapsLimit(t *testing.T) {
+	// Given
+	givenOrgID := uuid.New()
+
+	mockAuditRepo := new(providers.MockAuditLogRepository)
+	mockLogger := new(providers.MockLogger)
+
+	useCase := NewListAuditLogsUseCase(mockAuditRepo, mockLogger)
+
+	mockAuditRepo.On("ListByOrganization", mock.Anything, givenOrgID, mock.MatchedBy(func(filter domain.AuditLogFilter) bool {
+		return filter.Limit == domain.MaxAuditLogLimit
+	})).Return([]*domain.AuditLog{}, nil)
+
+	// When
+	_, err := useCase.Execute(context.Background(), givenOrgID, domain.AuditLogFilter{Limit: 50000})
+
+	// Then
+	assert.NoError(t, err)
+	mockAuditRepo.AssertExpectations(t)
+}
+
+func TestListAuditLogsUseCase_Execute_WithFromAfterTo_ReturnsBadRequest(t *testing.T) {
+	// Given
+	givenTo := time.Now().UTC()
+	givenFrom := givenTo.Add(time.Hour)
+
+	mockAuditRepo := new(providers.MockAuditLogRepository)
+	mockLogger := new(providers.MockLogger)
+
+	useCase := NewListAuditLogsUseCase(mockAuditRepo, mockLogger)
+
+	// When
+	entries, err := useCase.Execute(context.Background(), uuid.New(), domain.AuditLogFilter{From: &givenFrom, To: &givenTo})
+
+	// Then
+	assert.Error(t, err)
+	assert.Nil(t, entries)
+	assert.Contains(t, err.Error(), "from must be before to")
+	mockAuditRepo.AssertNotCalled(t, "ListByOrganization")
+}
+
+func TestListAuditLogsUseCase_Execute_WithRepositoryError_ReturnsInternalError(t *testing.T) {
+	// Given
+	givenOrgID := uuid.New()
+
+	mockAuditRepo := new(providers.MockAuditLogRepository)
+	mockLogger := new(providers.MockLogger)
+
+	useCase := NewListAuditLogsUseCase(mockAuditRepo, mockLogger)
+
+	mockAuditRepo.On("ListByOrganization", mock.Anything, givenOrgID, mock.Anything).Return(nil, errors.New("db down"))
+	mockLogger.On("Error", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
+
+	// When
+	entries, err := useCase.Execute(context.Background(), givenOrgID, domain.AuditLogFilter{})
+
+	// Then
+	assert.Error(t, err)
+	assert.Nil(t, entries)
+	assert.Contains(t, err.Error(), "failed to list audit logs")
+}
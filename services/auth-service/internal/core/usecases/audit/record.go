@@ -0,0 +1,37 @@
+package audit
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	pkgLogger "github.com/giia/giia-core-engine/pkg/logger"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/domain"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/providers"
+)
+
+// Record writes an audit entry in orgID. Failures are logged but do not fail
+// the audited operation.
+func Record(
+	ctx context.Context,
+	auditRepo providers.AuditLogRepository,
+	logger pkgLogger.Logger,
+	orgID, actorID uuid.UUID,
+	action, resource, details string,
+) {
+	entry := &domain.AuditLog{
+		OrganizationID: orgID,
+		ActorID:        actorID,
+		Action:         action,
+		Resource:       resource,
+		Details:        details,
+	}
+
+	if err := auditRepo.Create(ctx, entry); err != nil {
+		logger.Error(ctx, err, "Failed to write audit log", pkgLogger.Tags{
+			"organization_id": orgID.String(),
+			"action":          action,
+			"resource":        resource,
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package audit
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/domain"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/providers"
+)
+
+func TestRecord_WithEntry_WritesResourceAndDetails(t *testing.T) {
+	// Given
+	givenOrgID := uuid.New()
+	givenActorID := uuid.New()
+
+	mockAuditRepo := new(providers.MockAuditLogRepository)
+	mockLogger := new(providers.MockLogger)
+
+	mockAuditRepo.On("Create", mock.Anything, mock.MatchedBy(func(entry *domain.AuditLog) bool {
+		return entry.OrganizationID == givenOrgID &&
+			entry.ActorID == givenActorID &&
+			entry.Action == domain.AuditActionAPIKeyCreated &&
+			entry.Resource == "api_key:123" &&
+			entry.Details == "BI export"
+	})).Return(nil)
+
+	// When
+	Record(context.Background(), mockAuditRepo, mockLogger, givenOrgID, givenActorID, domain.AuditActionAPIKeyCreated, "api_key:123", "BI export")
+
+	// Then
+	mockAuditRepo.AssertExpectations(t)
+	mockLogger.AssertNotCalled(t, "Error", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestRecord_WithRepositoryError_LogsAndContinues(t *testing.T) {
+	// Given
+	mockAuditRepo := new(providers.MockAuditLogRepository)
+	mockLogger := new(providers.MockLogger)
+
+	mockAuditRepo.On("Create", mock.Anything, mock.Anything).Return(errors.New("db down"))
+	mockLogger.On("Error", mock.Anything, mock.Anything, "Failed to write audit log", mock.Anything).Return()
+
+	// When
+	Record(context.Background(), mockAuditRepo, mockLogger, uuid.New(), uuid.New(), domain.AuditActionMemberRemoved, "user:1", "")
+
+	// Then
+	mockLogger.AssertExpectations(t)
+}
@@ -0,0 +1,102 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+
+	pkgErrors "github.com/giia/giia-core-engine/pkg/errors"
+	pkgLogger "github.com/giia/giia-core-engine/pkg/logger"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/domain"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/providers"
+)
+
+type ChangePasswordUseCase struct {
+	userRepo       providers.UserRepository
+	tokenRepo      providers.TokenRepository
+	eventPublisher providers.SecurityEventPublisher
+	logger         pkgLogger.Logger
+}
+
+func NewChangePasswordUseCase(
+	userRepo providers.UserRepository,
+	tokenRepo providers.TokenRepository,
+	eventPublisher providers.SecurityEventPublisher,
+	logger pkgLogger.Logger,
+) *ChangePasswordUseCase {
+	return &ChangePasswordUseCase{
+		userRepo:       userRepo,
+		tokenRepo:      tokenRepo,
+		eventPublisher: eventPublisher,
+		logger:         logger,
+	}
+}
+
+func (uc *ChangePasswordUseCase) Execute(ctx context.Context, userID uuid.UUID, req *domain.ChangePasswordRequest) error {
+	if req.CurrentPassword == "" {
+		return pkgErrors.NewBadRequest("current password is required")
+	}
+
+	if req.NewPassword == "" {
+		return pkgErrors.NewBadRequest("new password is required")
+	}
+
+	if err := validatePassword(req.NewPassword); err != nil {
+		return err
+	}
+
+	user, err := uc.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		uc.logger.Error(ctx, err, "Failed to get user", pkgLogger.Tags{
+			"user_id": userID.String(),
+		})
+		return pkgErrors.NewNotFound("user not found")
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(req.CurrentPassword)); err != nil {
+		uc.logger.Warn(ctx, "Password change attempt with invalid current password", pkgLogger.Tags{
+			"user_id": userID.String(),
+		})
+		return pkgErrors.NewUnauthorized("current password is incorrect")
+	}
+
+	if req.CurrentPassword == req.NewPassword {
+		return pkgErrors.NewBadRequest("new password must be different from the current password")
+	}
+
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(req.NewPassword), bcrypt.DefaultCost)
+	if err != nil {
+		uc.logger.Error(ctx, err, "Failed to hash password", nil)
+		return pkgErrors.NewInternalServerError("failed to hash password")
+	}
+
+	user.Password = string(hashedPassword)
+	if err := uc.userRepo.Update(ctx, user); err != nil {
+		uc.logger.Error(ctx, err, "Failed to update password", pkgLogger.Tags{
+			"user_id": userID.String(),
+		})
+		return pkgErrors.NewInternalServerError("failed to update password")
+	}
+
+	if err := uc.tokenRepo.RevokeAllUserTokens(ctx, userID); err != nil {
+		uc.logger.Error(ctx, err, "Failed to revoke refresh tokens after password change", pkgLogger.Tags{
+			"user_id": userID.String(),
+		})
+	}
+
+	publishSecurityEvent(ctx, uc.eventPublisher, uc.logger, &domain.SecurityEvent{
+		Type:           domain.SecurityEventPasswordChanged,
+		UserID:         user.ID,
+		OrganizationID: user.OrganizationID,
+		Email:          user.Email,
+		IPAddress:      req.IPAddress,
+		UserAgent:      req.UserAgent,
+	})
+
+	uc.logger.Info(ctx, "Password changed successfully", pkgLogger.Tags{
+		"user_id": userID.String(),
+	})
+
+	return nil
+}
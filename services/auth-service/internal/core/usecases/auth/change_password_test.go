@@ -0,0 +1,156 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/domain"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/providers"
+)
+
+func TestChangePasswordUseCase_Execute_WithValidRequest_PublishesPasswordChanged(t *testing.T) {
+	// Given
+	givenUserID := uuid.New()
+	givenOrgID := uuid.New()
+	givenCurrentPassword := "OldPassword1!"
+	givenHashedPassword, _ := bcrypt.GenerateFromPassword([]byte(givenCurrentPassword), bcrypt.DefaultCost)
+
+	givenUser := &domain.User{
+		ID:             givenUserID,
+		Email:          "user@example.com",
+		Password:       string(givenHashedPassword),
+		OrganizationID: givenOrgID,
+	}
+
+	givenRequest := &domain.ChangePasswordRequest{
+		CurrentPassword: givenCurrentPassword,
+		NewPassword:     "NewPassword2@",
+	}
+
+	mockUserRepo := new(providers.MockUserRepository)
+	mockTokenRepo := new(providers.MockTokenRepository)
+	mockPublisher := new(providers.MockSecurityEventPublisher)
+	mockLogger := new(providers.MockLogger)
+
+	useCase := NewChangePasswordUseCase(mockUserRepo, mockTokenRepo, mockPublisher, mockLogger)
+
+	mockUserRepo.On("GetByID", mock.Anything, givenUserID).Return(givenUser, nil)
+	mockUserRepo.On("Update", mock.Anything, mock.AnythingOfType("*domain.User")).Return(nil)
+	mockTokenRepo.On("RevokeAllUserTokens", mock.Anything, givenUserID).Return(nil)
+	mockPublisher.On("PublishSecurityEvent", mock.Anything, mock.MatchedBy(func(event *domain.SecurityEvent) bool {
+		return event.Type == domain.SecurityEventPasswordChanged && event.OrganizationID == givenOrgID
+	})).Return(nil)
+	mockLogger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	// When
+	err := useCase.Execute(context.Background(), givenUserID, givenRequest)
+
+	// Then
+	assert.NoError(t, err)
+	assert.NoError(t, bcrypt.CompareHashAndPassword([]byte(givenUser.Password), []byte("NewPassword2@")))
+	mockUserRepo.AssertExpectations(t)
+	mockTokenRepo.AssertExpectations(t)
+	mockPublisher.AssertExpectations(t)
+}
+
+func TestChangePasswordUseCase_Execute_WithWrongCurrentPassword_ReturnsUnauthorized(t *testing.T) {
+	// Given
+	givenUserID := uuid.New()
+	givenHashedPassword, _ := bcrypt.GenerateFromPassword([]byte("OldPassword1!"), bcrypt.DefaultCost)
+
+	givenUser := &domain.User{
+		ID:       givenUserID,
+		Password: string(givenHashedPassword),
+	}
+
+	givenRequest := &domain.ChangePasswordRequest{
+		CurrentPassword: "WrongPassword1!",
+		NewPassword:     "NewPassword2@",
+	}
+
+	mockUserRepo := new(providers.MockUserRepository)
+	mockTokenRepo := new(providers.MockTokenRepository)
+	mockPublisher := new(providers.MockSecurityEventPublisher)
+	mockLogger := new(providers.MockLogger)
+
+	useCase := NewChangePasswordUseCase(mockUserRepo, mockTokenRepo, mockPublisher, mockLogger)
+
+	mockUserRepo.On("GetByID", mock.Anything, givenUserID).Return(givenUser, nil)
+	mockLogger.On("Warn", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	// When
+	err := useCase.Execute(context.Background(), givenUserID, givenRequest)
+
+	// Then
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "current password is incorrect")
+	mockUserRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	mockPublisher.AssertNotCalled(t, "PublishSecurityEvent", mock.Anything, mock.Anything)
+}
+
+func TestChangePasswordUseCase_Execute_WithWeakNewPassword_ReturnsBadRequest(t *testing.T) {
+	// Given
+	givenRequest := &domain.ChangePasswordRequest{
+		CurrentPassword: "OldPassword1!",
+		NewPassword:     "weak",
+	}
+
+	mockUserRepo := new(providers.MockUserRepository)
+	mockTokenRepo := new(providers.MockTokenRepository)
+	mockPublisher := new(providers.MockSecurityEventPublisher)
+	mockLogger := new(providers.MockLogger)
+
+	useCase := NewChangePasswordUseCase(mockUserRepo, mockTokenRepo, mockPublisher, mockLogger)
+
+	// When
+	err := useCase.Execute(context.Background(), uuid.New(), givenRequest)
+
+	// Then
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "at least 8 characters")
+	mockUserRepo.AssertNotCalled(t, "GetByID", mock.Anything, mock.Anything)
+}
+
+func TestChangePasswordUseCase_Execute_WhenPublishFails_StillSucceeds(t *testing.T) {
+	// Given
+	givenUserID := uuid.New()
+	givenCurrentPassword := "OldPassword1!"
+	givenHashedPassword, _ := bcrypt.GenerateFromPassword([]byte(givenCurrentPassword), bcrypt.DefaultCost)
+
+	givenUser := &domain.User{
+		ID:       givenUserID,
+		Password: string(givenHashedPassword),
+	}
+
+	givenRequest := &domain.ChangePasswordRequest{
+		CurrentPassword: givenCurrentPassword,
+		NewPassword:     "NewPassword2@",
+	}
+
+	mockUserRepo := new(providers.MockUserRepository)
+	mockTokenRepo := new(providers.MockTokenRepository)
+	mockPublisher := new(providers.MockSecurityEventPublisher)
+	mockLogger := new(providers.MockLogger)
+
+	useCase := NewChangePasswordUseCase(mockUserRepo, mockTokenRepo, mockPublisher, mockLogger)
+
+	mockUserRepo.On("GetByID", mock.Anything, givenUserID).Return(givenUser, nil)
+	mockUserRepo.On("Update", mock.Anything, mock.AnythingOfType("*domain.User")).Return(nil)
+	mockTokenRepo.On("RevokeAllUserTokens", mock.Anything, givenUserID).Return(nil)
+	mockPublisher.On("PublishSecurityEvent", mock.Anything, mock.Anything).Return(errors.New("nats unavailable"))
+	mockLogger.On("Error", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
+	mockLogger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	// When
+	err := useCase.Execute(context.Background(), givenUserID, givenRequest)
+
+	// Then
+	assert.NoError(t, err)
+	mockPublisher.AssertExpectations(t)
+}
@@ -0,0 +1,88 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	pkgErrors "github.com/giia/giia-core-engine/pkg/errors"
+	pkgLogger "github.com/giia/giia-core-engine/pkg/logger"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/domain"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/providers"
+)
+
+type ConfirmTwoFactorEnrollmentUseCase struct {
+	userRepo       providers.UserRepository
+	totpValidator  providers.TOTPValidator
+	eventPublisher providers.SecurityEventPublisher
+	logger         pkgLogger.Logger
+}
+
+func NewConfirmTwoFactorEnrollmentUseCase(
+	userRepo providers.UserRepository,
+	totpValidator providers.TOTPValidator,
+	eventPublisher providers.SecurityEventPublisher,
+	logger pkgLogger.Logger,
+) *ConfirmTwoFactorEnrollmentUseCase {
+	return &ConfirmTwoFactorEnrollmentUseCase{
+		userRepo:       userRepo,
+		totpValidator:  totpValidator,
+		eventPublisher: eventPublisher,
+		logger:         logger,
+	}
+}
+
+// Execute enables 2FA once the user proves their authenticator produces codes
+// for the pending secret. From then on suspicious logins and magic links ask
+// for a step-up code.
+func (uc *ConfirmTwoFactorEnrollmentUseCase) Execute(ctx context.Context, userID uuid.UUID, req *domain.ConfirmTwoFactorEnrollmentRequest) error {
+	if req.Code == "" {
+		return pkgErrors.NewBadRequest("verification code is required")
+	}
+
+	user, err := uc.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		uc.logger.Error(ctx, err, "Failed to get user", pkgLogger.Tags{
+			"user_id": userID.String(),
+		})
+		return pkgErrors.NewNotFound("user not found")
+	}
+
+	if user.TwoFactorEnabled {
+		return pkgErrors.NewConflict("two-factor authentication is already enabled")
+	}
+
+	if user.TwoFactorSecret == "" {
+		return pkgErrors.NewBadRequest("two-factor enrollment has not been started")
+	}
+
+	if !uc.totpValidator.ValidateCode(user.TwoFactorSecret, req.Code) {
+		uc.logger.Warn(ctx, "Invalid 2FA enrollment code", pkgLogger.Tags{
+			"user_id": userID.String(),
+		})
+		return pkgErrors.NewUnauthorized("invalid verification code")
+	}
+
+	user.TwoFactorEnabled = true
+	if err := uc.userRepo.Update(ctx, user); err != nil {
+		uc.logger.Error(ctx, err, "Failed to enable 2FA", pkgLogger.Tags{
+			"user_id": userID.String(),
+		})
+		return pkgErrors.NewInternalServerError("failed to enable two-factor authentication")
+	}
+
+	publishSecurityEvent(ctx, uc.eventPublisher, uc.logger, &domain.SecurityEvent{
+		Type:           domain.SecurityEventTwoFactorEnabled,
+		UserID:         user.ID,
+		OrganizationID: user.OrganizationID,
+		Email:          user.Email,
+		IPAddress:      req.IPAddress,
+		UserAgent:      req.UserAgent,
+	})
+
+	uc.logger.Info(ctx, "Two-factor authentication enabled", pkgLogger.Tags{
+		"user_id": userID.String(),
+	})
+
+	return nil
+}
@@ -0,0 +1,104 @@
+package auth
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/domain"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/providers"
+)
+
+func TestConfirmTwoFactorEnrollmentUseCase_Execute_WithValidCode_EnablesTwoFactor(t *testing.T) {
+	// Given
+	givenUserID := uuid.New()
+	givenOrgID := uuid.New()
+
+	givenUser := &domain.User{
+		ID:              givenUserID,
+		Email:           "user@example.com",
+		OrganizationID:  givenOrgID,
+		TwoFactorSecret: "JBSWY3DPEHPK3PXP",
+	}
+
+	mockUserRepo := new(providers.MockUserRepository)
+	mockValidator := new(providers.MockTOTPValidator)
+	mockPublisher := new(providers.MockSecurityEventPublisher)
+	mockLogger := new(providers.MockLogger)
+
+	useCase := NewConfirmTwoFactorEnrollmentUseCase(mockUserRepo, mockValidator, mockPublisher, mockLogger)
+
+	mockUserRepo.On("GetByID", mock.Anything, givenUserID).Return(givenUser, nil)
+	mockValidator.On("ValidateCode", "JBSWY3DPEHPK3PXP", "123456").Return(true)
+	mockUserRepo.On("Update", mock.Anything, mock.MatchedBy(func(user *domain.User) bool {
+		return user.TwoFactorEnabled && user.TwoFactorSecret == "JBSWY3DPEHPK3PXP"
+	})).Return(nil)
+	mockPublisher.On("PublishSecurityEvent", mock.Anything, mock.MatchedBy(func(event *domain.SecurityEvent) bool {
+		return event.Type == domain.SecurityEventTwoFactorEnabled && event.OrganizationID == givenOrgID
+	})).Return(nil)
+	mockLogger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	// When
+	err := useCase.Execute(context.Background(), givenUserID, &domain.ConfirmTwoFactorEnrollmentRequest{Code: "123456"})
+
+	// Then
+	assert.NoError(t, err)
+	mockUserRepo.AssertExpectations(t)
+	mockPublisher.AssertExpectations(t)
+}
+
+func TestConfirmTwoFactorEnrollmentUseCase_Execute_WithInvalidCode_ReturnsUnauthorized(t *testing.T) {
+	// Given
+	givenUserID := uuid.New()
+
+	givenUser := &domain.User{
+		ID:              givenUserID,
+		TwoFactorSecret: "JBSWY3DPEHPK3PXP",
+	}
+
+	mockUserRepo := new(providers.MockUserRepository)
+	mockValidator := new(providers.MockTOTPValidator)
+	mockPublisher := new(providers.MockSecurityEventPublisher)
+	mockLogger := new(providers.MockLogger)
+
+	useCase := NewConfirmTwoFactorEnrollmentUseCase(mockUserRepo, mockValidator, mockPublisher, mockLogger)
+
+	mockUserRepo.On("GetByID", mock.Anything, givenUserID).Return(givenUser, nil)
+	mockValidator.On("ValidateCode", "JBSWY3DPEHPK3PXP", "000000").Return(false)
+	mockLogger.On("Warn", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	// When
+	err := useCase.Execute(context.Background(), givenUserID, &domain.ConfirmTwoFactorEnrollmentRequest{Code: "000000"})
+
+	// Then
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid verification code")
+	assert.False(t, givenUser.TwoFactorEnabled)
+	mockUserRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	mockPublisher.AssertNotCalled(t, "PublishSecurityEvent", mock.Anything, mock.Anything)
+}
+
+func TestConfirmTwoFactorEnrollmentUseCase_Execute_WithoutPendingSecret_ReturnsBadRequest(t *testing.T) {
+	// Given
+	givenUserID := uuid.New()
+
+	mockUserRepo := new(providers.MockUserRepository)
+	mockValidator := new(providers.MockTOTPValidator)
+	mockPublisher := new(providers.MockSecurityEventPublisher)
+	mockLogger := new(providers.MockLogger)
+
+	useCase := NewConfirmTwoFactorEnrollmentUseCase(mockUserRepo, mockValidator, mockPublisher, mockLogger)
+
+	mockUserRepo.On("GetByID", mock.Anything, givenUserID).Return(&domain.User{ID: givenUserID}, nil)
+
+	// When
+	err := useCase.Execute(context.Background(), givenUserID, &domain.ConfirmTwoFactorEnrollmentRequest{Code: "123456"})
+
+	// Then
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "has not been started")
+	mockValidator.AssertNotCalled(t, "ValidateCode", mock.Anything, mock.Anything)
+}
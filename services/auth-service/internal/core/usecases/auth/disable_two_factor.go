@@ -0,0 +1,82 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+
+	pkgErrors "github.com/giia/giia-core-engine/pkg/errors"
+	pkgLogger "github.com/giia/giia-core-engine/pkg/logger"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/domain"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/providers"
+)
+
+type DisableTwoFactorUseCase struct {
+	userRepo       providers.UserRepository
+	eventPublisher providers.SecurityEventPublisher
+	logger         pkgLogger.Logger
+}
+
+func NewDisableTwoFactorUseCase(
+	userRepo providers.UserRepository,
+	eventPublisher providers.SecurityEventPublisher,
+	logger pkgLogger.Logger,
+) *DisableTwoFactorUseCase {
+	return &DisableTwoFactorUseCase{
+		userRepo:       userRepo,
+		eventPublisher: eventPublisher,
+		logger:         logger,
+	}
+}
+
+// Execute turns off 2FA after re-checking the password and notifies the user,
+// since an attacker holding a session would disable it first.
+func (uc *DisableTwoFactorUseCase) Execute(ctx context.Context, userID uuid.UUID, req *domain.DisableTwoFactorRequest) error {
+	if req.Password == "" {
+		return pkgErrors.NewBadRequest("password is required")
+	}
+
+	user, err := uc.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		uc.logger.Error(ctx, err, "Failed to get user", pkgLogger.Tags{
+			"user_id": userID.String(),
+		})
+		return pkgErrors.NewNotFound("user not found")
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(req.Password)); err != nil {
+		uc.logger.Warn(ctx, "2FA disable attempt with invalid password", pkgLogger.Tags{
+			"user_id": userID.String(),
+		})
+		return pkgErrors.NewUnauthorized("password is incorrect")
+	}
+
+	if !user.TwoFactorEnabled {
+		return pkgErrors.NewBadRequest("two-factor authentication is not enabled")
+	}
+
+	user.TwoFactorEnabled = false
+	user.TwoFactorSecret = ""
+	if err := uc.userRepo.Update(ctx, user); err != nil {
+		uc.logger.Error(ctx, err, "Failed to disable 2FA", pkgLogger.Tags{
+			"user_id": userID.String(),
+		})
+		return pkgErrors.NewInternalServerError("failed to disable two-factor authentication")
+	}
+
+	publishSecurityEvent(ctx, uc.eventPublisher, uc.logger, &domain.SecurityEvent{
+		Type:           domain.SecurityEventTwoFactorDisabled,
+		UserID:         user.ID,
+		OrganizationID: user.OrganizationID,
+		Email:          user.Email,
+		IPAddress:      req.IPAddress,
+		UserAgent:      req.UserAgent,
+	})
+
+	uc.logger.Info(ctx, "Two-factor authentication disabled", pkgLogger.Tags{
+		"user_id": userID.String(),
+	})
+
+	return nil
+}
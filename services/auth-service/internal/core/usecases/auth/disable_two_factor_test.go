@@ -0,0 +1,83 @@
+package auth
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/domain"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/providers"
+)
+
+func TestDisableTwoFactorUseCase_Execute_WithValidPassword_PublishesTwoFactorDisabled(t *testing.T) {
+	// Given
+	givenUserID := uuid.New()
+	givenOrgID := uuid.New()
+	givenHashedPassword, _ := bcrypt.GenerateFromPassword([]byte("Password1!"), bcrypt.DefaultCost)
+
+	givenUser := &domain.User{
+		ID:               givenUserID,
+		Email:            "user@example.com",
+		Password:         string(givenHashedPassword),
+		OrganizationID:   givenOrgID,
+		TwoFactorEnabled: true,
+		TwoFactorSecret:  "JBSWY3DPEHPK3PXP",
+	}
+
+	mockUserRepo := new(providers.MockUserRepository)
+	mockPublisher := new(providers.MockSecurityEventPublisher)
+	mockLogger := new(providers.MockLogger)
+
+	useCase := NewDisableTwoFactorUseCase(mockUserRepo, mockPublisher, mockLogger)
+
+	mockUserRepo.On("GetByID", mock.Anything, givenUserID).Return(givenUser, nil)
+	mockUserRepo.On("Update", mock.Anything, mock.MatchedBy(func(user *domain.User) bool {
+		return !user.TwoFactorEnabled && user.TwoFactorSecret == ""
+	})).Return(nil)
+	mockPublisher.On("PublishSecurityEvent", mock.Anything, mock.MatchedBy(func(event *domain.SecurityEvent) bool {
+		return event.Type == domain.SecurityEventTwoFactorDisabled && event.OrganizationID == givenOrgID
+	})).Return(nil)
+	mockLogger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	// When
+	err := useCase.Execute(context.Background(), givenUserID, &domain.DisableTwoFactorRequest{Password: "Password1!"})
+
+	// Then
+	assert.NoError(t, err)
+	mockUserRepo.AssertExpectations(t)
+	mockPublisher.AssertExpectations(t)
+}
+
+func TestDisableTwoFactorUseCase_Execute_WithWrongPassword_ReturnsUnauthorized(t *testing.T) {
+	// Given
+	givenUserID := uuid.New()
+	givenHashedPassword, _ := bcrypt.GenerateFromPassword([]byte("Password1!"), bcrypt.DefaultCost)
+
+	givenUser := &domain.User{
+		ID:               givenUserID,
+		Password:         string(givenHashedPassword),
+		TwoFactorEnabled: true,
+	}
+
+	mockUserRepo := new(providers.MockUserRepository)
+	mockPublisher := new(providers.MockSecurityEventPublisher)
+	mockLogger := new(providers.MockLogger)
+
+	useCase := NewDisableTwoFactorUseCase(mockUserRepo, mockPublisher, mockLogger)
+
+	mockUserRepo.On("GetByID", mock.Anything, givenUserID).Return(givenUser, nil)
+	mockLogger.On("Warn", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	// When
+	err := useCase.Execute(context.Background(), givenUserID, &domain.DisableTwoFactorRequest{Password: "wrong"})
+
+	// Then
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "password is incorrect")
+	mockUserRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	mockPublisher.AssertNotCalled(t, "PublishSecurityEvent", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,291 @@
+package auth
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+
+	pkgErrors "github.com/giia/giia-core-engine/pkg/errors"
+	pkgLogger "github.com/giia/giia-core-engine/pkg/logger"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/domain"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/providers"
+)
+
+// emailChangeTTL is how long both addresses have to confirm an email change.
+const emailChangeTTL = 24 * time.Hour
+
+type StartEmailChangeUseCase struct {
+	userRepo        providers.UserRepository
+	emailChangeRepo providers.EmailChangeRepository
+	emailService    providers.EmailService
+	logger          pkgLogger.Logger
+}
+
+func NewStartEmailChangeUseCase(
+	userRepo providers.UserRepository,
+	emailChangeRepo providers.EmailChangeRepository,
+	emailService providers.EmailService,
+	logger pkgLogger.Logger,
+) *StartEmailChangeUseCase {
+	return &StartEmailChangeUseCase{
+		userRepo:        userRepo,
+		emailChangeRepo: emailChangeRepo,
+		emailService:    emailService,
+		logger:          logger,
+	}
+}
+
+// Execute re-checks the password and emails a confirmation link to both the
+// current and the new address. Starting a new change cancels any pending one.
+func (uc *StartEmailChangeUseCase) Execute(ctx context.Context, userID uuid.UUID, req *domain.StartEmailChangeRequest) (*domain.EmailChangeResponse, error) {
+	newEmail := strings.ToLower(strings.TrimSpace(req.NewEmail))
+	if newEmail == "" {
+		return nil, pkgErrors.NewBadRequest("new email is required")
+	}
+
+	if req.CurrentPassword == "" {
+		return nil, pkgErrors.NewBadRequest("current password is required")
+	}
+
+	user, err := uc.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		uc.logger.Error(ctx, err, "Failed to get user", pkgLogger.Tags{
+			"user_id": userID.String(),
+		})
+		return nil, pkgErrors.NewNotFound("user not found")
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(req.CurrentPassword)); err != nil {
+		uc.logger.Warn(ctx, "Email change attempt with invalid current password", pkgLogger.Tags{
+			"user_id": userID.String(),
+		})
+		return nil, pkgErrors.NewUnauthorized("current password is incorrect")
+	}
+
+	if newEmail == strings.ToLower(user.Email) {
+		return nil, pkgErrors.NewBadRequest("new email must be different from the current email")
+	}
+
+	// Login looks users up by email across organizations, so the address must
+	// be unique globally.
+	if existing, err := uc.userRepo.GetByEmail(ctx, newEmail); err == nil && existing != nil {
+		return nil, pkgErrors.NewConflict("email is already in use")
+	}
+
+	oldToken, err := generateChallengeToken()
+	if err != nil {
+		uc.logger.Error(ctx, err, "Failed to generate email change token", pkgLogger.Tags{
+			"user_id": userID.String(),
+		})
+		return nil, pkgErrors.NewInternalServerError("failed to start email change")
+	}
+
+	newToken, err := generateChallengeToken()
+	if err != nil {
+		uc.logger.Error(ctx, err, "Failed to generate email change token", pkgLogger.Tags{
+			"user_id": userID.String(),
+		})
+		return nil, pkgErrors.NewInternalServerError("failed to start email change")
+	}
+
+	if err := uc.emailChangeRepo.CancelPending(ctx, user.ID); err != nil {
+		uc.logger.Error(ctx, err, "Failed to cancel pending email changes", pkgLogger.Tags{
+			"user_id": userID.String(),
+		})
+		return nil, pkgErrors.NewInternalServerError("failed to start email change")
+	}
+
+	now := time.Now().UTC()
+	request := &domain.EmailChangeRequest{
+		ID:             uuid.New(),
+		UserID:         user.ID,
+		OrganizationID: user.OrganizationID,
+		OldEmail:       user.Email,
+		NewEmail:       newEmail,
+		OldTokenHash:   hashToken(oldToken),
+		NewTokenHash:   hashToken(newToken),
+		Status:         domain.EmailChangeStatusPending,
+		ExpiresAt:      now.Add(emailChangeTTL),
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+
+	if err := uc.emailChangeRepo.Create(ctx, request); err != nil {
+		uc.logger.Error(ctx, err, "Failed to create email change request", pkgLogger.Tags{
+			"user_id": userID.String(),
+		})
+		return nil, pkgErrors.NewInternalServerError("failed to start email change")
+	}
+
+	if err := uc.emailService.SendEmailChangeConfirmationEmail(ctx, user.Email, oldToken, user.FirstName, newEmail); err != nil {
+		uc.logger.Error(ctx, err, "Failed to send email change confirmation to current address", pkgLogger.Tags{
+			"user_id": userID.String(),
+		})
+		return nil, pkgErrors.NewInternalServerError("failed to send email change confirmation")
+	}
+
+	if err := uc.emailService.SendEmailChangeConfirmationEmail(ctx, newEmail, newToken, user.FirstName, newEmail); err != nil {
+		uc.logger.Error(ctx, err, "Failed to send email change confirmation to new address", pkgLogger.Tags{
+			"user_id": userID.String(),
+		})
+		return nil, pkgErrors.NewInternalServerError("failed to send email change confirmation")
+	}
+
+	uc.logger.Info(ctx, "Email change requested", pkgLogger.Tags{
+		"user_id":         userID.String(),
+		"email_change_id": request.ID.String(),
+		"ip_address":      req.IPAddress,
+	})
+
+	return request.ToResponse(), nil
+}
+
+type ConfirmEmailChangeUseCase struct {
+	userRepo        providers.UserRepository
+	emailChangeRepo providers.EmailChangeRepository
+	tokenRepo       providers.TokenRepository
+	jwtManager      providers.JWTManager
+	userEvents      providers.UserEventPublisher
+	recoveryWindow  time.Duration
+	logger          pkgLogger.Logger
+}
+
+// NewConfirmEmailChangeUseCase keeps the previous email as a recovery contact
+// for recoveryWindow after the change completes.
+func NewConfirmEmailChangeUseCase(
+	userRepo providers.UserRepository,
+	emailChangeRepo providers.EmailChangeRepository,
+	tokenRepo providers.TokenRepository,
+	jwtManager providers.JWTManager,
+	userEvents providers.UserEventPublisher,
+	recoveryWindow time.Duration,
+	logger pkgLogger.Logger,
+) *ConfirmEmailChangeUseCase {
+	return &ConfirmEmailChangeUseCase{
+		userRepo:        userRepo,
+		emailChangeRepo: emailChangeRepo,
+		tokenRepo:       tokenRepo,
+		jwtManager:      jwtManager,
+		userEvents:      userEvents,
+		recoveryWindow:  recoveryWindow,
+		logger:          logger,
+	}
+}
+
+// Execute records the confirmation of whichever address the token was sent
+// to. When both have confirmed it switches the login email, signs out every
+// session and publishes auth.user.email_changed.
+func (uc *ConfirmEmailChangeUseCase) Execute(ctx context.Context, req *domain.ConfirmEmailChangeRequest) (*domain.EmailChangeResponse, error) {
+	if req.Token == "" {
+		return nil, pkgErrors.NewBadRequest("email change token is required")
+	}
+
+	tokenHash := hashToken(req.Token)
+	request, err := uc.emailChangeRepo.GetByTokenHash(ctx, tokenHash)
+	if err != nil {
+		return nil, pkgErrors.NewUnauthorized("invalid or expired email change link")
+	}
+
+	now := time.Now().UTC()
+	if !request.IsPending(now) {
+		return nil, pkgErrors.NewUnauthorized("invalid or expired email change link")
+	}
+
+	if tokenHash == request.OldTokenHash {
+		if request.OldConfirmedAt == nil {
+			request.OldConfirmedAt = &now
+		}
+	} else if request.NewConfirmedAt == nil {
+		request.NewConfirmedAt = &now
+	}
+	request.UpdatedAt = now
+
+	if !request.BothConfirmed() {
+		if err := uc.emailChangeRepo.Update(ctx, request); err != nil {
+			uc.logger.Error(ctx, err, "Failed to record email change confirmation", pkgLogger.Tags{
+				"email_change_id": request.ID.String(),
+			})
+			return nil, pkgErrors.NewInternalServerError("failed to confirm email change")
+		}
+		return request.ToResponse(), nil
+	}
+
+	if err := uc.complete(ctx, request, now); err != nil {
+		return nil, err
+	}
+
+	return request.ToResponse(), nil
+}
+
+func (uc *ConfirmEmailChangeUseCase) complete(ctx context.Context, request *domain.EmailChangeRequest, now time.Time) error {
+	user, err := uc.userRepo.GetByID(ctx, request.UserID)
+	if err != nil {
+		uc.logger.Error(ctx, err, "Failed to get user for email change", pkgLogger.Tags{
+			"user_id": request.UserID.String(),
+		})
+		return pkgErrors.NewNotFound("user not found")
+	}
+
+	// The address may have been taken while the confirmations were pending.
+	if existing, err := uc.userRepo.GetByEmail(ctx, request.NewEmail); err == nil && existing != nil && existing.ID != user.ID {
+		return pkgErrors.NewConflict("email is already in use")
+	}
+
+	recoveryExpiresAt := now.Add(uc.recoveryWindow)
+	user.RecoveryEmail = user.Email
+	user.RecoveryEmailExpiresAt = &recoveryExpiresAt
+	user.Email = request.NewEmail
+	user.UpdatedAt = now
+
+	if err := uc.userRepo.Update(ctx, user); err != nil {
+		uc.logger.Error(ctx, err, "Failed to update user email", pkgLogger.Tags{
+			"user_id": user.ID.String(),
+		})
+		return pkgErrors.NewInternalServerError("failed to confirm email change")
+	}
+
+	request.Status = domain.EmailChangeStatusCompleted
+	request.CompletedAt = &now
+	if err := uc.emailChangeRepo.Update(ctx, request); err != nil {
+		uc.logger.Error(ctx, err, "Failed to mark email change completed", pkgLogger.Tags{
+			"email_change_id": request.ID.String(),
+		})
+	}
+
+	if err := uc.tokenRepo.RevokeAllUserTokens(ctx, user.ID); err != nil {
+		uc.logger.Error(ctx, err, "Failed to revoke refresh tokens after email change", pkgLogger.Tags{
+			"user_id": user.ID.String(),
+		})
+	}
+
+	// Access tokens carry the old email until they expire; the cutoff rejects
+	// them right away.
+	if err := uc.tokenRepo.RevokeUserSessions(ctx, user.ID, now, uc.jwtManager.GetAccessExpiry()); err != nil {
+		uc.logger.Error(ctx, err, "Failed to revoke access tokens after email change", pkgLogger.Tags{
+			"user_id": user.ID.String(),
+		})
+	}
+
+	if err := uc.userEvents.PublishEmailChanged(ctx, &domain.EmailChangedEvent{
+		UserID:                 user.ID,
+		OrganizationID:         user.OrganizationID,
+		OldEmail:               request.OldEmail,
+		NewEmail:               request.NewEmail,
+		RecoveryEmailExpiresAt: recoveryExpiresAt,
+		OccurredAt:             now,
+	}); err != nil {
+		uc.logger.Error(ctx, err, "Failed to publish email changed event", pkgLogger.Tags{
+			"user_id": user.ID.String(),
+		})
+	}
+
+	uc.logger.Info(ctx, "Email changed", pkgLogger.Tags{
+		"user_id":         user.ID.String(),
+		"email_change_id": request.ID.String(),
+	})
+
+	return nil
+}
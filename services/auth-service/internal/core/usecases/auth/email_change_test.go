@@ -0,0 +1,194 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/domain"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/providers"
+)
+
+func TestStartEmailChangeUseCase_Execute_WithValidRequest_SendsLinkToBothAddresses(t *testing.T) {
+	// Given
+	givenHashedPassword, _ := bcrypt.GenerateFromPassword([]byte("Password1!"), bcrypt.DefaultCost)
+	givenUser := &domain.User{ID: uuid.New(), OrganizationID: uuid.New(), Email: "ana@old.com", FirstName: "Ana", Password: string(givenHashedPassword)}
+
+	mockUserRepo := new(providers.MockUserRepository)
+	mockEmailChangeRepo := new(providers.MockEmailChangeRepository)
+	mockEmailService := new(providers.MockEmailService)
+	mockLogger := new(providers.MockLogger)
+
+	useCase := NewStartEmailChangeUseCase(mockUserRepo, mockEmailChangeRepo, mockEmailService, mockLogger)
+
+	var oldToken, newToken string
+	mockUserRepo.On("GetByID", mock.Anything, givenUser.ID).Return(givenUser, nil)
+	mockUserRepo.On("GetByEmail", mock.Anything, "ana@new.com").Return(nil, errors.New("record not found"))
+	mockEmailChangeRepo.On("CancelPending", mock.Anything, givenUser.ID).Return(nil)
+	mockEmailChangeRepo.On("Create", mock.Anything, mock.AnythingOfType("*domain.EmailChangeRequest")).Return(nil)
+	mockEmailService.On("SendEmailChangeConfirmationEmail", mock.Anything, "ana@old.com", mock.AnythingOfType("string"), "Ana", "ana@new.com").
+		Run(func(args mock.Arguments) { oldToken = args.String(2) }).Return(nil)
+	mockEmailService.On("SendEmailChangeConfirmationEmail", mock.Anything, "ana@new.com", mock.AnythingOfType("string"), "Ana", "ana@new.com").
+		Run(func(args mock.Arguments) { newToken = args.String(2) }).Return(nil)
+	mockLogger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	// When
+	response, err := useCase.Execute(context.Background(), givenUser.ID, &domain.StartEmailChangeRequest{NewEmail: " Ana@New.com ", CurrentPassword: "Password1!"})
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, domain.EmailChangeStatusPending, response.Status)
+	assert.Equal(t, "ana@new.com", response.NewEmail)
+	assert.NotEqual(t, oldToken, newToken)
+	mockEmailChangeRepo.AssertCalled(t, "Create", mock.Anything, mock.MatchedBy(func(r *domain.EmailChangeRequest) bool {
+		return r.OldTokenHash == hashToken(oldToken) && r.NewTokenHash == hashToken(newToken) && r.OldEmail == "ana@old.com"
+	}))
+	assert.Equal(t, "ana@old.com", givenUser.Email)
+}
+
+func TestStartEmailChangeUseCase_Execute_WithEmailInUse_ReturnsConflict(t *testing.T) {
+	// Given
+	givenHashedPassword, _ := bcrypt.GenerateFromPassword([]byte("Password1!"), bcrypt.DefaultCost)
+	givenUser := &domain.User{ID: uuid.New(), OrganizationID: uuid.New(), Email: "ana@old.com", Password: string(givenHashedPassword)}
+
+	mockUserRepo := new(providers.MockUserRepository)
+	mockEmailChangeRepo := new(providers.MockEmailChangeRepository)
+	mockEmailService := new(providers.MockEmailService)
+	mockLogger := new(providers.MockLogger)
+
+	useCase := NewStartEmailChangeUseCase(mockUserRepo, mockEmailChangeRepo, mockEmailService, mockLogger)
+
+	mockUserRepo.On("GetByID", mock.Anything, givenUser.ID).Return(givenUser, nil)
+	mockUserRepo.On("GetByEmail", mock.Anything, "taken@acme.com").Return(&domain.User{ID: uuid.New()}, nil)
+
+	// When
+	response, err := useCase.Execute(context.Background(), givenUser.ID, &domain.StartEmailChangeRequest{NewEmail: "taken@acme.com", CurrentPassword: "Password1!"})
+
+	// Then
+	assert.Error(t, err)
+	assert.Nil(t, response)
+	assert.Contains(t, err.Error(), "email is already in use")
+	mockEmailChangeRepo.AssertNotCalled(t, "Create")
+}
+
+func TestConfirmEmailChangeUseCase_Execute_WithOnlyOldAddressConfirmed_KeepsEmail(t *testing.T) {
+	// Given
+	givenRequest := &domain.EmailChangeRequest{
+		ID:           uuid.New(),
+		UserID:       uuid.New(),
+		NewEmail:     "ana@new.com",
+		OldTokenHash: hashToken("old-token"),
+		NewTokenHash: hashToken("new-token"),
+		Status:       domain.EmailChangeStatusPending,
+		ExpiresAt:    time.Now().Add(time.Hour),
+	}
+
+	mockUserRepo := new(providers.MockUserRepository)
+	mockEmailChangeRepo := new(providers.MockEmailChangeRepository)
+	mockTokenRepo := new(providers.MockTokenRepository)
+	mockJWTManager := new(providers.MockJWTManager)
+	mockUserEvents := new(providers.MockUserEventPublisher)
+	mockLogger := new(providers.MockLogger)
+
+	useCase := NewConfirmEmailChangeUseCase(mockUserRepo, mockEmailChangeRepo, mockTokenRepo, mockJWTManager, mockUserEvents, 30*24*time.Hour, mockLogger)
+
+	mockEmailChangeRepo.On("GetByTokenHash", mock.Anything, hashToken("old-token")).Return(givenRequest, nil)
+	mockEmailChangeRepo.On("Update", mock.Anything, givenRequest).Return(nil)
+
+	// When
+	response, err := useCase.Execute(context.Background(), &domain.ConfirmEmailChangeRequest{Token: "old-token"})
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, domain.EmailChangeStatusPending, response.Status)
+	assert.True(t, response.OldEmailConfirmed)
+	assert.False(t, response.NewEmailConfirmed)
+	mockUserRepo.AssertNotCalled(t, "Update")
+	mockUserEvents.AssertNotCalled(t, "PublishEmailChanged")
+}
+
+func TestConfirmEmailChangeUseCase_Execute_WithBothConfirmed_ChangesEmailAndKeepsRecoveryContact(t *testing.T) {
+	// Given
+	confirmedAt := time.Now().Add(-time.Minute)
+	givenUser := &domain.User{ID: uuid.New(), OrganizationID: uuid.New(), Email: "ana@old.com"}
+	givenRequest := &domain.EmailChangeRequest{
+		ID:             uuid.New(),
+		UserID:         givenUser.ID,
+		OldEmail:       "ana@old.com",
+		NewEmail:       "ana@new.com",
+		OldTokenHash:   hashToken("old-token"),
+		NewTokenHash:   hashToken("new-token"),
+		OldConfirmedAt: &confirmedAt,
+		Status:         domain.EmailChangeStatusPending,
+		ExpiresAt:      time.Now().Add(time.Hour),
+	}
+
+	mockUserRepo := new(providers.MockUserRepository)
+	mockEmailChangeRepo := new(providers.MockEmailChangeRepository)
+	mockTokenRepo := new(providers.MockTokenRepository)
+	mockJWTManager := new(providers.MockJWTManager)
+	mockUserEvents := new(providers.MockUserEventPublisher)
+	mockLogger := new(providers.MockLogger)
+
+	useCase := NewConfirmEmailChangeUseCase(mockUserRepo, mockEmailChangeRepo, mockTokenRepo, mockJWTManager, mockUserEvents, 30*24*time.Hour, mockLogger)
+
+	mockEmailChangeRepo.On("GetByTokenHash", mock.Anything, hashToken("new-token")).Return(givenRequest, nil)
+	mockUserRepo.On("GetByID", mock.Anything, givenUser.ID).Return(givenUser, nil)
+	mockUserRepo.On("GetByEmail", mock.Anything, "ana@new.com").Return(nil, errors.New("record not found"))
+	mockUserRepo.On("Update", mock.Anything, givenUser).Return(nil)
+	mockEmailChangeRepo.On("Update", mock.Anything, givenRequest).Return(nil)
+	mockTokenRepo.On("RevokeAllUserTokens", mock.Anything, givenUser.ID).Return(nil)
+	mockJWTManager.On("GetAccessExpiry").Return(15 * time.Minute)
+	mockTokenRepo.On("RevokeUserSessions", mock.Anything, givenUser.ID, mock.AnythingOfType("time.Time"), 15*time.Minute).Return(nil)
+	mockUserEvents.On("PublishEmailChanged", mock.Anything, mock.MatchedBy(func(e *domain.EmailChangedEvent) bool {
+		return e.OldEmail == "ana@old.com" && e.NewEmail == "ana@new.com"
+	})).Return(nil)
+	mockLogger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	// When
+	response, err := useCase.Execute(context.Background(), &domain.ConfirmEmailChangeRequest{Token: "new-token"})
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, domain.EmailChangeStatusCompleted, response.Status)
+	assert.Equal(t, "ana@new.com", givenUser.Email)
+	assert.Equal(t, "ana@old.com", givenUser.ActiveRecoveryEmail(time.Now()))
+	assert.Empty(t, givenUser.ActiveRecoveryEmail(time.Now().Add(31*24*time.Hour)))
+	mockTokenRepo.AssertExpectations(t)
+	mockUserEvents.AssertExpectations(t)
+}
+
+func TestConfirmEmailChangeUseCase_Execute_WithExpiredRequest_ReturnsUnauthorized(t *testing.T) {
+	// Given
+	givenRequest := &domain.EmailChangeRequest{
+		OldTokenHash: hashToken("old-token"),
+		Status:       domain.EmailChangeStatusPending,
+		ExpiresAt:    time.Now().Add(-time.Minute),
+	}
+
+	mockUserRepo := new(providers.MockUserRepository)
+	mockEmailChangeRepo := new(providers.MockEmailChangeRepository)
+	mockTokenRepo := new(providers.MockTokenRepository)
+	mockJWTManager := new(providers.MockJWTManager)
+	mockUserEvents := new(providers.MockUserEventPublisher)
+	mockLogger := new(providers.MockLogger)
+
+	useCase := NewConfirmEmailChangeUseCase(mockUserRepo, mockEmailChangeRepo, mockTokenRepo, mockJWTManager, mockUserEvents, 30*24*time.Hour, mockLogger)
+
+	mockEmailChangeRepo.On("GetByTokenHash", mock.Anything, hashToken("old-token")).Return(givenRequest, nil)
+
+	// When
+	response, err := useCase.Execute(context.Background(), &domain.ConfirmEmailChangeRequest{Token: "old-token"})
+
+	// Then
+	assert.Error(t, err)
+	assert.Nil(t, response)
+	assert.Contains(t, err.Error(), "invalid or expired email change link")
+	mockEmailChangeRepo.AssertNotCalled(t, "Update")
+}
@@ -15,23 +15,36 @@ import (
 )
 
 type LoginUseCase struct {
-	userRepo   providers.UserRepository
-	tokenRepo  providers.TokenRepository
-	jwtManager providers.JWTManager
-	logger     pkgLogger.Logger
+	userRepo       providers.UserRepository
+	tokenRepo      providers.TokenRepository
+	geoResolver    providers.GeoIPResolver
+	eventPublisher providers.SecurityEventPublisher
+	sessions       *sessionIssuer
+	logger         pkgLogger.Logger
 }
 
 func NewLoginUseCase(
 	userRepo providers.UserRepository,
 	tokenRepo providers.TokenRepository,
 	jwtManager providers.JWTManager,
+	geoResolver providers.GeoIPResolver,
+	eventPublisher providers.SecurityEventPublisher,
+	permissions providers.PermissionResolver,
 	logger pkgLogger.Logger,
 ) *LoginUseCase {
 	return &LoginUseCase{
-		userRepo:   userRepo,
-		tokenRepo:  tokenRepo,
-		jwtManager: jwtManager,
-		logger:     logger,
+		userRepo:       userRepo,
+		tokenRepo:      tokenRepo,
+		geoResolver:    geoResolver,
+		eventPublisher: eventPublisher,
+		sessions: &sessionIssuer{
+			userRepo:    userRepo,
+			tokenRepo:   tokenRepo,
+			jwtManager:  jwtManager,
+			permissions: permissions,
+			logger:      logger,
+		},
+		logger: logger,
 	}
 }
 
@@ -69,59 +82,62 @@ func (uc *LoginUseCase) Execute(ctx context.Context, req *domain.LoginRequest) (
 		return nil, pkgErrors.NewForbidden("account is not active")
 	}
 
-	accessToken, err := uc.jwtManager.GenerateAccessToken(
-		user.ID,
-		user.OrganizationID,
-		user.Email,
-		nil,
-	)
-	if err != nil {
-		uc.logger.Error(ctx, err, "Failed to generate access token", pkgLogger.Tags{
-			"user_id": user.ID.String(),
-		})
-		return nil, pkgErrors.NewInternalServerError("failed to generate access token")
+	lc := assessLogin(ctx, uc.tokenRepo, uc.geoResolver, uc.logger, user, req.IPAddress, req.UserAgent)
+	if lc.suspicious() {
+		publishSuspiciousLogin(ctx, uc.eventPublisher, uc.logger, user, lc)
+
+		// Users without a second factor are notified but not blocked
+		if user.TwoFactorEnabled {
+			return requireStepUp(ctx, uc.tokenRepo, uc.logger, user, lc)
+		}
 	}
 
-	refreshTokenString, err := uc.jwtManager.GenerateRefreshToken(user.ID)
+	return uc.sessions.issue(ctx, user, lc)
+}
+
+// requireStepUp parks the login as a challenge that must be completed with a
+// second factor before a session is issued.
+func requireStepUp(
+	ctx context.Context,
+	tokenRepo providers.TokenRepository,
+	logger pkgLogger.Logger,
+	user *domain.User,
+	lc *loginContext,
+) (*domain.LoginResponse, error) {
+	challengeToken, err := generateChallengeToken()
 	if err != nil {
-		uc.logger.Error(ctx, err, "Failed to generate refresh token", pkgLogger.Tags{
+		logger.Error(ctx, err, "Failed to generate login challenge", pkgLogger.Tags{
 			"user_id": user.ID.String(),
 		})
-		return nil, pkgErrors.NewInternalServerError("failed to generate refresh token")
+		return nil, pkgErrors.NewInternalServerError("failed to start verification")
 	}
 
-	tokenHash := hashToken(refreshTokenString)
-	refreshToken := &domain.RefreshToken{
-		TokenHash: tokenHash,
+	challenge := &domain.LoginChallenge{
 		UserID:    user.ID,
-		ExpiresAt: time.Now().Add(uc.jwtManager.GetRefreshExpiry()),
-		Revoked:   false,
-	}
-
-	if err := uc.tokenRepo.StoreRefreshToken(ctx, refreshToken); err != nil {
-		uc.logger.Error(ctx, err, "Failed to store refresh token", pkgLogger.Tags{
-			"user_id": user.ID.String(),
-		})
-		return nil, pkgErrors.NewInternalServerError("failed to store refresh token")
+		DeviceID:  lc.deviceID,
+		IPAddress: lc.ipAddress,
+		UserAgent: lc.userAgent,
+		Location:  lc.location,
+		Reasons:   lc.reasons,
+		CreatedAt: time.Now().UTC(),
 	}
 
-	if err := uc.userRepo.UpdateLastLogin(ctx, user.ID); err != nil {
-		uc.logger.Error(ctx, err, "Failed to update last login", pkgLogger.Tags{
+	if err := tokenRepo.StoreLoginChallenge(ctx, hashToken(challengeToken), challenge, loginChallengeTTL); err != nil {
+		logger.Error(ctx, err, "Failed to store login challenge", pkgLogger.Tags{
 			"user_id": user.ID.String(),
 		})
+		return nil, pkgErrors.NewInternalServerError("failed to start verification")
 	}
 
-	uc.logger.Info(ctx, "User logged in successfully", pkgLogger.Tags{
-		"user_id":         user.ID.String(),
-		"email":           user.Email,
-		"organization_id": user.OrganizationID.String(),
+	logger.Warn(ctx, "Suspicious login requires step-up verification", pkgLogger.Tags{
+		"user_id":    user.ID.String(),
+		"ip_address": lc.ipAddress,
 	})
 
 	return &domain.LoginResponse{
-		AccessToken:  accessToken,
-		RefreshToken: refreshTokenString,
-		ExpiresIn:    int(uc.jwtManager.GetAccessExpiry().Seconds()),
-		User:         user.ToResponse(),
+		StepUpRequired: true,
+		ChallengeToken: challengeToken,
+		StepUpReasons:  lc.reasons,
 	}, nil
 }
 
@@ -38,12 +38,14 @@ func TestLoginUseCase_Execute_WithValidCredentials_ReturnsTokens(t *testing.T) {
 	mockUserRepo := new(providers.MockUserRepository)
 	mockTokenRepo := new(providers.MockTokenRepository)
 	mockJWTManager := new(providers.MockJWTManager)
+	mockGeoResolver := new(providers.MockGeoIPResolver)
+	mockPublisher := new(providers.MockSecurityEventPublisher)
 	mockLogger := new(providers.MockLogger)
 
-	useCase := NewLoginUseCase(mockUserRepo, mockTokenRepo, mockJWTManager, mockLogger)
+	useCase := NewLoginUseCase(mockUserRepo, mockTokenRepo, mockJWTManager, mockGeoResolver, mockPublisher, nil, mockLogger)
 
 	mockUserRepo.On("GetByEmail", mock.Anything, givenEmail).Return(givenUser, nil)
-	mockJWTManager.On("GenerateAccessToken", givenUserID, givenOrgID, givenEmail, mock.Anything).Return("access_token", nil)
+	mockJWTManager.On("GenerateAccessToken", givenUserID, givenOrgID, givenEmail, mock.Anything, mock.Anything).Return("access_token", nil)
 	mockJWTManager.On("GenerateRefreshToken", givenUserID).Return("refresh_token", nil)
 	mockJWTManager.On("GetRefreshExpiry").Return(7 * 24 * time.Hour)
 	mockJWTManager.On("GetAccessExpiry").Return(15 * time.Minute)
@@ -65,6 +67,85 @@ func TestLoginUseCase_Execute_WithValidCredentials_ReturnsTokens(t *testing.T) {
 	mockJWTManager.AssertExpectations(t)
 }
 
+func givenActiveLoginUser(password string) *domain.User {
+	hashedPassword, _ := bcrypt.GenerateFromPassword([]byte(password), bcrypt.MinCost)
+	return &domain.User{
+		ID:             uuid.New(),
+		Email:          "planner@acme.com",
+		Password:       string(hashedPassword),
+		Status:         domain.UserStatusActive,
+		OrganizationID: uuid.New(),
+	}
+}
+
+func TestLoginUseCase_Execute_WithPermissionResolver_EmbedsPermissionsInAccessToken(t *testing.T) {
+	// Given
+	givenUser := givenActiveLoginUser("password123")
+	givenPermissions := []string{"ddmrp:buffers:read", "execution:po:approve"}
+
+	mockUserRepo := new(providers.MockUserRepository)
+	mockTokenRepo := new(providers.MockTokenRepository)
+	mockJWTManager := new(providers.MockJWTManager)
+	mockGeoResolver := new(providers.MockGeoIPResolver)
+	mockPublisher := new(providers.MockSecurityEventPublisher)
+	mockPermissions := new(providers.MockPermissionResolver)
+	mockLogger := new(providers.MockLogger)
+
+	useCase := NewLoginUseCase(mockUserRepo, mockTokenRepo, mockJWTManager, mockGeoResolver, mockPublisher, mockPermissions, mockLogger)
+
+	mockUserRepo.On("GetByEmail", mock.Anything, givenUser.Email).Return(givenUser, nil)
+	mockPermissions.On("ResolvePermissions", mock.Anything, givenUser.ID, givenUser.OrganizationID).Return(givenPermissions, nil)
+	mockJWTManager.On("GenerateAccessToken", givenUser.ID, givenUser.OrganizationID, givenUser.Email, mock.Anything, givenPermissions).Return("access_token", nil)
+	mockJWTManager.On("GenerateRefreshToken", givenUser.ID).Return("refresh_token", nil)
+	mockJWTManager.On("GetRefreshExpiry").Return(7 * 24 * time.Hour)
+	mockJWTManager.On("GetAccessExpiry").Return(15 * time.Minute)
+	mockTokenRepo.On("StoreRefreshToken", mock.Anything, mock.AnythingOfType("*domain.RefreshToken")).Return(nil)
+	mockUserRepo.On("UpdateLastLogin", mock.Anything, givenUser.ID).Return(nil)
+	mockLogger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	// When
+	response, err := useCase.Execute(context.Background(), &domain.LoginRequest{Email: givenUser.Email, Password: "password123"})
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, "access_token", response.AccessToken)
+	mockJWTManager.AssertExpectations(t)
+}
+
+func TestLoginUseCase_Execute_WithPermissionResolverError_IssuesTokenWithoutPermissions(t *testing.T) {
+	// Given
+	givenUser := givenActiveLoginUser("password123")
+
+	mockUserRepo := new(providers.MockUserRepository)
+	mockTokenRepo := new(providers.MockTokenRepository)
+	mockJWTManager := new(providers.MockJWTManager)
+	mockGeoResolver := new(providers.MockGeoIPResolver)
+	mockPublisher := new(providers.MockSecurityEventPublisher)
+	mockPermissions := new(providers.MockPermissionResolver)
+	mockLogger := new(providers.MockLogger)
+
+	useCase := NewLoginUseCase(mockUserRepo, mockTokenRepo, mockJWTManager, mockGeoResolver, mockPublisher, mockPermissions, mockLogger)
+
+	mockUserRepo.On("GetByEmail", mock.Anything, givenUser.Email).Return(givenUser, nil)
+	mockPermissions.On("ResolvePermissions", mock.Anything, givenUser.ID, givenUser.OrganizationID).Return(nil, assert.AnError)
+	mockJWTManager.On("GenerateAccessToken", givenUser.ID, givenUser.OrganizationID, givenUser.Email, mock.Anything, []string(nil)).Return("access_token", nil)
+	mockJWTManager.On("GenerateRefreshToken", givenUser.ID).Return("refresh_token", nil)
+	mockJWTManager.On("GetRefreshExpiry").Return(7 * 24 * time.Hour)
+	mockJWTManager.On("GetAccessExpiry").Return(15 * time.Minute)
+	mockTokenRepo.On("StoreRefreshToken", mock.Anything, mock.AnythingOfType("*domain.RefreshToken")).Return(nil)
+	mockUserRepo.On("UpdateLastLogin", mock.Anything, givenUser.ID).Return(nil)
+	mockLogger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	mockLogger.On("Warn", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	// When
+	response, err := useCase.Execute(context.Background(), &domain.LoginRequest{Email: givenUser.Email, Password: "password123"})
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, "access_token", response.AccessToken)
+	mockJWTManager.AssertExpectations(t)
+}
+
 func TestLoginUseCase_Execute_WithEmptyEmail_ReturnsBadRequest(t *testing.T) {
 	// Given
 	givenRequest := &domain.LoginRequest{
@@ -75,9 +156,11 @@ func TestLoginUseCase_Execute_WithEmptyEmail_ReturnsBadRequest(t *testing.T) {
 	mockUserRepo := new(providers.MockUserRepository)
 	mockTokenRepo := new(providers.MockTokenRepository)
 	mockJWTManager := new(providers.MockJWTManager)
+	mockGeoResolver := new(providers.MockGeoIPResolver)
+	mockPublisher := new(providers.MockSecurityEventPublisher)
 	mockLogger := new(providers.MockLogger)
 
-	useCase := NewLoginUseCase(mockUserRepo, mockTokenRepo, mockJWTManager, mockLogger)
+	useCase := NewLoginUseCase(mockUserRepo, mockTokenRepo, mockJWTManager, mockGeoResolver, mockPublisher, nil, mockLogger)
 
 	// When
 	response, err := useCase.Execute(context.Background(), givenRequest)
@@ -98,9 +181,11 @@ func TestLoginUseCase_Execute_WithEmptyPassword_ReturnsBadRequest(t *testing.T)
 	mockUserRepo := new(providers.MockUserRepository)
 	mockTokenRepo := new(providers.MockTokenRepository)
 	mockJWTManager := new(providers.MockJWTManager)
+	mockGeoResolver := new(providers.MockGeoIPResolver)
+	mockPublisher := new(providers.MockSecurityEventPublisher)
 	mockLogger := new(providers.MockLogger)
 
-	useCase := NewLoginUseCase(mockUserRepo, mockTokenRepo, mockJWTManager, mockLogger)
+	useCase := NewLoginUseCase(mockUserRepo, mockTokenRepo, mockJWTManager, mockGeoResolver, mockPublisher, nil, mockLogger)
 
 	// When
 	response, err := useCase.Execute(context.Background(), givenRequest)
@@ -122,9 +207,11 @@ func TestLoginUseCase_Execute_WithNonExistentUser_ReturnsUnauthorized(t *testing
 	mockUserRepo := new(providers.MockUserRepository)
 	mockTokenRepo := new(providers.MockTokenRepository)
 	mockJWTManager := new(providers.MockJWTManager)
+	mockGeoResolver := new(providers.MockGeoIPResolver)
+	mockPublisher := new(providers.MockSecurityEventPublisher)
 	mockLogger := new(providers.MockLogger)
 
-	useCase := NewLoginUseCase(mockUserRepo, mockTokenRepo, mockJWTManager, mockLogger)
+	useCase := NewLoginUseCase(mockUserRepo, mockTokenRepo, mockJWTManager, mockGeoResolver, mockPublisher, nil, mockLogger)
 
 	mockUserRepo.On("GetByEmail", mock.Anything, givenEmail).Return((*domain.User)(nil), assert.AnError)
 	mockLogger.On("Error", mock.Anything, assert.AnError, mock.Anything, mock.Anything).Return()
@@ -164,9 +251,11 @@ func TestLoginUseCase_Execute_WithInvalidPassword_ReturnsUnauthorized(t *testing
 	mockUserRepo := new(providers.MockUserRepository)
 	mockTokenRepo := new(providers.MockTokenRepository)
 	mockJWTManager := new(providers.MockJWTManager)
+	mockGeoResolver := new(providers.MockGeoIPResolver)
+	mockPublisher := new(providers.MockSecurityEventPublisher)
 	mockLogger := new(providers.MockLogger)
 
-	useCase := NewLoginUseCase(mockUserRepo, mockTokenRepo, mockJWTManager, mockLogger)
+	useCase := NewLoginUseCase(mockUserRepo, mockTokenRepo, mockJWTManager, mockGeoResolver, mockPublisher, nil, mockLogger)
 
 	mockUserRepo.On("GetByEmail", mock.Anything, givenEmail).Return(givenUser, nil)
 	mockLogger.On("Warn", mock.Anything, mock.Anything, mock.Anything).Return()
@@ -205,9 +294,11 @@ func TestLoginUseCase_Execute_WithInactiveUser_ReturnsForbidden(t *testing.T) {
 	mockUserRepo := new(providers.MockUserRepository)
 	mockTokenRepo := new(providers.MockTokenRepository)
 	mockJWTManager := new(providers.MockJWTManager)
+	mockGeoResolver := new(providers.MockGeoIPResolver)
+	mockPublisher := new(providers.MockSecurityEventPublisher)
 	mockLogger := new(providers.MockLogger)
 
-	useCase := NewLoginUseCase(mockUserRepo, mockTokenRepo, mockJWTManager, mockLogger)
+	useCase := NewLoginUseCase(mockUserRepo, mockTokenRepo, mockJWTManager, mockGeoResolver, mockPublisher, nil, mockLogger)
 
 	mockUserRepo.On("GetByEmail", mock.Anything, givenEmail).Return(givenUser, nil)
 	mockLogger.On("Warn", mock.Anything, mock.Anything, mock.Anything).Return()
@@ -246,9 +337,11 @@ func TestLoginUseCase_Execute_WithSuspendedUser_ReturnsForbidden(t *testing.T) {
 	mockUserRepo := new(providers.MockUserRepository)
 	mockTokenRepo := new(providers.MockTokenRepository)
 	mockJWTManager := new(providers.MockJWTManager)
+	mockGeoResolver := new(providers.MockGeoIPResolver)
+	mockPublisher := new(providers.MockSecurityEventPublisher)
 	mockLogger := new(providers.MockLogger)
 
-	useCase := NewLoginUseCase(mockUserRepo, mockTokenRepo, mockJWTManager, mockLogger)
+	useCase := NewLoginUseCase(mockUserRepo, mockTokenRepo, mockJWTManager, mockGeoResolver, mockPublisher, nil, mockLogger)
 
 	mockUserRepo.On("GetByEmail", mock.Anything, givenEmail).Return(givenUser, nil)
 	mockLogger.On("Warn", mock.Anything, mock.Anything, mock.Anything).Return()
@@ -287,12 +380,14 @@ func TestLoginUseCase_Execute_WhenAccessTokenGenerationFails_ReturnsError(t *tes
 	mockUserRepo := new(providers.MockUserRepository)
 	mockTokenRepo := new(providers.MockTokenRepository)
 	mockJWTManager := new(providers.MockJWTManager)
+	mockGeoResolver := new(providers.MockGeoIPResolver)
+	mockPublisher := new(providers.MockSecurityEventPublisher)
 	mockLogger := new(providers.MockLogger)
 
-	useCase := NewLoginUseCase(mockUserRepo, mockTokenRepo, mockJWTManager, mockLogger)
+	useCase := NewLoginUseCase(mockUserRepo, mockTokenRepo, mockJWTManager, mockGeoResolver, mockPublisher, nil, mockLogger)
 
 	mockUserRepo.On("GetByEmail", mock.Anything, givenEmail).Return(givenUser, nil)
-	mockJWTManager.On("GenerateAccessToken", givenUserID, givenOrgID, givenEmail, mock.Anything).Return("", assert.AnError)
+	mockJWTManager.On("GenerateAccessToken", givenUserID, givenOrgID, givenEmail, mock.Anything, mock.Anything).Return("", assert.AnError)
 	mockLogger.On("Error", mock.Anything, assert.AnError, mock.Anything, mock.Anything).Return()
 
 	// When
@@ -330,12 +425,14 @@ func TestLoginUseCase_Execute_WhenRefreshTokenGenerationFails_ReturnsError(t *te
 	mockUserRepo := new(providers.MockUserRepository)
 	mockTokenRepo := new(providers.MockTokenRepository)
 	mockJWTManager := new(providers.MockJWTManager)
+	mockGeoResolver := new(providers.MockGeoIPResolver)
+	mockPublisher := new(providers.MockSecurityEventPublisher)
 	mockLogger := new(providers.MockLogger)
 
-	useCase := NewLoginUseCase(mockUserRepo, mockTokenRepo, mockJWTManager, mockLogger)
+	useCase := NewLoginUseCase(mockUserRepo, mockTokenRepo, mockJWTManager, mockGeoResolver, mockPublisher, nil, mockLogger)
 
 	mockUserRepo.On("GetByEmail", mock.Anything, givenEmail).Return(givenUser, nil)
-	mockJWTManager.On("GenerateAccessToken", givenUserID, givenOrgID, givenEmail, mock.Anything).Return("access_token", nil)
+	mockJWTManager.On("GenerateAccessToken", givenUserID, givenOrgID, givenEmail, mock.Anything, mock.Anything).Return("access_token", nil)
 	mockJWTManager.On("GenerateRefreshToken", givenUserID).Return("", assert.AnError)
 	mockLogger.On("Error", mock.Anything, assert.AnError, mock.Anything, mock.Anything).Return()
 
@@ -374,12 +471,14 @@ func TestLoginUseCase_Execute_WhenStoreRefreshTokenFails_ReturnsError(t *testing
 	mockUserRepo := new(providers.MockUserRepository)
 	mockTokenRepo := new(providers.MockTokenRepository)
 	mockJWTManager := new(providers.MockJWTManager)
+	mockGeoResolver := new(providers.MockGeoIPResolver)
+	mockPublisher := new(providers.MockSecurityEventPublisher)
 	mockLogger := new(providers.MockLogger)
 
-	useCase := NewLoginUseCase(mockUserRepo, mockTokenRepo, mockJWTManager, mockLogger)
+	useCase := NewLoginUseCase(mockUserRepo, mockTokenRepo, mockJWTManager, mockGeoResolver, mockPublisher, nil, mockLogger)
 
 	mockUserRepo.On("GetByEmail", mock.Anything, givenEmail).Return(givenUser, nil)
-	mockJWTManager.On("GenerateAccessToken", givenUserID, givenOrgID, givenEmail, mock.Anything).Return("access_token", nil)
+	mockJWTManager.On("GenerateAccessToken", givenUserID, givenOrgID, givenEmail, mock.Anything, mock.Anything).Return("access_token", nil)
 	mockJWTManager.On("GenerateRefreshToken", givenUserID).Return("refresh_token", nil)
 	mockJWTManager.On("GetRefreshExpiry").Return(7 * 24 * time.Hour)
 	mockTokenRepo.On("StoreRefreshToken", mock.Anything, mock.AnythingOfType("*domain.RefreshToken")).Return(assert.AnError)
@@ -396,3 +495,190 @@ func TestLoginUseCase_Execute_WhenStoreRefreshTokenFails_ReturnsError(t *testing
 	mockJWTManager.AssertExpectations(t)
 	mockTokenRepo.AssertExpectations(t)
 }
+
+func TestLoginUseCase_Execute_FromNewDevice_PublishesSecurityEvent(t *testing.T) {
+	// Given
+	givenEmail := "user@example.com"
+	givenPassword := "password123"
+	givenUserID := uuid.New()
+	givenOrgID := uuid.New()
+	givenLastLogin := time.Now().Add(-24 * time.Hour)
+	givenHashedPassword, _ := bcrypt.GenerateFromPassword([]byte(givenPassword), bcrypt.DefaultCost)
+
+	givenUser := &domain.User{
+		ID:             givenUserID,
+		Email:          givenEmail,
+		Password:       string(givenHashedPassword),
+		Status:         domain.UserStatusActive,
+		OrganizationID: givenOrgID,
+		LastLoginAt:    &givenLastLogin,
+	}
+
+	givenRequest := &domain.LoginRequest{
+		Email:     givenEmail,
+		Password:  givenPassword,
+		IPAddress: "203.0.113.10",
+		UserAgent: "Mozilla/5.0 (X11; Linux x86_64)",
+	}
+
+	mockUserRepo := new(providers.MockUserRepository)
+	mockTokenRepo := new(providers.MockTokenRepository)
+	mockJWTManager := new(providers.MockJWTManager)
+	mockGeoResolver := new(providers.MockGeoIPResolver)
+	mockPublisher := new(providers.MockSecurityEventPublisher)
+	mockLogger := new(providers.MockLogger)
+
+	useCase := NewLoginUseCase(mockUserRepo, mockTokenRepo, mockJWTManager, mockGeoResolver, mockPublisher, nil, mockLogger)
+
+	mockUserRepo.On("GetByEmail", mock.Anything, givenEmail).Return(givenUser, nil)
+	mockJWTManager.On("GenerateAccessToken", givenUserID, givenOrgID, givenEmail, mock.Anything, mock.Anything).Return("access_token", nil)
+	mockJWTManager.On("GenerateRefreshToken", givenUserID).Return("refresh_token", nil)
+	mockJWTManager.On("GetRefreshExpiry").Return(7 * 24 * time.Hour)
+	mockJWTManager.On("GetAccessExpiry").Return(15 * time.Minute)
+	mockGeoResolver.On("Resolve", mock.Anything, "203.0.113.10").Return(nil, nil)
+	mockTokenRepo.On("HasDeviceLogin", mock.Anything, givenUserID, mock.AnythingOfType("string")).Return(false, nil)
+	mockTokenRepo.On("StoreRefreshToken", mock.Anything, mock.MatchedBy(func(token *domain.RefreshToken) bool {
+		return token.DeviceID != ""
+	})).Return(nil)
+	mockUserRepo.On("UpdateLastLogin", mock.Anything, givenUserID).Return(nil)
+	mockPublisher.On("PublishSecurityEvent", mock.Anything, mock.MatchedBy(func(event *domain.SecurityEvent) bool {
+		return event.Type == domain.SecurityEventNewDeviceLogin &&
+			event.UserID == givenUserID &&
+			event.IPAddress == "203.0.113.10" &&
+			event.Priority() == domain.SecurityEventPriorityHigh
+	})).Return(nil)
+	mockLogger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	// When
+	response, err := useCase.Execute(context.Background(), givenRequest)
+
+	// Then
+	assert.NoError(t, err)
+	assert.NotNil(t, response)
+	mockTokenRepo.AssertExpectations(t)
+	mockPublisher.AssertExpectations(t)
+}
+
+func TestLoginUseCase_Execute_FromKnownDevice_DoesNotPublishSecurityEvent(t *testing.T) {
+	// Given
+	givenEmail := "user@example.com"
+	givenPassword := "password123"
+	givenUserID := uuid.New()
+	givenOrgID := uuid.New()
+	givenLastLogin := time.Now().Add(-24 * time.Hour)
+	givenHashedPassword, _ := bcrypt.GenerateFromPassword([]byte(givenPassword), bcrypt.DefaultCost)
+
+	givenUser := &domain.User{
+		ID:             givenUserID,
+		Email:          givenEmail,
+		Password:       string(givenHashedPassword),
+		Status:         domain.UserStatusActive,
+		OrganizationID: givenOrgID,
+		LastLoginAt:    &givenLastLogin,
+	}
+
+	givenRequest := &domain.LoginRequest{
+		Email:     givenEmail,
+		Password:  givenPassword,
+		UserAgent: "Mozilla/5.0 (X11; Linux x86_64)",
+	}
+
+	mockUserRepo := new(providers.MockUserRepository)
+	mockTokenRepo := new(providers.MockTokenRepository)
+	mockJWTManager := new(providers.MockJWTManager)
+	mockGeoResolver := new(providers.MockGeoIPResolver)
+	mockPublisher := new(providers.MockSecurityEventPublisher)
+	mockLogger := new(providers.MockLogger)
+
+	useCase := NewLoginUseCase(mockUserRepo, mockTokenRepo, mockJWTManager, mockGeoResolver, mockPublisher, nil, mockLogger)
+
+	mockUserRepo.On("GetByEmail", mock.Anything, givenEmail).Return(givenUser, nil)
+	mockJWTManager.On("GenerateAccessToken", givenUserID, givenOrgID, givenEmail, mock.Anything, mock.Anything).Return("access_token", nil)
+	mockJWTManager.On("GenerateRefreshToken", givenUserID).Return("refresh_token", nil)
+	mockJWTManager.On("GetRefreshExpiry").Return(7 * 24 * time.Hour)
+	mockJWTManager.On("GetAccessExpiry").Return(15 * time.Minute)
+	mockTokenRepo.On("HasDeviceLogin", mock.Anything, givenUserID, mock.AnythingOfType("string")).Return(true, nil)
+	mockTokenRepo.On("StoreRefreshToken", mock.Anything, mock.AnythingOfType("*domain.RefreshToken")).Return(nil)
+	mockUserRepo.On("UpdateLastLogin", mock.Anything, givenUserID).Return(nil)
+	mockLogger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	// When
+	response, err := useCase.Execute(context.Background(), givenRequest)
+
+	// Then
+	assert.NoError(t, err)
+	assert.NotNil(t, response)
+	mockPublisher.AssertNotCalled(t, "PublishSecurityEvent", mock.Anything, mock.Anything)
+}
+
+func TestLoginUseCase_Execute_WithImpossibleTravelAndTwoFactor_RequiresStepUp(t *testing.T) {
+	// Given
+	givenEmail := "user@example.com"
+	givenPassword := "password123"
+	givenUserID := uuid.New()
+	givenOrgID := uuid.New()
+	givenLastLogin := time.Now().Add(-1 * time.Hour)
+	givenHashedPassword, _ := bcrypt.GenerateFromPassword([]byte(givenPassword), bcrypt.DefaultCost)
+
+	givenUser := &domain.User{
+		ID:               givenUserID,
+		Email:            givenEmail,
+		Password:         string(givenHashedPassword),
+		Status:           domain.UserStatusActive,
+		OrganizationID:   givenOrgID,
+		LastLoginAt:      &givenLastLogin,
+		TwoFactorEnabled: true,
+		TwoFactorSecret:  "SECRET",
+	}
+
+	givenRequest := &domain.LoginRequest{
+		Email:     givenEmail,
+		Password:  givenPassword,
+		IPAddress: "198.51.100.7",
+		UserAgent: "Mozilla/5.0 (X11; Linux x86_64)",
+	}
+
+	givenPreviousLat, givenPreviousLon := -34.6037, -58.3816 // Buenos Aires
+	givenPreviousSession := &domain.RefreshToken{
+		UserID:    givenUserID,
+		Latitude:  &givenPreviousLat,
+		Longitude: &givenPreviousLon,
+		CreatedAt: time.Now().Add(-1 * time.Hour),
+	}
+	givenLocation := &domain.GeoLocation{Country: "JP", City: "Tokyo", Latitude: 35.6762, Longitude: 139.6503}
+
+	mockUserRepo := new(providers.MockUserRepository)
+	mockTokenRepo := new(providers.MockTokenRepository)
+	mockJWTManager := new(providers.MockJWTManager)
+	mockGeoResolver := new(providers.MockGeoIPResolver)
+	mockPublisher := new(providers.MockSecurityEventPublisher)
+	mockLogger := new(providers.MockLogger)
+
+	useCase := NewLoginUseCase(mockUserRepo, mockTokenRepo, mockJWTManager, mockGeoResolver, mockPublisher, nil, mockLogger)
+
+	mockUserRepo.On("GetByEmail", mock.Anything, givenEmail).Return(givenUser, nil)
+	mockGeoResolver.On("Resolve", mock.Anything, "198.51.100.7").Return(givenLocation, nil)
+	mockTokenRepo.On("HasDeviceLogin", mock.Anything, givenUserID, mock.AnythingOfType("string")).Return(true, nil)
+	mockTokenRepo.On("GetLatestRefreshToken", mock.Anything, givenUserID).Return(givenPreviousSession, nil)
+	mockTokenRepo.On("StoreLoginChallenge", mock.Anything, mock.AnythingOfType("string"), mock.MatchedBy(func(challenge *domain.LoginChallenge) bool {
+		return challenge.UserID == givenUserID && challenge.Location.City == "Tokyo"
+	}), loginChallengeTTL).Return(nil)
+	mockPublisher.On("PublishSecurityEvent", mock.Anything, mock.MatchedBy(func(event *domain.SecurityEvent) bool {
+		return event.Type == domain.SecurityEventImpossibleTravel &&
+			event.Priority() == domain.SecurityEventPriorityCritical
+	})).Return(nil)
+	mockLogger.On("Warn", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	// When
+	response, err := useCase.Execute(context.Background(), givenRequest)
+
+	// Then
+	assert.NoError(t, err)
+	assert.True(t, response.StepUpRequired)
+	assert.NotEmpty(t, response.ChallengeToken)
+	assert.Empty(t, response.AccessToken)
+	assert.Equal(t, []domain.SuspiciousLoginReason{domain.SuspiciousLoginImpossibleTravel}, response.StepUpReasons)
+	mockTokenRepo.AssertExpectations(t)
+	mockPublisher.AssertExpectations(t)
+	mockJWTManager.AssertNotCalled(t, "GenerateAccessToken", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
@@ -0,0 +1,193 @@
+package auth
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	pkgErrors "github.com/giia/giia-core-engine/pkg/errors"
+	pkgLogger "github.com/giia/giia-core-engine/pkg/logger"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/domain"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/providers"
+)
+
+// magicLinkTTL is how long an emailed sign-in link stays valid.
+const magicLinkTTL = 15 * time.Minute
+
+type RequestMagicLinkUseCase struct {
+	userRepo     providers.UserRepository
+	orgRepo      providers.OrganizationRepository
+	tokenRepo    providers.TokenRepository
+	emailService providers.EmailService
+	logger       pkgLogger.Logger
+}
+
+func NewRequestMagicLinkUseCase(
+	userRepo providers.UserRepository,
+	orgRepo providers.OrganizationRepository,
+	tokenRepo providers.TokenRepository,
+	emailService providers.EmailService,
+	logger pkgLogger.Logger,
+) *RequestMagicLinkUseCase {
+	return &RequestMagicLinkUseCase{
+		userRepo:     userRepo,
+		orgRepo:      orgRepo,
+		tokenRepo:    tokenRepo,
+		emailService: emailService,
+		logger:       logger,
+	}
+}
+
+// Execute emails a single-use sign-in link when the user exists, is active
+// and their organization allows magic links. Every other case also returns
+// nil so the endpoint does not reveal which emails have accounts.
+func (uc *RequestMagicLinkUseCase) Execute(ctx context.Context, req *domain.RequestMagicLinkRequest) error {
+	email := strings.ToLower(strings.TrimSpace(req.Email))
+	if email == "" {
+		return pkgErrors.NewBadRequest("email is required")
+	}
+
+	user, err := uc.userRepo.GetByEmail(ctx, email)
+	if err != nil {
+		uc.logger.Info(ctx, "Magic link requested for unknown email", pkgLogger.Tags{
+			"ip_address": req.IPAddress,
+		})
+		return nil
+	}
+
+	if user.Status != domain.UserStatusActive {
+		uc.logger.Warn(ctx, "Magic link requested for inactive user", pkgLogger.Tags{
+			"user_id": user.ID.String(),
+		})
+		return nil
+	}
+
+	org, err := uc.orgRepo.GetByID(ctx, user.OrganizationID)
+	if err != nil {
+		uc.logger.Error(ctx, err, "Failed to get organization", pkgLogger.Tags{
+			"organization_id": user.OrganizationID.String(),
+		})
+		return nil
+	}
+
+	if !org.AuthSettings().MagicLinkEnabled {
+		uc.logger.Info(ctx, "Magic link requested but disabled for organization", pkgLogger.Tags{
+			"user_id":         user.ID.String(),
+			"organization_id": org.ID.String(),
+		})
+		return nil
+	}
+
+	token, err := generateChallengeToken()
+	if err != nil {
+		uc.logger.Error(ctx, err, "Failed to generate magic link token", pkgLogger.Tags{
+			"user_id": user.ID.String(),
+		})
+		return pkgErrors.NewInternalServerError("failed to send sign-in link")
+	}
+
+	link := &domain.MagicLink{
+		UserID:    user.ID,
+		IPAddress: req.IPAddress,
+		CreatedAt: time.Now().UTC(),
+	}
+
+	if err := uc.tokenRepo.StoreMagicLink(ctx, hashToken(token), link, magicLinkTTL); err != nil {
+		uc.logger.Error(ctx, err, "Failed to store magic link", pkgLogger.Tags{
+			"user_id": user.ID.String(),
+		})
+		return pkgErrors.NewInternalServerError("failed to send sign-in link")
+	}
+
+	if err := uc.emailService.SendMagicLinkEmail(ctx, user.Email, token, user.FirstName); err != nil {
+		uc.logger.Error(ctx, err, "Failed to send magic link email", pkgLogger.Tags{
+			"user_id": user.ID.String(),
+		})
+		return nil
+	}
+
+	uc.logger.Info(ctx, "Magic link sent", pkgLogger.Tags{
+		"user_id": user.ID.String(),
+	})
+
+	return nil
+}
+
+type ExchangeMagicLinkUseCase struct {
+	userRepo       providers.UserRepository
+	orgRepo        providers.OrganizationRepository
+	tokenRepo      providers.TokenRepository
+	geoResolver    providers.GeoIPResolver
+	eventPublisher providers.SecurityEventPublisher
+	sessions       *sessionIssuer
+	logger         pkgLogger.Logger
+}
+
+func NewExchangeMagicLinkUseCase(
+	userRepo providers.UserRepository,
+	orgRepo providers.OrganizationRepository,
+	tokenRepo providers.TokenRepository,
+	jwtManager providers.JWTManager,
+	geoResolver providers.GeoIPResolver,
+	eventPublisher providers.SecurityEventPublisher,
+	permissions providers.PermissionResolver,
+	logger pkgLogger.Logger,
+) *ExchangeMagicLinkUseCase {
+	return &ExchangeMagicLinkUseCase{
+		userRepo:       userRepo,
+		orgRepo:        orgRepo,
+		tokenRepo:      tokenRepo,
+		geoResolver:    geoResolver,
+		eventPublisher: eventPublisher,
+		sessions: &sessionIssuer{
+			userRepo:    userRepo,
+			tokenRepo:   tokenRepo,
+			jwtManager:  jwtManager,
+			permissions: permissions,
+			logger:      logger,
+		},
+		logger: logger,
+	}
+}
+
+// Execute consumes the link and issues a session like a password login. The
+// link replaces the password only: users with 2FA always complete a step-up
+// challenge.
+func (uc *ExchangeMagicLinkUseCase) Execute(ctx context.Context, req *domain.ExchangeMagicLinkRequest) (*domain.LoginResponse, error) {
+	if req.Token == "" {
+		return nil, pkgErrors.NewBadRequest("sign-in link token is required")
+	}
+
+	link, err := uc.tokenRepo.ConsumeMagicLink(ctx, hashToken(req.Token))
+	if err != nil {
+		return nil, pkgErrors.NewUnauthorized("invalid or expired sign-in link")
+	}
+
+	user, err := uc.userRepo.GetByID(ctx, link.UserID)
+	if err != nil {
+		uc.logger.Error(ctx, err, "Failed to get user for magic link", pkgLogger.Tags{
+			"user_id": link.UserID.String(),
+		})
+		return nil, pkgErrors.NewUnauthorized("invalid or expired sign-in link")
+	}
+
+	if user.Status != domain.UserStatusActive {
+		return nil, pkgErrors.NewForbidden("account is not active")
+	}
+
+	org, err := uc.orgRepo.GetByID(ctx, user.OrganizationID)
+	if err != nil || !org.AuthSettings().MagicLinkEnabled {
+		return nil, pkgErrors.NewForbidden("magic link login is disabled for this organization")
+	}
+
+	lc := assessLogin(ctx, uc.tokenRepo, uc.geoResolver, uc.logger, user, req.IPAddress, req.UserAgent)
+	if lc.suspicious() {
+		publishSuspiciousLogin(ctx, uc.eventPublisher, uc.logger, user, lc)
+	}
+
+	if user.TwoFactorEnabled {
+		return requireStepUp(ctx, uc.tokenRepo, uc.logger, user, lc)
+	}
+
+	return uc.sessions.issue(ctx, user, lc)
+}
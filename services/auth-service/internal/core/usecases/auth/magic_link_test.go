@@ -0,0 +1,191 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/domain"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/providers"
+)
+
+func givenMagicLinkOrganization(enabled bool) *domain.Organization {
+	org := &domain.Organization{ID: uuid.New()}
+	org.SetAuthSettings(&domain.AuthSettings{MagicLinkEnabled: enabled})
+	return org
+}
+
+func TestRequestMagicLinkUseCase_Execute_WithEnabledOrganization_StoresLinkAndSendsEmail(t *testing.T) {
+	// Given
+	givenOrg := givenMagicLinkOrganization(true)
+	givenUser := &domain.User{ID: uuid.New(), Email: "ana@acme.com", FirstName: "Ana", Status: domain.UserStatusActive, OrganizationID: givenOrg.ID}
+
+	mockUserRepo := new(providers.MockUserRepository)
+	mockOrgRepo := new(providers.MockOrganizationRepository)
+	mockTokenRepo := new(providers.MockTokenRepository)
+	mockEmailService := new(providers.MockEmailService)
+	mockLogger := new(providers.MockLogger)
+
+	useCase := NewRequestMagicLinkUseCase(mockUserRepo, mockOrgRepo, mockTokenRepo, mockEmailService, mockLogger)
+
+	var sentToken string
+	mockUserRepo.On("GetByEmail", mock.Anything, "ana@acme.com").Return(givenUser, nil)
+	mockOrgRepo.On("GetByID", mock.Anything, givenOrg.ID).Return(givenOrg, nil)
+	mockTokenRepo.On("StoreMagicLink", mock.Anything, mock.AnythingOfType("string"), mock.MatchedBy(func(l *domain.MagicLink) bool {
+		return l.UserID == givenUser.ID
+	}), magicLinkTTL).Return(nil)
+	mockEmailService.On("SendMagicLinkEmail", mock.Anything, "ana@acme.com", mock.AnythingOfType("string"), "Ana").
+		Run(func(args mock.Arguments) { sentToken = args.String(2) }).Return(nil)
+	mockLogger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	// When
+	err := useCase.Execute(context.Background(), &domain.RequestMagicLinkRequest{Email: " Ana@Acme.com "})
+
+	// Then
+	assert.NoError(t, err)
+	mockTokenRepo.AssertCalled(t, "StoreMagicLink", mock.Anything, hashToken(sentToken), mock.Anything, magicLinkTTL)
+	mockEmailService.AssertExpectations(t)
+}
+
+func TestRequestMagicLinkUseCase_Execute_WithDisabledOrganization_SendsNothing(t *testing.T) {
+	// Given
+	givenOrg := givenMagicLinkOrganization(false)
+	givenUser := &domain.User{ID: uuid.New(), Email: "ana@acme.com", Status: domain.UserStatusActive, OrganizationID: givenOrg.ID}
+
+	mockUserRepo := new(providers.MockUserRepository)
+	mockOrgRepo := new(providers.MockOrganizationRepository)
+	mockTokenRepo := new(providers.MockTokenRepository)
+	mockEmailService := new(providers.MockEmailService)
+	mockLogger := new(providers.MockLogger)
+
+	useCase := NewRequestMagicLinkUseCase(mockUserRepo, mockOrgRepo, mockTokenRepo, mockEmailService, mockLogger)
+
+	mockUserRepo.On("GetByEmail", mock.Anything, "ana@acme.com").Return(givenUser, nil)
+	mockOrgRepo.On("GetByID", mock.Anything, givenOrg.ID).Return(givenOrg, nil)
+	mockLogger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	// When
+	err := useCase.Execute(context.Background(), &domain.RequestMagicLinkRequest{Email: "ana@acme.com"})
+
+	// Then
+	assert.NoError(t, err)
+	mockTokenRepo.AssertNotCalled(t, "StoreMagicLink")
+	mockEmailService.AssertNotCalled(t, "SendMagicLinkEmail")
+}
+
+func TestRequestMagicLinkUseCase_Execute_WithUnknownEmail_ReturnsNilWithoutSending(t *testing.T) {
+	// Given
+	mockUserRepo := new(providers.MockUserRepository)
+	mockOrgRepo := new(providers.MockOrganizationRepository)
+	mockTokenRepo := new(providers.MockTokenRepository)
+	mockEmailService := new(providers.MockEmailService)
+	mockLogger := new(providers.MockLogger)
+
+	useCase := NewRequestMagicLinkUseCase(mockUserRepo, mockOrgRepo, mockTokenRepo, mockEmailService, mockLogger)
+
+	mockUserRepo.On("GetByEmail", mock.Anything, "nobody@acme.com").Return(nil, errors.New("record not found"))
+	mockLogger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	// When
+	err := useCase.Execute(context.Background(), &domain.RequestMagicLinkRequest{Email: "nobody@acme.com"})
+
+	// Then
+	assert.NoError(t, err)
+	mockEmailService.AssertNotCalled(t, "SendMagicLinkEmail")
+}
+
+func TestExchangeMagicLinkUseCase_Execute_WithValidLink_IssuesSession(t *testing.T) {
+	// Given
+	givenOrg := givenMagicLinkOrganization(true)
+	givenUser := &domain.User{ID: uuid.New(), Email: "ana@acme.com", Status: domain.UserStatusActive, OrganizationID: givenOrg.ID}
+
+	mockUserRepo := new(providers.MockUserRepository)
+	mockOrgRepo := new(providers.MockOrganizationRepository)
+	mockTokenRepo := new(providers.MockTokenRepository)
+	mockJWTManager := new(providers.MockJWTManager)
+	mockGeoResolver := new(providers.MockGeoIPResolver)
+	mockPublisher := new(providers.MockSecurityEventPublisher)
+	mockLogger := new(providers.MockLogger)
+
+	useCase := NewExchangeMagicLinkUseCase(mockUserRepo, mockOrgRepo, mockTokenRepo, mockJWTManager, mockGeoResolver, mockPublisher, nil, mockLogger)
+
+	mockTokenRepo.On("ConsumeMagicLink", mock.Anything, hashToken("link-token")).Return(&domain.MagicLink{UserID: givenUser.ID}, nil)
+	mockUserRepo.On("GetByID", mock.Anything, givenUser.ID).Return(givenUser, nil)
+	mockOrgRepo.On("GetByID", mock.Anything, givenOrg.ID).Return(givenOrg, nil)
+	mockJWTManager.On("GenerateAccessToken", givenUser.ID, givenOrg.ID, givenUser.Email, mock.Anything, mock.Anything).Return("access_token", nil)
+	mockJWTManager.On("GenerateRefreshToken", givenUser.ID).Return("refresh_token", nil)
+	mockJWTManager.On("GetRefreshExpiry").Return(7 * 24 * time.Hour)
+	mockJWTManager.On("GetAccessExpiry").Return(15 * time.Minute)
+	mockTokenRepo.On("StoreRefreshToken", mock.Anything, mock.AnythingOfType("*domain.RefreshToken")).Return(nil)
+	mockUserRepo.On("UpdateLastLogin", mock.Anything, givenUser.ID).Return(nil)
+	mockLogger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	// When
+	response, err := useCase.Execute(context.Background(), &domain.ExchangeMagicLinkRequest{Token: "link-token"})
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, "access_token", response.AccessToken)
+	assert.Equal(t, "refresh_token", response.RefreshToken)
+	mockTokenRepo.AssertExpectations(t)
+}
+
+func TestExchangeMagicLinkUseCase_Execute_WithUsedOrExpiredLink_ReturnsUnauthorized(t *testing.T) {
+	// Given
+	mockUserRepo := new(providers.MockUserRepository)
+	mockOrgRepo := new(providers.MockOrganizationRepository)
+	mockTokenRepo := new(providers.MockTokenRepository)
+	mockJWTManager := new(providers.MockJWTManager)
+	mockGeoResolver := new(providers.MockGeoIPResolver)
+	mockPublisher := new(providers.MockSecurityEventPublisher)
+	mockLogger := new(providers.MockLogger)
+
+	useCase := NewExchangeMagicLinkUseCase(mockUserRepo, mockOrgRepo, mockTokenRepo, mockJWTManager, mockGeoResolver, mockPublisher, nil, mockLogger)
+
+	mockTokenRepo.On("ConsumeMagicLink", mock.Anything, hashToken("link-token")).Return(nil, errors.New("redis: nil"))
+
+	// When
+	response, err := useCase.Execute(context.Background(), &domain.ExchangeMagicLinkRequest{Token: "link-token"})
+
+	// Then
+	assert.Error(t, err)
+	assert.Nil(t, response)
+	assert.Contains(t, err.Error(), "invalid or expired sign-in link")
+	mockUserRepo.AssertNotCalled(t, "GetByID")
+}
+
+func TestExchangeMagicLinkUseCase_Execute_WithTwoFactorUser_RequiresStepUp(t *testing.T) {
+	// Given
+	givenOrg := givenMagicLinkOrganization(true)
+	givenUser := &domain.User{ID: uuid.New(), Status: domain.UserStatusActive, OrganizationID: givenOrg.ID, TwoFactorEnabled: true}
+
+	mockUserRepo := new(providers.MockUserRepository)
+	mockOrgRepo := new(providers.MockOrganizationRepository)
+	mockTokenRepo := new(providers.MockTokenRepository)
+	mockJWTManager := new(providers.MockJWTManager)
+	mockGeoResolver := new(providers.MockGeoIPResolver)
+	mockPublisher := new(providers.MockSecurityEventPublisher)
+	mockLogger := new(providers.MockLogger)
+
+	useCase := NewExchangeMagicLinkUseCase(mockUserRepo, mockOrgRepo, mockTokenRepo, mockJWTManager, mockGeoResolver, mockPublisher, nil, mockLogger)
+
+	mockTokenRepo.On("ConsumeMagicLink", mock.Anything, hashToken("link-token")).Return(&domain.MagicLink{UserID: givenUser.ID}, nil)
+	mockUserRepo.On("GetByID", mock.Anything, givenUser.ID).Return(givenUser, nil)
+	mockOrgRepo.On("GetByID", mock.Anything, givenOrg.ID).Return(givenOrg, nil)
+	mockTokenRepo.On("StoreLoginChallenge", mock.Anything, mock.AnythingOfType("string"), mock.Anything, loginChallengeTTL).Return(nil)
+	mockLogger.On("Warn", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	// When
+	response, err := useCase.Execute(context.Background(), &domain.ExchangeMagicLinkRequest{Token: "link-token"})
+
+	// Then
+	assert.NoError(t, err)
+	assert.True(t, response.StepUpRequired)
+	assert.NotEmpty(t, response.ChallengeToken)
+	mockJWTManager.AssertNotCalled(t, "GenerateAccessToken")
+}
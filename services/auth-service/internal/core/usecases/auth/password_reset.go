@@ -0,0 +1,194 @@
+package auth
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+
+	pkgErrors "github.com/giia/giia-core-engine/pkg/errors"
+	pkgLogger "github.com/giia/giia-core-engine/pkg/logger"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/domain"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/providers"
+)
+
+// passwordResetTTL is how long an emailed password reset link stays valid.
+const passwordResetTTL = 1 * time.Hour
+
+type RequestPasswordResetUseCase struct {
+	userRepo     providers.UserRepository
+	tokenRepo    providers.TokenRepository
+	emailService providers.EmailService
+	logger       pkgLogger.Logger
+}
+
+func NewRequestPasswordResetUseCase(
+	userRepo providers.UserRepository,
+	tokenRepo providers.TokenRepository,
+	emailService providers.EmailService,
+	logger pkgLogger.Logger,
+) *RequestPasswordResetUseCase {
+	return &RequestPasswordResetUseCase{
+		userRepo:     userRepo,
+		tokenRepo:    tokenRepo,
+		emailService: emailService,
+		logger:       logger,
+	}
+}
+
+// Execute emails a single-use reset link when the user exists and is not
+// suspended. Inactive users are included so imported accounts can ask for a
+// new link once their invitation expires. Every other case also returns nil
+// so the endpoint does not reveal which emails have accounts.
+func (uc *RequestPasswordResetUseCase) Execute(ctx context.Context, req *domain.ForgotPasswordRequest) error {
+	email := strings.ToLower(strings.TrimSpace(req.Email))
+	if email == "" {
+		return pkgErrors.NewBadRequest("email is required")
+	}
+
+	user, err := uc.userRepo.GetByEmail(ctx, email)
+	if err != nil {
+		uc.logger.Info(ctx, "Password reset requested for unknown email", pkgLogger.Tags{
+			"ip_address": req.IPAddress,
+		})
+		return nil
+	}
+
+	if user.Status == domain.UserStatusSuspended {
+		uc.logger.Warn(ctx, "Password reset requested for suspended user", pkgLogger.Tags{
+			"user_id": user.ID.String(),
+		})
+		return nil
+	}
+
+	token, resetToken, err := domain.GeneratePasswordResetToken(user.ID, passwordResetTTL)
+	if err != nil {
+		uc.logger.Error(ctx, err, "Failed to generate password reset token", pkgLogger.Tags{
+			"user_id": user.ID.String(),
+		})
+		return pkgErrors.NewInternalServerError("failed to send password reset link")
+	}
+
+	if err := uc.tokenRepo.StorePasswordResetToken(ctx, resetToken); err != nil {
+		uc.logger.Error(ctx, err, "Failed to store password reset token", pkgLogger.Tags{
+			"user_id": user.ID.String(),
+		})
+		return pkgErrors.NewInternalServerError("failed to send password reset link")
+	}
+
+	if err := uc.emailService.SendPasswordResetEmail(ctx, user.Email, token, user.FirstName); err != nil {
+		uc.logger.Error(ctx, err, "Failed to send password reset email", pkgLogger.Tags{
+			"user_id": user.ID.String(),
+		})
+		return nil
+	}
+
+	uc.logger.Info(ctx, "Password reset link sent", pkgLogger.Tags{
+		"user_id": user.ID.String(),
+	})
+
+	return nil
+}
+
+type ResetPasswordUseCase struct {
+	userRepo       providers.UserRepository
+	tokenRepo      providers.TokenRepository
+	eventPublisher providers.SecurityEventPublisher
+	logger         pkgLogger.Logger
+}
+
+func NewResetPasswordUseCase(
+	userRepo providers.UserRepository,
+	tokenRepo providers.TokenRepository,
+	eventPublisher providers.SecurityEventPublisher,
+	logger pkgLogger.Logger,
+) *ResetPasswordUseCase {
+	return &ResetPasswordUseCase{
+		userRepo:       userRepo,
+		tokenRepo:      tokenRepo,
+		eventPublisher: eventPublisher,
+		logger:         logger,
+	}
+}
+
+// Execute sets a new password from a reset or import invitation link and
+// signs out every session. The link proves the user owns the mailbox, so an
+// inactive account is activated as well.
+func (uc *ResetPasswordUseCase) Execute(ctx context.Context, req *domain.ResetPasswordRequest) error {
+	if req.Token == "" {
+		return pkgErrors.NewBadRequest("password reset token is required")
+	}
+
+	if req.NewPassword == "" {
+		return pkgErrors.NewBadRequest("new password is required")
+	}
+
+	if err := validatePassword(req.NewPassword); err != nil {
+		return err
+	}
+
+	tokenHash := domain.HashPasswordResetToken(req.Token)
+	resetToken, err := uc.tokenRepo.GetPasswordResetToken(ctx, tokenHash)
+	if err != nil {
+		return pkgErrors.NewBadRequest("invalid or expired password reset token")
+	}
+
+	user, err := uc.userRepo.GetByID(ctx, resetToken.UserID)
+	if err != nil {
+		uc.logger.Error(ctx, err, "Failed to get user for password reset", pkgLogger.Tags{
+			"user_id": resetToken.UserID.String(),
+		})
+		return pkgErrors.NewBadRequest("invalid or expired password reset token")
+	}
+
+	if user.Status == domain.UserStatusSuspended {
+		return pkgErrors.NewForbidden("account is not active")
+	}
+
+	if err := uc.tokenRepo.MarkPasswordResetTokenUsed(ctx, tokenHash); err != nil {
+		uc.logger.Error(ctx, err, "Failed to mark password reset token as used", pkgLogger.Tags{
+			"user_id": user.ID.String(),
+		})
+		return pkgErrors.NewInternalServerError("failed to reset password")
+	}
+
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(req.NewPassword), bcrypt.DefaultCost)
+	if err != nil {
+		uc.logger.Error(ctx, err, "Failed to hash password", nil)
+		return pkgErrors.NewInternalServerError("failed to hash password")
+	}
+
+	user.Password = string(hashedPassword)
+	if user.Status == domain.UserStatusInactive {
+		user.Status = domain.UserStatusActive
+	}
+
+	if err := uc.userRepo.Update(ctx, user); err != nil {
+		uc.logger.Error(ctx, err, "Failed to update password", pkgLogger.Tags{
+			"user_id": user.ID.String(),
+		})
+		return pkgErrors.NewInternalServerError("failed to reset password")
+	}
+
+	if err := uc.tokenRepo.RevokeAllUserTokens(ctx, user.ID); err != nil {
+		uc.logger.Error(ctx, err, "Failed to revoke refresh tokens after password reset", pkgLogger.Tags{
+			"user_id": user.ID.String(),
+		})
+	}
+
+	publishSecurityEvent(ctx, uc.eventPublisher, uc.logger, &domain.SecurityEvent{
+		Type:           domain.SecurityEventPasswordChanged,
+		UserID:         user.ID,
+		OrganizationID: user.OrganizationID,
+		Email:          user.Email,
+		IPAddress:      req.IPAddress,
+		UserAgent:      req.UserAgent,
+	})
+
+	uc.logger.Info(ctx, "Password reset successfully", pkgLogger.Tags{
+		"user_id": user.ID.String(),
+	})
+
+	return nil
+}
@@ -0,0 +1,138 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/domain"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/providers"
+)
+
+func TestRequestPasswordResetUseCase_Execute_WithInactiveUser_SendsResetLink(t *testing.T) {
+	// Given
+	givenUser := &domain.User{
+		ID:        uuid.New(),
+		Email:     "imported@example.com",
+		FirstName: "Ana",
+		Status:    domain.UserStatusInactive,
+	}
+
+	mockUserRepo := new(providers.MockUserRepository)
+	mockTokenRepo := new(providers.MockTokenRepository)
+	mockEmailService := new(providers.MockEmailService)
+	mockLogger := new(providers.MockLogger)
+
+	useCase := NewRequestPasswordResetUseCase(mockUserRepo, mockTokenRepo, mockEmailService, mockLogger)
+
+	var storedHash string
+	mockUserRepo.On("GetByEmail", mock.Anything, "imported@example.com").Return(givenUser, nil)
+	mockTokenRepo.On("StorePasswordResetToken", mock.Anything, mock.MatchedBy(func(token *domain.PasswordResetToken) bool {
+		storedHash = token.TokenHash
+		return token.UserID == givenUser.ID
+	})).Return(nil)
+	mockEmailService.On("SendPasswordResetEmail", mock.Anything, "imported@example.com", mock.MatchedBy(func(token string) bool {
+		return domain.HashPasswordResetToken(token) == storedHash
+	}), "Ana").Return(nil)
+	mockLogger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	// When
+	err := useCase.Execute(context.Background(), &domain.ForgotPasswordRequest{Email: " Imported@Example.com "})
+
+	// Then
+	assert.NoError(t, err)
+	mockTokenRepo.AssertExpectations(t)
+	mockEmailService.AssertExpectations(t)
+}
+
+func TestRequestPasswordResetUseCase_Execute_WithUnknownEmail_ReturnsNil(t *testing.T) {
+	// Given
+	mockUserRepo := new(providers.MockUserRepository)
+	mockTokenRepo := new(providers.MockTokenRepository)
+	mockEmailService := new(providers.MockEmailService)
+	mockLogger := new(providers.MockLogger)
+
+	useCase := NewRequestPasswordResetUseCase(mockUserRepo, mockTokenRepo, mockEmailService, mockLogger)
+
+	mockUserRepo.On("GetByEmail", mock.Anything, "nobody@example.com").Return(nil, errors.New("record not found"))
+	mockLogger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	// When
+	err := useCase.Execute(context.Background(), &domain.ForgotPasswordRequest{Email: "nobody@example.com"})
+
+	// Then
+	assert.NoError(t, err)
+	mockTokenRepo.AssertNotCalled(t, "StorePasswordResetToken", mock.Anything, mock.Anything)
+	mockEmailService.AssertNotCalled(t, "SendPasswordResetEmail", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestResetPasswordUseCase_Execute_WithImportedUser_SetsPasswordAndActivates(t *testing.T) {
+	// Given
+	givenToken := "reset-token"
+	givenTokenHash := domain.HashPasswordResetToken(givenToken)
+	givenOrgID := uuid.New()
+	givenUser := &domain.User{
+		ID:             uuid.New(),
+		Email:          "imported@example.com",
+		Status:         domain.UserStatusInactive,
+		OrganizationID: givenOrgID,
+	}
+
+	mockUserRepo := new(providers.MockUserRepository)
+	mockTokenRepo := new(providers.MockTokenRepository)
+	mockPublisher := new(providers.MockSecurityEventPublisher)
+	mockLogger := new(providers.MockLogger)
+
+	useCase := NewResetPasswordUseCase(mockUserRepo, mockTokenRepo, mockPublisher, mockLogger)
+
+	mockTokenRepo.On("GetPasswordResetToken", mock.Anything, givenTokenHash).Return(&domain.PasswordResetToken{UserID: givenUser.ID}, nil)
+	mockUserRepo.On("GetByID", mock.Anything, givenUser.ID).Return(givenUser, nil)
+	mockTokenRepo.On("MarkPasswordResetTokenUsed", mock.Anything, givenTokenHash).Return(nil)
+	mockUserRepo.On("Update", mock.Anything, givenUser).Return(nil)
+	mockTokenRepo.On("RevokeAllUserTokens", mock.Anything, givenUser.ID).Return(nil)
+	mockPublisher.On("PublishSecurityEvent", mock.Anything, mock.MatchedBy(func(event *domain.SecurityEvent) bool {
+		return event.Type == domain.SecurityEventPasswordChanged && event.OrganizationID == givenOrgID
+	})).Return(nil)
+	mockLogger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	// When
+	err := useCase.Execute(context.Background(), &domain.ResetPasswordRequest{
+		Token:       givenToken,
+		NewPassword: "NewPassword2@",
+	})
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, domain.UserStatusActive, givenUser.Status)
+	assert.NoError(t, bcrypt.CompareHashAndPassword([]byte(givenUser.Password), []byte("NewPassword2@")))
+	mockTokenRepo.AssertExpectations(t)
+	mockPublisher.AssertExpectations(t)
+}
+
+func TestResetPasswordUseCase_Execute_WithInvalidToken_ReturnsBadRequest(t *testing.T) {
+	// Given
+	mockUserRepo := new(providers.MockUserRepository)
+	mockTokenRepo := new(providers.MockTokenRepository)
+	mockPublisher := new(providers.MockSecurityEventPublisher)
+	mockLogger := new(providers.MockLogger)
+
+	useCase := NewResetPasswordUseCase(mockUserRepo, mockTokenRepo, mockPublisher, mockLogger)
+
+	mockTokenRepo.On("GetPasswordResetToken", mock.Anything, mock.Anything).Return(nil, errors.New("record not found"))
+
+	// When
+	err := useCase.Execute(context.Background(), &domain.ResetPasswordRequest{
+		Token:       "expired",
+		NewPassword: "NewPassword2@",
+	})
+
+	// Then
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid or expired password reset token")
+	mockUserRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+}
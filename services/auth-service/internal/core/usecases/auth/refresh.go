@@ -12,23 +12,26 @@ import (
 )
 
 type RefreshTokenUseCase struct {
-	userRepo   providers.UserRepository
-	tokenRepo  providers.TokenRepository
-	jwtManager providers.JWTManager
-	logger     pkgLogger.Logger
+	userRepo    providers.UserRepository
+	tokenRepo   providers.TokenRepository
+	jwtManager  providers.JWTManager
+	permissions providers.PermissionResolver
+	logger      pkgLogger.Logger
 }
 
 func NewRefreshTokenUseCase(
 	userRepo providers.UserRepository,
 	tokenRepo providers.TokenRepository,
 	jwtManager providers.JWTManager,
+	permissions providers.PermissionResolver,
 	logger pkgLogger.Logger,
 ) *RefreshTokenUseCase {
 	return &RefreshTokenUseCase{
-		userRepo:   userRepo,
-		tokenRepo:  tokenRepo,
-		jwtManager: jwtManager,
-		logger:     logger,
+		userRepo:    userRepo,
+		tokenRepo:   tokenRepo,
+		jwtManager:  jwtManager,
+		permissions: permissions,
+		logger:      logger,
 	}
 }
 
@@ -87,6 +90,7 @@ func (uc *RefreshTokenUseCase) Execute(ctx context.Context, refreshTokenString s
 		user.OrganizationID,
 		user.Email,
 		nil,
+		tokenPermissions(ctx, uc.permissions, uc.logger, user.ID, user.OrganizationID),
 	)
 	if err != nil {
 		uc.logger.Error(ctx, err, "Failed to generate access token", pkgLogger.Tags{
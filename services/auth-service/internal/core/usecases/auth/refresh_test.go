@@ -43,12 +43,12 @@ func TestRefreshTokenUseCase_Execute_WithValidToken_ReturnsNewAccessToken(t *tes
 	mockJWTManager := new(providers.MockJWTManager)
 	mockLogger := new(providers.MockLogger)
 
-	useCase := NewRefreshTokenUseCase(mockUserRepo, mockTokenRepo, mockJWTManager, mockLogger)
+	useCase := NewRefreshTokenUseCase(mockUserRepo, mockTokenRepo, mockJWTManager, nil, mockLogger)
 
 	mockJWTManager.On("ValidateRefreshToken", givenRefreshToken).Return(givenClaims, nil)
 	mockTokenRepo.On("GetRefreshToken", mock.Anything, givenTokenHash).Return(givenStoredToken, nil)
 	mockUserRepo.On("GetByID", mock.Anything, givenUserID).Return(givenUser, nil)
-	mockJWTManager.On("GenerateAccessToken", givenUserID, givenOrgID, givenEmail, mock.Anything).Return("new_access_token", nil)
+	mockJWTManager.On("GenerateAccessToken", givenUserID, givenOrgID, givenEmail, mock.Anything, mock.Anything).Return("new_access_token", nil)
 	mockLogger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
 
 	// When
@@ -69,7 +69,7 @@ func TestRefreshTokenUseCase_Execute_WithEmptyToken_ReturnsBadRequest(t *testing
 	mockJWTManager := new(providers.MockJWTManager)
 	mockLogger := new(providers.MockLogger)
 
-	useCase := NewRefreshTokenUseCase(mockUserRepo, mockTokenRepo, mockJWTManager, mockLogger)
+	useCase := NewRefreshTokenUseCase(mockUserRepo, mockTokenRepo, mockJWTManager, nil, mockLogger)
 
 	// When
 	accessToken, err := useCase.Execute(context.Background(), "")
@@ -89,7 +89,7 @@ func TestRefreshTokenUseCase_Execute_WithInvalidToken_ReturnsUnauthorized(t *tes
 	mockJWTManager := new(providers.MockJWTManager)
 	mockLogger := new(providers.MockLogger)
 
-	useCase := NewRefreshTokenUseCase(mockUserRepo, mockTokenRepo, mockJWTManager, mockLogger)
+	useCase := NewRefreshTokenUseCase(mockUserRepo, mockTokenRepo, mockJWTManager, nil, mockLogger)
 
 	mockJWTManager.On("ValidateRefreshToken", givenInvalidToken).Return((*jwt.RegisteredClaims)(nil), assert.AnError)
 	mockLogger.On("Warn", mock.Anything, mock.Anything, mock.Anything).Return()
@@ -119,7 +119,7 @@ func TestRefreshTokenUseCase_Execute_WithExpiredToken_ReturnsUnauthorized(t *tes
 	mockJWTManager := new(providers.MockJWTManager)
 	mockLogger := new(providers.MockLogger)
 
-	useCase := NewRefreshTokenUseCase(mockUserRepo, mockTokenRepo, mockJWTManager, mockLogger)
+	useCase := NewRefreshTokenUseCase(mockUserRepo, mockTokenRepo, mockJWTManager, nil, mockLogger)
 
 	mockJWTManager.On("ValidateRefreshToken", givenExpiredToken).Return(givenClaims, nil)
 	mockTokenRepo.On("GetRefreshToken", mock.Anything, givenTokenHash).Return((*domain.RefreshToken)(nil), assert.AnError)
@@ -157,7 +157,7 @@ func TestRefreshTokenUseCase_Execute_WithRevokedToken_ReturnsUnauthorized(t *tes
 	mockJWTManager := new(providers.MockJWTManager)
 	mockLogger := new(providers.MockLogger)
 
-	useCase := NewRefreshTokenUseCase(mockUserRepo, mockTokenRepo, mockJWTManager, mockLogger)
+	useCase := NewRefreshTokenUseCase(mockUserRepo, mockTokenRepo, mockJWTManager, nil, mockLogger)
 
 	mockJWTManager.On("ValidateRefreshToken", givenRevokedToken).Return(givenClaims, nil)
 	mockTokenRepo.On("GetRefreshToken", mock.Anything, givenTokenHash).Return(givenStoredToken, nil)
@@ -194,7 +194,7 @@ func TestRefreshTokenUseCase_Execute_WithInvalidUserIDInToken_ReturnsUnauthorize
 	mockJWTManager := new(providers.MockJWTManager)
 	mockLogger := new(providers.MockLogger)
 
-	useCase := NewRefreshTokenUseCase(mockUserRepo, mockTokenRepo, mockJWTManager, mockLogger)
+	useCase := NewRefreshTokenUseCase(mockUserRepo, mockTokenRepo, mockJWTManager, nil, mockLogger)
 
 	mockJWTManager.On("ValidateRefreshToken", givenInvalidToken).Return(givenClaims, nil)
 	mockTokenRepo.On("GetRefreshToken", mock.Anything, givenTokenHash).Return(givenStoredToken, nil)
@@ -231,7 +231,7 @@ func TestRefreshTokenUseCase_Execute_WithNonExistentUser_ReturnsUnauthorized(t *
 	mockJWTManager := new(providers.MockJWTManager)
 	mockLogger := new(providers.MockLogger)
 
-	useCase := NewRefreshTokenUseCase(mockUserRepo, mockTokenRepo, mockJWTManager, mockLogger)
+	useCase := NewRefreshTokenUseCase(mockUserRepo, mockTokenRepo, mockJWTManager, nil, mockLogger)
 
 	mockJWTManager.On("ValidateRefreshToken", givenRefreshToken).Return(givenClaims, nil)
 	mockTokenRepo.On("GetRefreshToken", mock.Anything, givenTokenHash).Return(givenStoredToken, nil)
@@ -279,7 +279,7 @@ func TestRefreshTokenUseCase_Execute_WithInactiveUser_ReturnsForbidden(t *testin
 	mockJWTManager := new(providers.MockJWTManager)
 	mockLogger := new(providers.MockLogger)
 
-	useCase := NewRefreshTokenUseCase(mockUserRepo, mockTokenRepo, mockJWTManager, mockLogger)
+	useCase := NewRefreshTokenUseCase(mockUserRepo, mockTokenRepo, mockJWTManager, nil, mockLogger)
 
 	mockJWTManager.On("ValidateRefreshToken", givenRefreshToken).Return(givenClaims, nil)
 	mockTokenRepo.On("GetRefreshToken", mock.Anything, givenTokenHash).Return(givenStoredToken, nil)
@@ -328,12 +328,12 @@ func TestRefreshTokenUseCase_Execute_WhenAccessTokenGenerationFails_ReturnsInter
 	mockJWTManager := new(providers.MockJWTManager)
 	mockLogger := new(providers.MockLogger)
 
-	useCase := NewRefreshTokenUseCase(mockUserRepo, mockTokenRepo, mockJWTManager, mockLogger)
+	useCase := NewRefreshTokenUseCase(mockUserRepo, mockTokenRepo, mockJWTManager, nil, mockLogger)
 
 	mockJWTManager.On("ValidateRefreshToken", givenRefreshToken).Return(givenClaims, nil)
 	mockTokenRepo.On("GetRefreshToken", mock.Anything, givenTokenHash).Return(givenStoredToken, nil)
 	mockUserRepo.On("GetByID", mock.Anything, givenUserID).Return(givenUser, nil)
-	mockJWTManager.On("GenerateAccessToken", givenUserID, givenOrgID, givenEmail, mock.Anything).Return("", assert.AnError)
+	mockJWTManager.On("GenerateAccessToken", givenUserID, givenOrgID, givenEmail, mock.Anything, mock.Anything).Return("", assert.AnError)
 	mockLogger.On("Error", mock.Anything, assert.AnError, mock.Anything, mock.Anything).Return()
 
 	// When
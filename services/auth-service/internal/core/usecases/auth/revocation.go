@@ -0,0 +1,67 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	pkgLogger "github.com/giia/giia-core-engine/pkg/logger"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/providers"
+)
+
+// CheckTokenRevocationUseCase tells the HTTP middleware whether an otherwise
+// valid access token was revoked by logout or by an admin revoking every
+// session of its user.
+type CheckTokenRevocationUseCase struct {
+	tokenRepo providers.TokenRepository
+	logger    pkgLogger.Logger
+}
+
+func NewCheckTokenRevocationUseCase(
+	tokenRepo providers.TokenRepository,
+	logger pkgLogger.Logger,
+) *CheckTokenRevocationUseCase {
+	return &CheckTokenRevocationUseCase{
+		tokenRepo: tokenRepo,
+		logger:    logger,
+	}
+}
+
+func (uc *CheckTokenRevocationUseCase) Execute(ctx context.Context, tokenString string, claims *providers.Claims) bool {
+	return tokenRevoked(ctx, uc.tokenRepo, uc.logger, tokenString, claims)
+}
+
+// tokenRevoked reports whether the token is blacklisted or was issued before
+// the user's sessions were revoked. A Redis failure is logged and the token
+// accepted, so an outage does not sign everyone out.
+func tokenRevoked(ctx context.Context, tokenRepo providers.TokenRepository, logger pkgLogger.Logger, tokenString string, claims *providers.Claims) bool {
+	blacklisted, err := tokenRepo.IsTokenBlacklisted(ctx, tokenString)
+	if err != nil {
+		logger.Error(ctx, err, "Failed to check token blacklist", pkgLogger.Tags{
+			"user_id": claims.UserID,
+		})
+	} else if blacklisted {
+		return true
+	}
+
+	userID, err := uuid.Parse(claims.UserID)
+	if err != nil {
+		return false
+	}
+
+	revokedAt, err := tokenRepo.GetUserSessionsRevokedAt(ctx, userID)
+	if err != nil {
+		logger.Error(ctx, err, "Failed to check session revocation", pkgLogger.Tags{
+			"user_id": claims.UserID,
+		})
+		return false
+	}
+
+	if revokedAt == nil {
+		return false
+	}
+
+	// iat has second precision: a token issued in the same second as the
+	// revocation is treated as revoked
+	return claims.IssuedAt == nil || !claims.IssuedAt.Time.After(*revokedAt)
+}
@@ -0,0 +1,78 @@
+package auth
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	pkgErrors "github.com/giia/giia-core-engine/pkg/errors"
+	pkgLogger "github.com/giia/giia-core-engine/pkg/logger"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/domain"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/providers"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/usecases/audit"
+)
+
+type RevokeUserSessionsUseCase struct {
+	userRepo   providers.UserRepository
+	tokenRepo  providers.TokenRepository
+	jwtManager providers.JWTManager
+	auditRepo  providers.AuditLogRepository
+	logger     pkgLogger.Logger
+}
+
+func NewRevokeUserSessionsUseCase(
+	userRepo providers.UserRepository,
+	tokenRepo providers.TokenRepository,
+	jwtManager providers.JWTManager,
+	auditRepo providers.AuditLogRepository,
+	logger pkgLogger.Logger,
+) *RevokeUserSessionsUseCase {
+	return &RevokeUserSessionsUseCase{
+		userRepo:   userRepo,
+		tokenRepo:  tokenRepo,
+		jwtManager: jwtManager,
+		auditRepo:  auditRepo,
+		logger:     logger,
+	}
+}
+
+// Execute signs a user of orgID out everywhere: refresh tokens are revoked
+// and every access token issued so far is rejected until it would have
+// expired anyway.
+func (uc *RevokeUserSessionsUseCase) Execute(ctx context.Context, orgID, actorID, userID uuid.UUID) error {
+	if orgID == uuid.Nil {
+		return pkgErrors.NewBadRequest("organization ID cannot be empty")
+	}
+
+	user, err := uc.userRepo.GetByID(ctx, userID)
+	if err != nil || user.OrganizationID != orgID {
+		return pkgErrors.NewNotFound("user not found")
+	}
+
+	if err := uc.tokenRepo.RevokeUserSessions(ctx, userID, time.Now().UTC(), uc.jwtManager.GetAccessExpiry()); err != nil {
+		uc.logger.Error(ctx, err, "Failed to revoke user sessions", pkgLogger.Tags{
+			"organization_id": orgID.String(),
+			"user_id":         userID.String(),
+		})
+		return pkgErrors.NewInternalServerError("failed to revoke sessions")
+	}
+
+	if err := uc.tokenRepo.RevokeAllUserTokens(ctx, userID); err != nil {
+		uc.logger.Error(ctx, err, "Failed to revoke refresh tokens", pkgLogger.Tags{
+			"organization_id": orgID.String(),
+			"user_id":         userID.String(),
+		})
+		return pkgErrors.NewInternalServerError("failed to revoke sessions")
+	}
+
+	audit.Record(ctx, uc.auditRepo, uc.logger, orgID, actorID, domain.AuditActionSessionsRevoked, "user:"+userID.String(), "")
+
+	uc.logger.Info(ctx, "User sessions revoked", pkgLogger.Tags{
+		"organization_id": orgID.String(),
+		"user_id":         userID.String(),
+		"actor_id":        actorID.String(),
+	})
+
+	return nil
+}
@@ -0,0 +1,97 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/domain"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/providers"
+)
+
+func TestRevokeUserSessionsUseCase_Execute_WithUserInOrganization_RevokesAndAudits(t *testing.T) {
+	// Given
+	givenOrgID := uuid.New()
+	givenActorID := uuid.New()
+	givenUser := &domain.User{ID: uuid.New(), OrganizationID: givenOrgID}
+
+	mockUserRepo := new(providers.MockUserRepository)
+	mockTokenRepo := new(providers.MockTokenRepository)
+	mockJWTManager := new(providers.MockJWTManager)
+	mockAuditRepo := new(providers.MockAuditLogRepository)
+	mockLogger := new(providers.MockLogger)
+
+	useCase := NewRevokeUserSessionsUseCase(mockUserRepo, mockTokenRepo, mockJWTManager, mockAuditRepo, mockLogger)
+
+	mockUserRepo.On("GetByID", mock.Anything, givenUser.ID).Return(givenUser, nil)
+	mockJWTManager.On("GetAccessExpiry").Return(15 * time.Minute)
+	mockTokenRepo.On("RevokeUserSessions", mock.Anything, givenUser.ID, mock.AnythingOfType("time.Time"), 15*time.Minute).Return(nil)
+	mockTokenRepo.On("RevokeAllUserTokens", mock.Anything, givenUser.ID).Return(nil)
+	mockAuditRepo.On("Create", mock.Anything, mock.MatchedBy(func(l *domain.AuditLog) bool {
+		return l.Action == domain.AuditActionSessionsRevoked && l.ActorID == givenActorID
+	})).Return(nil)
+	mockLogger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	// When
+	err := useCase.Execute(context.Background(), givenOrgID, givenActorID, givenUser.ID)
+
+	// Then
+	assert.NoError(t, err)
+	mockTokenRepo.AssertExpectations(t)
+	mockAuditRepo.AssertExpectations(t)
+}
+
+func TestRevokeUserSessionsUseCase_Execute_WithUserOfAnotherOrganization_ReturnsNotFound(t *testing.T) {
+	// Given
+	givenUser := &domain.User{ID: uuid.New(), OrganizationID: uuid.New()}
+
+	mockUserRepo := new(providers.MockUserRepository)
+	mockTokenRepo := new(providers.MockTokenRepository)
+	mockJWTManager := new(providers.MockJWTManager)
+	mockAuditRepo := new(providers.MockAuditLogRepository)
+	mockLogger := new(providers.MockLogger)
+
+	useCase := NewRevokeUserSessionsUseCase(mockUserRepo, mockTokenRepo, mockJWTManager, mockAuditRepo, mockLogger)
+
+	mockUserRepo.On("GetByID", mock.Anything, givenUser.ID).Return(givenUser, nil)
+
+	// When
+	err := useCase.Execute(context.Background(), uuid.New(), uuid.New(), givenUser.ID)
+
+	// Then
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "user not found")
+	mockTokenRepo.AssertNotCalled(t, "RevokeUserSessions")
+}
+
+func TestRevokeUserSessionsUseCase_Execute_WithRedisFailure_ReturnsInternalError(t *testing.T) {
+	// Given
+	givenOrgID := uuid.New()
+	givenUser := &domain.User{ID: uuid.New(), OrganizationID: givenOrgID}
+
+	mockUserRepo := new(providers.MockUserRepository)
+	mockTokenRepo := new(providers.MockTokenRepository)
+	mockJWTManager := new(providers.MockJWTManager)
+	mockAuditRepo := new(providers.MockAuditLogRepository)
+	mockLogger := new(providers.MockLogger)
+
+	useCase := NewRevokeUserSessionsUseCase(mockUserRepo, mockTokenRepo, mockJWTManager, mockAuditRepo, mockLogger)
+
+	mockUserRepo.On("GetByID", mock.Anything, givenUser.ID).Return(givenUser, nil)
+	mockJWTManager.On("GetAccessExpiry").Return(15 * time.Minute)
+	mockTokenRepo.On("RevokeUserSessions", mock.Anything, givenUser.ID, mock.Anything, mock.Anything).Return(errors.New("connection refused"))
+	mockLogger.On("Error", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
+
+	// When
+	err := useCase.Execute(context.Background(), givenOrgID, uuid.New(), givenUser.ID)
+
+	// Then
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to revoke sessions")
+	mockAuditRepo.AssertNotCalled(t, "Create")
+}
@@ -0,0 +1,35 @@
+package auth
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
+	pkgLogger "github.com/giia/giia-core-engine/pkg/logger"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/domain"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/providers"
+)
+
+// publishSecurityEvent never fails the calling flow: a missed notification must
+// not block a login or password change.
+func publishSecurityEvent(ctx context.Context, publisher providers.SecurityEventPublisher, logger pkgLogger.Logger, event *domain.SecurityEvent) {
+	if event.OccurredAt.IsZero() {
+		event.OccurredAt = time.Now().UTC()
+	}
+
+	if err := publisher.PublishSecurityEvent(ctx, event); err != nil {
+		logger.Error(ctx, err, "Failed to publish security event", pkgLogger.Tags{
+			"user_id":    event.UserID.String(),
+			"event_type": string(event.Type),
+		})
+	}
+}
+
+func deviceFingerprint(userAgent string) string {
+	if userAgent == "" {
+		return ""
+	}
+	hash := sha256.Sum256([]byte(userAgent))
+	return hex.EncodeToString(hash[:])
+}
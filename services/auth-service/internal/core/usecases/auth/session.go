@@ -0,0 +1,230 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"time"
+
+	"github.com/google/uuid"
+
+	pkgErrors "github.com/giia/giia-core-engine/pkg/errors"
+	pkgLogger "github.com/giia/giia-core-engine/pkg/logger"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/domain"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/providers"
+)
+
+const (
+	loginChallengeTTL = 5 * time.Minute
+
+	// A challenge is discarded after this many wrong codes so the 10^6 TOTP
+	// space cannot be brute forced within its TTL.
+	maxLoginChallengeAttempts = 5
+
+	// Travel faster than a commercial flight between two logins is treated as
+	// impossible. Short hops are ignored because IP geolocation is imprecise.
+	maxTravelSpeedKmh   = 1000.0
+	minTravelDistanceKm = 500.0
+)
+
+// loginContext captures where a login comes from and why it looks suspicious.
+type loginContext struct {
+	deviceID  string
+	ipAddress string
+	userAgent string
+	location  *domain.GeoLocation
+	reasons   []domain.SuspiciousLoginReason
+}
+
+func (lc *loginContext) suspicious() bool {
+	return len(lc.reasons) > 0
+}
+
+func assessLogin(
+	ctx context.Context,
+	tokenRepo providers.TokenRepository,
+	geoResolver providers.GeoIPResolver,
+	logger pkgLogger.Logger,
+	user *domain.User,
+	ipAddress, userAgent string,
+) *loginContext {
+	lc := &loginContext{
+		deviceID:  deviceFingerprint(userAgent),
+		ipAddress: ipAddress,
+		userAgent: userAgent,
+	}
+
+	if ipAddress != "" {
+		location, err := geoResolver.Resolve(ctx, ipAddress)
+		if err != nil {
+			logger.Warn(ctx, "Failed to resolve login location", pkgLogger.Tags{
+				"user_id":    user.ID.String(),
+				"ip_address": ipAddress,
+			})
+		}
+		lc.location = location
+	}
+
+	// First-ever logins have nothing to compare against
+	if user.LastLoginAt == nil {
+		return lc
+	}
+
+	if lc.deviceID != "" {
+		known, err := tokenRepo.HasDeviceLogin(ctx, user.ID, lc.deviceID)
+		if err != nil {
+			logger.Error(ctx, err, "Failed to check known devices", pkgLogger.Tags{
+				"user_id": user.ID.String(),
+			})
+		} else if !known {
+			lc.reasons = append(lc.reasons, domain.SuspiciousLoginNewDevice)
+		}
+	}
+
+	if lc.location != nil {
+		previous, err := tokenRepo.GetLatestRefreshToken(ctx, user.ID)
+		if err == nil && isImpossibleTravel(previous, lc.location, time.Now()) {
+			lc.reasons = append(lc.reasons, domain.SuspiciousLoginImpossibleTravel)
+		}
+	}
+
+	return lc
+}
+
+func isImpossibleTravel(previous *domain.RefreshToken, current *domain.GeoLocation, now time.Time) bool {
+	previousLocation := previous.Location()
+	if previousLocation == nil {
+		return false
+	}
+
+	distance := previousLocation.DistanceKm(current)
+	if distance < minTravelDistanceKm {
+		return false
+	}
+
+	elapsed := now.Sub(previous.CreatedAt).Hours()
+	if elapsed <= 0 {
+		return true
+	}
+
+	return distance/elapsed > maxTravelSpeedKmh
+}
+
+func publishSuspiciousLogin(ctx context.Context, publisher providers.SecurityEventPublisher, logger pkgLogger.Logger, user *domain.User, lc *loginContext) {
+	for _, reason := range lc.reasons {
+		eventType := domain.SecurityEventNewDeviceLogin
+		if reason == domain.SuspiciousLoginImpossibleTravel {
+			eventType = domain.SecurityEventImpossibleTravel
+		}
+
+		publishSecurityEvent(ctx, publisher, logger, &domain.SecurityEvent{
+			Type:           eventType,
+			UserID:         user.ID,
+			OrganizationID: user.OrganizationID,
+			Email:          user.Email,
+			IPAddress:      lc.ipAddress,
+			UserAgent:      lc.userAgent,
+			Location:       lc.location,
+		})
+	}
+}
+
+// tokenPermissions resolves the permissions to embed in an access token. On
+// failure the token is issued without them and services fall back to the
+// CheckPermission RPC, so a permission lookup problem never blocks sign-in.
+func tokenPermissions(ctx context.Context, resolver providers.PermissionResolver, logger pkgLogger.Logger, userID, orgID uuid.UUID) []string {
+	if resolver == nil {
+		return nil
+	}
+
+	permissions, err := resolver.ResolvePermissions(ctx, userID, orgID)
+	if err != nil {
+		logger.Warn(ctx, "Issuing access token without embedded permissions", pkgLogger.Tags{
+			"user_id":         userID.String(),
+			"organization_id": orgID.String(),
+			"error":           err.Error(),
+		})
+		return nil
+	}
+
+	return permissions
+}
+
+func generateChallengeToken() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
+
+// sessionIssuer mints the token pair for an authenticated user and records the
+// session with its device and location.
+type sessionIssuer struct {
+	userRepo    providers.UserRepository
+	tokenRepo   providers.TokenRepository
+	jwtManager  providers.JWTManager
+	permissions providers.PermissionResolver
+	logger      pkgLogger.Logger
+}
+
+func (s *sessionIssuer) issue(ctx context.Context, user *domain.User, lc *loginContext) (*domain.LoginResponse, error) {
+	accessToken, err := s.jwtManager.GenerateAccessToken(
+		user.ID,
+		user.OrganizationID,
+		user.Email,
+		nil,
+		tokenPermissions(ctx, s.permissions, s.logger, user.ID, user.OrganizationID),
+	)
+	if err != nil {
+		s.logger.Error(ctx, err, "Failed to generate access token", pkgLogger.Tags{
+			"user_id": user.ID.String(),
+		})
+		return nil, pkgErrors.NewInternalServerError("failed to generate access token")
+	}
+
+	refreshTokenString, err := s.jwtManager.GenerateRefreshToken(user.ID)
+	if err != nil {
+		s.logger.Error(ctx, err, "Failed to generate refresh token", pkgLogger.Tags{
+			"user_id": user.ID.String(),
+		})
+		return nil, pkgErrors.NewInternalServerError("failed to generate refresh token")
+	}
+
+	refreshToken := &domain.RefreshToken{
+		TokenHash: hashToken(refreshTokenString),
+		UserID:    user.ID,
+		ExpiresAt: time.Now().Add(s.jwtManager.GetRefreshExpiry()),
+		Revoked:   false,
+		DeviceID:  lc.deviceID,
+		UserAgent: lc.userAgent,
+		IPAddress: lc.ipAddress,
+	}
+	refreshToken.SetLocation(lc.location)
+
+	if err := s.tokenRepo.StoreRefreshToken(ctx, refreshToken); err != nil {
+		s.logger.Error(ctx, err, "Failed to store refresh token", pkgLogger.Tags{
+			"user_id": user.ID.String(),
+		})
+		return nil, pkgErrors.NewInternalServerError("failed to store refresh token")
+	}
+
+	if err := s.userRepo.UpdateLastLogin(ctx, user.ID); err != nil {
+		s.logger.Error(ctx, err, "Failed to update last login", pkgLogger.Tags{
+			"user_id": user.ID.String(),
+		})
+	}
+
+	s.logger.Info(ctx, "User logged in successfully", pkgLogger.Tags{
+		"user_id":         user.ID.String(),
+		"email":           user.Email,
+		"organization_id": user.OrganizationID.String(),
+	})
+
+	return &domain.LoginResponse{
+		AccessToken:  accessToken,
+		RefreshToken: refreshTokenString,
+		ExpiresIn:    int(s.jwtManager.GetAccessExpiry().Seconds()),
+		User:         user.ToResponse(),
+	}, nil
+}
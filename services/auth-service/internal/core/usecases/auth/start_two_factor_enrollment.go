@@ -0,0 +1,86 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+
+	pkgErrors "github.com/giia/giia-core-engine/pkg/errors"
+	pkgLogger "github.com/giia/giia-core-engine/pkg/logger"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/domain"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/providers"
+)
+
+type StartTwoFactorEnrollmentUseCase struct {
+	userRepo     providers.UserRepository
+	totpEnroller providers.TOTPEnroller
+	logger       pkgLogger.Logger
+}
+
+func NewStartTwoFactorEnrollmentUseCase(
+	userRepo providers.UserRepository,
+	totpEnroller providers.TOTPEnroller,
+	logger pkgLogger.Logger,
+) *StartTwoFactorEnrollmentUseCase {
+	return &StartTwoFactorEnrollmentUseCase{
+		userRepo:     userRepo,
+		totpEnroller: totpEnroller,
+		logger:       logger,
+	}
+}
+
+// Execute stores a new TOTP secret for the user after re-checking the
+// password. 2FA stays disabled until ConfirmTwoFactorEnrollmentUseCase sees a
+// code from it, so an abandoned enrollment never locks the user out.
+func (uc *StartTwoFactorEnrollmentUseCase) Execute(ctx context.Context, userID uuid.UUID, req *domain.StartTwoFactorEnrollmentRequest) (*domain.TwoFactorEnrollmentResponse, error) {
+	if req.Password == "" {
+		return nil, pkgErrors.NewBadRequest("password is required")
+	}
+
+	user, err := uc.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		uc.logger.Error(ctx, err, "Failed to get user", pkgLogger.Tags{
+			"user_id": userID.String(),
+		})
+		return nil, pkgErrors.NewNotFound("user not found")
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(req.Password)); err != nil {
+		uc.logger.Warn(ctx, "2FA enrollment attempt with invalid password", pkgLogger.Tags{
+			"user_id": userID.String(),
+		})
+		return nil, pkgErrors.NewUnauthorized("password is incorrect")
+	}
+
+	// Replacing the secret of an enabled account would let a stolen session
+	// move 2FA to the attacker's device; it has to be disabled first
+	if user.TwoFactorEnabled {
+		return nil, pkgErrors.NewConflict("two-factor authentication is already enabled")
+	}
+
+	secret, otpauthURL, err := uc.totpEnroller.GenerateEnrollment(user.Email)
+	if err != nil {
+		uc.logger.Error(ctx, err, "Failed to generate 2FA secret", pkgLogger.Tags{
+			"user_id": userID.String(),
+		})
+		return nil, pkgErrors.NewInternalServerError("failed to start two-factor enrollment")
+	}
+
+	user.TwoFactorSecret = secret
+	if err := uc.userRepo.Update(ctx, user); err != nil {
+		uc.logger.Error(ctx, err, "Failed to store pending 2FA secret", pkgLogger.Tags{
+			"user_id": userID.String(),
+		})
+		return nil, pkgErrors.NewInternalServerError("failed to start two-factor enrollment")
+	}
+
+	uc.logger.Info(ctx, "Two-factor enrollment started", pkgLogger.Tags{
+		"user_id": userID.String(),
+	})
+
+	return &domain.TwoFactorEnrollmentResponse{
+		Secret:     secret,
+		OTPAuthURL: otpauthURL,
+	}, nil
+}
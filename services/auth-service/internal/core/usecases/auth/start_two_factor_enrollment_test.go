@@ -0,0 +1,109 @@
+package auth
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/domain"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/providers"
+)
+
+func TestStartTwoFactorEnrollmentUseCase_Execute_WithValidPassword_StoresPendingSecret(t *testing.T) {
+	// Given
+	givenUserID := uuid.New()
+	givenHashedPassword, _ := bcrypt.GenerateFromPassword([]byte("Password1!"), bcrypt.DefaultCost)
+
+	givenUser := &domain.User{
+		ID:       givenUserID,
+		Email:    "user@example.com",
+		Password: string(givenHashedPassword),
+	}
+
+	mockUserRepo := new(providers.MockUserRepository)
+	mockEnroller := new(providers.MockTOTPEnroller)
+	mockLogger := new(providers.MockLogger)
+
+	useCase := NewStartTwoFactorEnrollmentUseCase(mockUserRepo, mockEnroller, mockLogger)
+
+	mockUserRepo.On("GetByID", mock.Anything, givenUserID).Return(givenUser, nil)
+	mockEnroller.On("GenerateEnrollment", "user@example.com").Return("JBSWY3DPEHPK3PXP", "otpauth://totp/GIIA:user@example.com?secret=JBSWY3DPEHPK3PXP", nil)
+	mockUserRepo.On("Update", mock.Anything, mock.MatchedBy(func(user *domain.User) bool {
+		return !user.TwoFactorEnabled && user.TwoFactorSecret == "JBSWY3DPEHPK3PXP"
+	})).Return(nil)
+	mockLogger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	// When
+	response, err := useCase.Execute(context.Background(), givenUserID, &domain.StartTwoFactorEnrollmentRequest{Password: "Password1!"})
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, "JBSWY3DPEHPK3PXP", response.Secret)
+	assert.Contains(t, response.OTPAuthURL, "otpauth://totp/")
+	mockUserRepo.AssertExpectations(t)
+}
+
+func TestStartTwoFactorEnrollmentUseCase_Execute_WithWrongPassword_ReturnsUnauthorized(t *testing.T) {
+	// Given
+	givenUserID := uuid.New()
+	givenHashedPassword, _ := bcrypt.GenerateFromPassword([]byte("Password1!"), bcrypt.DefaultCost)
+
+	givenUser := &domain.User{
+		ID:       givenUserID,
+		Password: string(givenHashedPassword),
+	}
+
+	mockUserRepo := new(providers.MockUserRepository)
+	mockEnroller := new(providers.MockTOTPEnroller)
+	mockLogger := new(providers.MockLogger)
+
+	useCase := NewStartTwoFactorEnrollmentUseCase(mockUserRepo, mockEnroller, mockLogger)
+
+	mockUserRepo.On("GetByID", mock.Anything, givenUserID).Return(givenUser, nil)
+	mockLogger.On("Warn", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	// When
+	response, err := useCase.Execute(context.Background(), givenUserID, &domain.StartTwoFactorEnrollmentRequest{Password: "wrong"})
+
+	// Then
+	assert.Error(t, err)
+	assert.Nil(t, response)
+	assert.Contains(t, err.Error(), "password is incorrect")
+	mockEnroller.AssertNotCalled(t, "GenerateEnrollment", mock.Anything)
+	mockUserRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+}
+
+func TestStartTwoFactorEnrollmentUseCase_Execute_WithTwoFactorEnabled_ReturnsConflict(t *testing.T) {
+	// Given
+	givenUserID := uuid.New()
+	givenHashedPassword, _ := bcrypt.GenerateFromPassword([]byte("Password1!"), bcrypt.DefaultCost)
+
+	givenUser := &domain.User{
+		ID:               givenUserID,
+		Password:         string(givenHashedPassword),
+		TwoFactorEnabled: true,
+		TwoFactorSecret:  "JBSWY3DPEHPK3PXP",
+	}
+
+	mockUserRepo := new(providers.MockUserRepository)
+	mockEnroller := new(providers.MockTOTPEnroller)
+	mockLogger := new(providers.MockLogger)
+
+	useCase := NewStartTwoFactorEnrollmentUseCase(mockUserRepo, mockEnroller, mockLogger)
+
+	mockUserRepo.On("GetByID", mock.Anything, givenUserID).Return(givenUser, nil)
+
+	// When
+	response, err := useCase.Execute(context.Background(), givenUserID, &domain.StartTwoFactorEnrollmentRequest{Password: "Password1!"})
+
+	// Then
+	assert.Error(t, err)
+	assert.Nil(t, response)
+	assert.Contains(t, err.Error(), "already enabled")
+	assert.Equal(t, "JBSWY3DPEHPK3PXP", givenUser.TwoFactorSecret)
+	mockUserRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+}
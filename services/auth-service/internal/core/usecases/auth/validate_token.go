@@ -13,6 +13,7 @@ import (
 
 type ValidateTokenUseCase struct {
 	userRepo   providers.UserRepository
+	tokenRepo  providers.TokenRepository
 	jwtManager providers.JWTManager
 	logger     pkgLogger.Logger
 }
@@ -28,11 +29,13 @@ type TokenValidationResult struct {
 
 func NewValidateTokenUseCase(
 	userRepo providers.UserRepository,
+	tokenRepo providers.TokenRepository,
 	jwtManager providers.JWTManager,
 	logger pkgLogger.Logger,
 ) *ValidateTokenUseCase {
 	return &ValidateTokenUseCase{
 		userRepo:   userRepo,
+		tokenRepo:  tokenRepo,
 		jwtManager: jwtManager,
 		logger:     logger,
 	}
@@ -73,6 +76,15 @@ func (uc *ValidateTokenUseCase) Execute(ctx context.Context, tokenString string)
 		}, nil
 	}
 
+	if tokenRevoked(ctx, uc.tokenRepo, uc.logger, tokenString, claims) {
+		uc.logger.Warn(ctx, "Token validation failed - token revoked", pkgLogger.Tags{
+			"user_id": userID.String(),
+		})
+		return &TokenValidationResult{
+			Valid: false,
+		}, nil
+	}
+
 	user, err := uc.userRepo.GetByID(ctx, userID)
 	if err != nil {
 		uc.logger.Warn(ctx, "User not found for valid token", pkgLogger.Tags{
@@ -41,12 +41,15 @@ func TestValidateTokenUseCase_Execute_WithValidToken_ReturnsValidResult(t *testi
 	}
 
 	mockUserRepo := new(providers.MockUserRepository)
+	mockTokenRepo := new(providers.MockTokenRepository)
 	mockJWTManager := new(providers.MockJWTManager)
 	mockLogger := new(providers.MockLogger)
 
-	useCase := NewValidateTokenUseCase(mockUserRepo, mockJWTManager, mockLogger)
+	useCase := NewValidateTokenUseCase(mockUserRepo, mockTokenRepo, mockJWTManager, mockLogger)
 
 	mockJWTManager.On("ValidateAccessToken", givenToken).Return(givenClaims, nil)
+	mockTokenRepo.On("IsTokenBlacklisted", mock.Anything, givenToken).Return(false, nil)
+	mockTokenRepo.On("GetUserSessionsRevokedAt", mock.Anything, givenUserID).Return(nil, nil)
 	mockUserRepo.On("GetByID", mock.Anything, givenUserID).Return(givenUser, nil)
 	mockLogger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
 
@@ -68,10 +71,11 @@ func TestValidateTokenUseCase_Execute_WithValidToken_ReturnsValidResult(t *testi
 func TestValidateTokenUseCase_Execute_WithEmptyToken_ReturnsBadRequest(t *testing.T) {
 	// Given
 	mockUserRepo := new(providers.MockUserRepository)
+	mockTokenRepo := new(providers.MockTokenRepository)
 	mockJWTManager := new(providers.MockJWTManager)
 	mockLogger := new(providers.MockLogger)
 
-	useCase := NewValidateTokenUseCase(mockUserRepo, mockJWTManager, mockLogger)
+	useCase := NewValidateTokenUseCase(mockUserRepo, mockTokenRepo, mockJWTManager, mockLogger)
 
 	// When
 	result, err := useCase.Execute(context.Background(), "")
@@ -87,10 +91,11 @@ func TestValidateTokenUseCase_Execute_WithInvalidToken_ReturnsInvalidResult(t *t
 	givenInvalidToken := "invalid.jwt.token"
 
 	mockUserRepo := new(providers.MockUserRepository)
+	mockTokenRepo := new(providers.MockTokenRepository)
 	mockJWTManager := new(providers.MockJWTManager)
 	mockLogger := new(providers.MockLogger)
 
-	useCase := NewValidateTokenUseCase(mockUserRepo, mockJWTManager, mockLogger)
+	useCase := NewValidateTokenUseCase(mockUserRepo, mockTokenRepo, mockJWTManager, mockLogger)
 
 	mockJWTManager.On("ValidateAccessToken", givenInvalidToken).Return((*providers.Claims)(nil), assert.AnError)
 	mockLogger.On("Warn", mock.Anything, mock.Anything, mock.Anything).Return()
@@ -110,10 +115,11 @@ func TestValidateTokenUseCase_Execute_WithExpiredToken_ReturnsInvalidResult(t *t
 	givenExpiredToken := "expired.jwt.token"
 
 	mockUserRepo := new(providers.MockUserRepository)
+	mockTokenRepo := new(providers.MockTokenRepository)
 	mockJWTManager := new(providers.MockJWTManager)
 	mockLogger := new(providers.MockLogger)
 
-	useCase := NewValidateTokenUseCase(mockUserRepo, mockJWTManager, mockLogger)
+	useCase := NewValidateTokenUseCase(mockUserRepo, mockTokenRepo, mockJWTManager, mockLogger)
 
 	mockJWTManager.On("ValidateAccessToken", givenExpiredToken).Return((*providers.Claims)(nil), assert.AnError)
 	mockLogger.On("Warn", mock.Anything, mock.Anything, mock.Anything).Return()
@@ -140,10 +146,11 @@ func TestValidateTokenUseCase_Execute_WithInvalidUserIDInToken_ReturnsInvalidRes
 	}
 
 	mockUserRepo := new(providers.MockUserRepository)
+	mockTokenRepo := new(providers.MockTokenRepository)
 	mockJWTManager := new(providers.MockJWTManager)
 	mockLogger := new(providers.MockLogger)
 
-	useCase := NewValidateTokenUseCase(mockUserRepo, mockJWTManager, mockLogger)
+	useCase := NewValidateTokenUseCase(mockUserRepo, mockTokenRepo, mockJWTManager, mockLogger)
 
 	mockJWTManager.On("ValidateAccessToken", givenToken).Return(givenClaims, nil)
 	mockLogger.On("Error", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
@@ -171,10 +178,11 @@ func TestValidateTokenUseCase_Execute_WithInvalidOrganizationIDInToken_ReturnsIn
 	}
 
 	mockUserRepo := new(providers.MockUserRepository)
+	mockTokenRepo := new(providers.MockTokenRepository)
 	mockJWTManager := new(providers.MockJWTManager)
 	mockLogger := new(providers.MockLogger)
 
-	useCase := NewValidateTokenUseCase(mockUserRepo, mockJWTManager, mockLogger)
+	useCase := NewValidateTokenUseCase(mockUserRepo, mockTokenRepo, mockJWTManager, mockLogger)
 
 	mockJWTManager.On("ValidateAccessToken", givenToken).Return(givenClaims, nil)
 	mockLogger.On("Error", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
@@ -203,12 +211,15 @@ func TestValidateTokenUseCase_Execute_WithUserNotFound_ReturnsInvalidResult(t *t
 	}
 
 	mockUserRepo := new(providers.MockUserRepository)
+	mockTokenRepo := new(providers.MockTokenRepository)
 	mockJWTManager := new(providers.MockJWTManager)
 	mockLogger := new(providers.MockLogger)
 
-	useCase := NewValidateTokenUseCase(mockUserRepo, mockJWTManager, mockLogger)
+	useCase := NewValidateTokenUseCase(mockUserRepo, mockTokenRepo, mockJWTManager, mockLogger)
 
 	mockJWTManager.On("ValidateAccessToken", givenToken).Return(givenClaims, nil)
+	mockTokenRepo.On("IsTokenBlacklisted", mock.Anything, givenToken).Return(false, nil)
+	mockTokenRepo.On("GetUserSessionsRevokedAt", mock.Anything, givenUserID).Return(nil, nil)
 	mockUserRepo.On("GetByID", mock.Anything, givenUserID).Return((*domain.User)(nil), assert.AnError)
 	mockLogger.On("Warn", mock.Anything, mock.Anything, mock.Anything).Return()
 
@@ -244,12 +255,15 @@ func TestValidateTokenUseCase_Execute_WithInactiveUser_ReturnsInvalidResult(t *t
 	}
 
 	mockUserRepo := new(providers.MockUserRepository)
+	mockTokenRepo := new(providers.MockTokenRepository)
 	mockJWTManager := new(providers.MockJWTManager)
 	mockLogger := new(providers.MockLogger)
 
-	useCase := NewValidateTokenUseCase(mockUserRepo, mockJWTManager, mockLogger)
+	useCase := NewValidateTokenUseCase(mockUserRepo, mockTokenRepo, mockJWTManager, mockLogger)
 
 	mockJWTManager.On("ValidateAccessToken", givenToken).Return(givenClaims, nil)
+	mockTokenRepo.On("IsTokenBlacklisted", mock.Anything, givenToken).Return(false, nil)
+	mockTokenRepo.On("GetUserSessionsRevokedAt", mock.Anything, givenUserID).Return(nil, nil)
 	mockUserRepo.On("GetByID", mock.Anything, givenUserID).Return(givenUser, nil)
 	mockLogger.On("Warn", mock.Anything, mock.Anything, mock.Anything).Return()
 
@@ -285,12 +299,15 @@ func TestValidateTokenUseCase_Execute_WithSuspendedUser_ReturnsInvalidResult(t *
 	}
 
 	mockUserRepo := new(providers.MockUserRepository)
+	mockTokenRepo := new(providers.MockTokenRepository)
 	mockJWTManager := new(providers.MockJWTManager)
 	mockLogger := new(providers.MockLogger)
 
-	useCase := NewValidateTokenUseCase(mockUserRepo, mockJWTManager, mockLogger)
+	useCase := NewValidateTokenUseCase(mockUserRepo, mockTokenRepo, mockJWTManager, mockLogger)
 
 	mockJWTManager.On("ValidateAccessToken", givenToken).Return(givenClaims, nil)
+	mockTokenRepo.On("IsTokenBlacklisted", mock.Anything, givenToken).Return(false, nil)
+	mockTokenRepo.On("GetUserSessionsRevokedAt", mock.Anything, givenUserID).Return(nil, nil)
 	mockUserRepo.On("GetByID", mock.Anything, givenUserID).Return(givenUser, nil)
 	mockLogger.On("Warn", mock.Anything, mock.Anything, mock.Anything).Return()
 
@@ -304,3 +321,68 @@ func TestValidateTokenUseCase_Execute_WithSuspendedUser_ReturnsInvalidResult(t *
 	mockUserRepo.AssertExpectations(t)
 	mockJWTManager.AssertExpectations(t)
 }
+
+func TestValidateTokenUseCase_Execute_WithBlacklistedToken_ReturnsInvalidResult(t *testing.T) {
+	// Given
+	givenUserID := uuid.New()
+	givenToken := "logged_out_token"
+
+	givenClaims := &providers.Claims{
+		UserID:         givenUserID.String(),
+		OrganizationID: uuid.New().String(),
+	}
+
+	mockUserRepo := new(providers.MockUserRepository)
+	mockTokenRepo := new(providers.MockTokenRepository)
+	mockJWTManager := new(providers.MockJWTManager)
+	mockLogger := new(providers.MockLogger)
+
+	useCase := NewValidateTokenUseCase(mockUserRepo, mockTokenRepo, mockJWTManager, mockLogger)
+
+	mockJWTManager.On("ValidateAccessToken", givenToken).Return(givenClaims, nil)
+	mockTokenRepo.On("IsTokenBlacklisted", mock.Anything, givenToken).Return(true, nil)
+	mockLogger.On("Warn", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	// When
+	result, err := useCase.Execute(context.Background(), givenToken)
+
+	// Then
+	assert.NoError(t, err)
+	assert.False(t, result.Valid)
+	mockUserRepo.AssertNotCalled(t, "GetByID")
+}
+
+func TestValidateTokenUseCase_Execute_WithTokenIssuedBeforeSessionsRevoked_ReturnsInvalidResult(t *testing.T) {
+	// Given
+	givenUserID := uuid.New()
+	givenToken := "old_session_token"
+	givenRevokedAt := time.Now().UTC().Truncate(time.Second)
+
+	givenClaims := &providers.Claims{
+		UserID:         givenUserID.String(),
+		OrganizationID: uuid.New().String(),
+		RegisteredClaims: jwt.RegisteredClaims{
+			IssuedAt: jwt.NewNumericDate(givenRevokedAt.Add(-time.Minute)),
+		},
+	}
+
+	mockUserRepo := new(providers.MockUserRepository)
+	mockTokenRepo := new(providers.MockTokenRepository)
+	mockJWTManager := new(providers.MockJWTManager)
+	mockLogger := new(providers.MockLogger)
+
+	useCase := NewValidateTokenUseCase(mockUserRepo, mockTokenRepo, mockJWTManager, mockLogger)
+
+	mockJWTManager.On("ValidateAccessToken", givenToken).Return(givenClaims, nil)
+	mockTokenRepo.On("IsTokenBlacklisted", mock.Anything, givenToken).Return(false, nil)
+	mockTokenRepo.On("GetUserSessionsRevokedAt", mock.Anything, givenUserID).Return(&givenRevokedAt, nil)
+	mockLogger.On("Warn", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	// When
+	result, err := useCase.Execute(context.Background(), givenToken)
+
+	// Then
+	assert.NoError(t, err)
+	assert.False(t, result.Valid)
+	mockUserRepo.AssertNotCalled(t, "GetByID")
+}
@@ -0,0 +1,120 @@
+package auth
+
+import (
+	"context"
+
+	pkgErrors "github.com/giia/giia-core-engine/pkg/errors"
+	pkgLogger "github.com/giia/giia-core-engine/pkg/logger"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/domain"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/providers"
+)
+
+type VerifyLoginChallengeUseCase struct {
+	userRepo      providers.UserRepository
+	tokenRepo     providers.TokenRepository
+	totpValidator providers.TOTPValidator
+	sessions      *sessionIssuer
+	logger        pkgLogger.Logger
+}
+
+func NewVerifyLoginChallengeUseCase(
+	userRepo providers.UserRepository,
+	tokenRepo providers.TokenRepository,
+	jwtManager providers.JWTManager,
+	totpValidator providers.TOTPValidator,
+	permissions providers.PermissionResolver,
+	logger pkgLogger.Logger,
+) *VerifyLoginChallengeUseCase {
+	return &VerifyLoginChallengeUseCase{
+		userRepo:      userRepo,
+		tokenRepo:     tokenRepo,
+		totpValidator: totpValidator,
+		sessions: &sessionIssuer{
+			userRepo:    userRepo,
+			tokenRepo:   tokenRepo,
+			jwtManager:  jwtManager,
+			permissions: permissions,
+			logger:      logger,
+		},
+		logger: logger,
+	}
+}
+
+func (uc *VerifyLoginChallengeUseCase) Execute(ctx context.Context, req *domain.VerifyLoginChallengeRequest) (*domain.LoginResponse, error) {
+	if req.ChallengeToken == "" {
+		return nil, pkgErrors.NewBadRequest("challenge token is required")
+	}
+
+	if req.Code == "" {
+		return nil, pkgErrors.NewBadRequest("verification code is required")
+	}
+
+	challengeHash := hashToken(req.ChallengeToken)
+	challenge, err := uc.tokenRepo.GetLoginChallenge(ctx, challengeHash)
+	if err != nil {
+		return nil, pkgErrors.NewUnauthorized("invalid or expired challenge")
+	}
+
+	attempts, err := uc.tokenRepo.RecordLoginChallengeAttempt(ctx, challengeHash, loginChallengeTTL)
+	if err != nil {
+		uc.logger.Error(ctx, err, "Failed to record login challenge attempt", pkgLogger.Tags{
+			"user_id": challenge.UserID.String(),
+		})
+		return nil, pkgErrors.NewInternalServerError("failed to complete verification")
+	}
+
+	if attempts > maxLoginChallengeAttempts {
+		uc.discardChallenge(ctx, challengeHash, challenge)
+		return nil, pkgErrors.NewUnauthorized("too many verification attempts, please log in again")
+	}
+
+	user, err := uc.userRepo.GetByID(ctx, challenge.UserID)
+	if err != nil {
+		uc.logger.Error(ctx, err, "Failed to get user for login challenge", pkgLogger.Tags{
+			"user_id": challenge.UserID.String(),
+		})
+		return nil, pkgErrors.NewUnauthorized("invalid or expired challenge")
+	}
+
+	if user.Status != domain.UserStatusActive {
+		return nil, pkgErrors.NewForbidden("account is not active")
+	}
+
+	if !user.TwoFactorEnabled || !uc.totpValidator.ValidateCode(user.TwoFactorSecret, req.Code) {
+		uc.logger.Warn(ctx, "Invalid step-up verification code", pkgLogger.Tags{
+			"user_id":  user.ID.String(),
+			"attempts": attempts,
+		})
+		if attempts >= maxLoginChallengeAttempts {
+			uc.discardChallenge(ctx, challengeHash, challenge)
+		}
+		return nil, pkgErrors.NewUnauthorized("invalid verification code")
+	}
+
+	// Consuming atomically makes sure a code racing against itself can only
+	// issue one session.
+	challenge, err = uc.tokenRepo.ConsumeLoginChallenge(ctx, challengeHash)
+	if err != nil {
+		return nil, pkgErrors.NewUnauthorized("invalid or expired challenge")
+	}
+
+	return uc.sessions.issue(ctx, user, &loginContext{
+		deviceID:  challenge.DeviceID,
+		ipAddress: challenge.IPAddress,
+		userAgent: challenge.UserAgent,
+		location:  challenge.Location,
+		reasons:   challenge.Reasons,
+	})
+}
+
+func (uc *VerifyLoginChallengeUseCase) discardChallenge(ctx context.Context, challengeHash string, challenge *domain.LoginChallenge) {
+	uc.logger.Warn(ctx, "Login challenge discarded after too many attempts", pkgLogger.Tags{
+		"user_id": challenge.UserID.String(),
+	})
+
+	if err := uc.tokenRepo.DeleteLoginChallenge(ctx, challengeHash); err != nil {
+		uc.logger.Error(ctx, err, "Failed to delete login challenge", pkgLogger.Tags{
+			"user_id": challenge.UserID.String(),
+		})
+	}
+}
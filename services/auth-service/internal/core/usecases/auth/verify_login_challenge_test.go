@@ -0,0 +1,250 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/domain"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/providers"
+)
+
+func TestVerifyLoginChallengeUseCase_Execute_WithValidCode_IssuesSession(t *testing.T) {
+	// Given
+	givenUserID := uuid.New()
+	givenOrgID := uuid.New()
+	givenChallengeToken := "challenge-token"
+	givenChallengeHash := hashToken(givenChallengeToken)
+
+	givenUser := &domain.User{
+		ID:               givenUserID,
+		Email:            "user@example.com",
+		Status:           domain.UserStatusActive,
+		OrganizationID:   givenOrgID,
+		TwoFactorEnabled: true,
+		TwoFactorSecret:  "SECRET",
+	}
+
+	givenChallenge := &domain.LoginChallenge{
+		UserID:    givenUserID,
+		DeviceID:  "device-1",
+		IPAddress: "198.51.100.7",
+		Reasons:   []domain.SuspiciousLoginReason{domain.SuspiciousLoginNewDevice},
+	}
+
+	mockUserRepo := new(providers.MockUserRepository)
+	mockTokenRepo := new(providers.MockTokenRepository)
+	mockJWTManager := new(providers.MockJWTManager)
+	mockTOTP := new(providers.MockTOTPValidator)
+	mockLogger := new(providers.MockLogger)
+
+	useCase := NewVerifyLoginChallengeUseCase(mockUserRepo, mockTokenRepo, mockJWTManager, mockTOTP, nil, mockLogger)
+
+	mockTokenRepo.On("GetLoginChallenge", mock.Anything, givenChallengeHash).Return(givenChallenge, nil)
+	mockTokenRepo.On("RecordLoginChallengeAttempt", mock.Anything, givenChallengeHash, loginChallengeTTL).Return(int64(1), nil)
+	mockUserRepo.On("GetByID", mock.Anything, givenUserID).Return(givenUser, nil)
+	mockTOTP.On("ValidateCode", "SECRET", "123456").Return(true)
+	mockTokenRepo.On("ConsumeLoginChallenge", mock.Anything, givenChallengeHash).Return(givenChallenge, nil)
+	mockJWTManager.On("GenerateAccessToken", givenUserID, givenOrgID, "user@example.com", mock.Anything, mock.Anything).Return("access_token", nil)
+	mockJWTManager.On("GenerateRefreshToken", givenUserID).Return("refresh_token", nil)
+	mockJWTManager.On("GetRefreshExpiry").Return(7 * 24 * time.Hour)
+	mockJWTManager.On("GetAccessExpiry").Return(15 * time.Minute)
+	mockTokenRepo.On("StoreRefreshToken", mock.Anything, mock.MatchedBy(func(token *domain.RefreshToken) bool {
+		return token.DeviceID == "device-1" && token.IPAddress == "198.51.100.7"
+	})).Return(nil)
+	mockUserRepo.On("UpdateLastLogin", mock.Anything, givenUserID).Return(nil)
+	mockLogger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	// When
+	response, err := useCase.Execute(context.Background(), &domain.VerifyLoginChallengeRequest{
+		ChallengeToken: givenChallengeToken,
+		Code:           "123456",
+	})
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, "access_token", response.AccessToken)
+	assert.False(t, response.StepUpRequired)
+	mockTokenRepo.AssertExpectations(t)
+	mockTOTP.AssertExpectations(t)
+}
+
+func TestVerifyLoginChallengeUseCase_Execute_WithInvalidCode_ReturnsUnauthorized(t *testing.T) {
+	// Given
+	givenUserID := uuid.New()
+	givenChallengeHash := hashToken("challenge-token")
+
+	givenUser := &domain.User{
+		ID:               givenUserID,
+		Status:           domain.UserStatusActive,
+		TwoFactorEnabled: true,
+		TwoFactorSecret:  "SECRET",
+	}
+
+	mockUserRepo := new(providers.MockUserRepository)
+	mockTokenRepo := new(providers.MockTokenRepository)
+	mockJWTManager := new(providers.MockJWTManager)
+	mockTOTP := new(providers.MockTOTPValidator)
+	mockLogger := new(providers.MockLogger)
+
+	useCase := NewVerifyLoginChallengeUseCase(mockUserRepo, mockTokenRepo, mockJWTManager, mockTOTP, nil, mockLogger)
+
+	mockTokenRepo.On("GetLoginChallenge", mock.Anything, givenChallengeHash).Return(&domain.LoginChallenge{UserID: givenUserID}, nil)
+	mockTokenRepo.On("RecordLoginChallengeAttempt", mock.Anything, givenChallengeHash, loginChallengeTTL).Return(int64(1), nil)
+	mockUserRepo.On("GetByID", mock.Anything, givenUserID).Return(givenUser, nil)
+	mockTOTP.On("ValidateCode", "SECRET", "000000").Return(false)
+	mockLogger.On("Warn", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	// When
+	response, err := useCase.Execute(context.Background(), &domain.VerifyLoginChallengeRequest{
+		ChallengeToken: "challenge-token",
+		Code:           "000000",
+	})
+
+	// Then
+	assert.Error(t, err)
+	assert.Nil(t, response)
+	assert.Contains(t, err.Error(), "invalid verification code")
+	mockTokenRepo.AssertNotCalled(t, "DeleteLoginChallenge", mock.Anything, mock.Anything)
+	mockTokenRepo.AssertNotCalled(t, "ConsumeLoginChallenge", mock.Anything, mock.Anything)
+}
+
+func TestVerifyLoginChallengeUseCase_Execute_WithLastInvalidAttempt_DeletesChallenge(t *testing.T) {
+	// Given
+	givenUserID := uuid.New()
+	givenChallengeHash := hashToken("challenge-token")
+
+	givenUser := &domain.User{
+		ID:               givenUserID,
+		Status:           domain.UserStatusActive,
+		TwoFactorEnabled: true,
+		TwoFactorSecret:  "SECRET",
+	}
+
+	mockUserRepo := new(providers.MockUserRepository)
+	mockTokenRepo := new(providers.MockTokenRepository)
+	mockJWTManager := new(providers.MockJWTManager)
+	mockTOTP := new(providers.MockTOTPValidator)
+	mockLogger := new(providers.MockLogger)
+
+	useCase := NewVerifyLoginChallengeUseCase(mockUserRepo, mockTokenRepo, mockJWTManager, mockTOTP, nil, mockLogger)
+
+	mockTokenRepo.On("GetLoginChallenge", mock.Anything, givenChallengeHash).Return(&domain.LoginChallenge{UserID: givenUserID}, nil)
+	mockTokenRepo.On("RecordLoginChallengeAttempt", mock.Anything, givenChallengeHash, loginChallengeTTL).Return(int64(maxLoginChallengeAttempts), nil)
+	mockUserRepo.On("GetByID", mock.Anything, givenUserID).Return(givenUser, nil)
+	mockTOTP.On("ValidateCode", "SECRET", "000000").Return(false)
+	mockTokenRepo.On("DeleteLoginChallenge", mock.Anything, givenChallengeHash).Return(nil)
+	mockLogger.On("Warn", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	// When
+	response, err := useCase.Execute(context.Background(), &domain.VerifyLoginChallengeRequest{
+		ChallengeToken: "challenge-token",
+		Code:           "000000",
+	})
+
+	// Then
+	assert.Error(t, err)
+	assert.Nil(t, response)
+	assert.Contains(t, err.Error(), "invalid verification code")
+	mockTokenRepo.AssertCalled(t, "DeleteLoginChallenge", mock.Anything, givenChallengeHash)
+}
+
+func TestVerifyLoginChallengeUseCase_Execute_WithTooManyAttempts_RejectsWithoutCheckingCode(t *testing.T) {
+	// Given
+	givenUserID := uuid.New()
+	givenChallengeHash := hashToken("challenge-token")
+
+	mockUserRepo := new(providers.MockUserRepository)
+	mockTokenRepo := new(providers.MockTokenRepository)
+	mockJWTManager := new(providers.MockJWTManager)
+	mockTOTP := new(providers.MockTOTPValidator)
+	mockLogger := new(providers.MockLogger)
+
+	useCase := NewVerifyLoginChallengeUseCase(mockUserRepo, mockTokenRepo, mockJWTManager, mockTOTP, nil, mockLogger)
+
+	mockTokenRepo.On("GetLoginChallenge", mock.Anything, givenChallengeHash).Return(&domain.LoginChallenge{UserID: givenUserID}, nil)
+	mockTokenRepo.On("RecordLoginChallengeAttempt", mock.Anything, givenChallengeHash, loginChallengeTTL).Return(int64(maxLoginChallengeAttempts+1), nil)
+	mockTokenRepo.On("DeleteLoginChallenge", mock.Anything, givenChallengeHash).Return(nil)
+	mockLogger.On("Warn", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	// When
+	response, err := useCase.Execute(context.Background(), &domain.VerifyLoginChallengeRequest{
+		ChallengeToken: "challenge-token",
+		Code:           "123456",
+	})
+
+	// Then
+	assert.Error(t, err)
+	assert.Nil(t, response)
+	assert.Contains(t, err.Error(), "too many verification attempts")
+	mockTOTP.AssertNotCalled(t, "ValidateCode", mock.Anything, mock.Anything)
+	mockTokenRepo.AssertCalled(t, "DeleteLoginChallenge", mock.Anything, givenChallengeHash)
+}
+
+func TestVerifyLoginChallengeUseCase_Execute_WithAlreadyConsumedChallenge_ReturnsUnauthorized(t *testing.T) {
+	// Given
+	givenUserID := uuid.New()
+	givenChallengeHash := hashToken("challenge-token")
+
+	givenUser := &domain.User{
+		ID:               givenUserID,
+		Status:           domain.UserStatusActive,
+		TwoFactorEnabled: true,
+		TwoFactorSecret:  "SECRET",
+	}
+
+	mockUserRepo := new(providers.MockUserRepository)
+	mockTokenRepo := new(providers.MockTokenRepository)
+	mockJWTManager := new(providers.MockJWTManager)
+	mockTOTP := new(providers.MockTOTPValidator)
+	mockLogger := new(providers.MockLogger)
+
+	useCase := NewVerifyLoginChallengeUseCase(mockUserRepo, mockTokenRepo, mockJWTManager, mockTOTP, nil, mockLogger)
+
+	mockTokenRepo.On("GetLoginChallenge", mock.Anything, givenChallengeHash).Return(&domain.LoginChallenge{UserID: givenUserID}, nil)
+	mockTokenRepo.On("RecordLoginChallengeAttempt", mock.Anything, givenChallengeHash, loginChallengeTTL).Return(int64(1), nil)
+	mockUserRepo.On("GetByID", mock.Anything, givenUserID).Return(givenUser, nil)
+	mockTOTP.On("ValidateCode", "SECRET", "123456").Return(true)
+	mockTokenRepo.On("ConsumeLoginChallenge", mock.Anything, givenChallengeHash).Return(nil, errors.New("redis: nil"))
+
+	// When
+	response, err := useCase.Execute(context.Background(), &domain.VerifyLoginChallengeRequest{
+		ChallengeToken: "challenge-token",
+		Code:           "123456",
+	})
+
+	// Then
+	assert.Error(t, err)
+	assert.Nil(t, response)
+	assert.Contains(t, err.Error(), "invalid or expired challenge")
+	mockJWTManager.AssertNotCalled(t, "GenerateAccessToken", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestVerifyLoginChallengeUseCase_Execute_WithExpiredChallenge_ReturnsUnauthorized(t *testing.T) {
+	// Given
+	mockUserRepo := new(providers.MockUserRepository)
+	mockTokenRepo := new(providers.MockTokenRepository)
+	mockJWTManager := new(providers.MockJWTManager)
+	mockTOTP := new(providers.MockTOTPValidator)
+	mockLogger := new(providers.MockLogger)
+
+	useCase := NewVerifyLoginChallengeUseCase(mockUserRepo, mockTokenRepo, mockJWTManager, mockTOTP, nil, mockLogger)
+
+	mockTokenRepo.On("GetLoginChallenge", mock.Anything, mock.Anything).Return(nil, errors.New("redis: nil"))
+
+	// When
+	response, err := useCase.Execute(context.Background(), &domain.VerifyLoginChallengeRequest{
+		ChallengeToken: "expired",
+		Code:           "123456",
+	})
+
+	// Then
+	assert.Error(t, err)
+	assert.Nil(t, response)
+	assert.Contains(t, err.Error(), "invalid or expired challenge")
+}
@@ -0,0 +1,84 @@
+package hierarchy
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	pkgErrors "github.com/giia/giia-core-engine/pkg/errors"
+	pkgLogger "github.com/giia/giia-core-engine/pkg/logger"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/domain"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/providers"
+)
+
+type GetEscalationChainUseCase struct {
+	userRepo providers.UserRepository
+	logger   pkgLogger.Logger
+}
+
+func NewGetEscalationChainUseCase(userRepo providers.UserRepository, logger pkgLogger.Logger) *GetEscalationChainUseCase {
+	return &GetEscalationChainUseCase{
+		userRepo: userRepo,
+		logger:   logger,
+	}
+}
+
+// Execute returns up to levels contacts above userID, nearest first. Managers
+// who are not active are skipped so escalation goes straight to the next
+// person who can act. A levels value of 0 returns the whole chain.
+func (uc *GetEscalationChainUseCase) Execute(ctx context.Context, orgID, userID uuid.UUID, levels int) (*domain.EscalationChainResponse, error) {
+	if orgID == uuid.Nil {
+		return nil, pkgErrors.NewBadRequest("organization ID cannot be empty")
+	}
+
+	if levels < 0 {
+		return nil, pkgErrors.NewBadRequest("levels cannot be negative")
+	}
+
+	if levels == 0 || levels > maxChainDepth {
+		levels = maxChainDepth
+	}
+
+	user, err := uc.userRepo.GetByID(ctx, userID)
+	if err != nil || user.OrganizationID != orgID {
+		return nil, pkgErrors.NewNotFound("user not found")
+	}
+
+	chain := make([]*domain.EscalationContact, 0)
+	visited := map[uuid.UUID]bool{user.ID: true}
+	current := user.ManagerID
+
+	for hops := 0; current != nil && len(chain) < levels && hops < maxChainDepth; hops++ {
+		if visited[*current] {
+			uc.logger.Warn(ctx, "Reporting cycle found while building escalation chain", pkgLogger.Tags{
+				"organization_id": orgID.String(),
+				"user_id":         userID.String(),
+			})
+			break
+		}
+		visited[*current] = true
+
+		manager, err := uc.userRepo.GetByID(ctx, *current)
+		if err != nil || manager.OrganizationID != orgID {
+			break
+		}
+
+		if manager.Status == domain.UserStatusActive {
+			chain = append(chain, &domain.EscalationContact{
+				Level:     len(chain) + 1,
+				UserID:    manager.ID,
+				Email:     manager.Email,
+				FirstName: manager.FirstName,
+				LastName:  manager.LastName,
+				Phone:     manager.Phone,
+			})
+		}
+
+		current = manager.ManagerID
+	}
+
+	return &domain.EscalationChainResponse{
+		UserID: userID,
+		Chain:  chain,
+	}, nil
+}
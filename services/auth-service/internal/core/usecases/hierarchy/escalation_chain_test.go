@@ -0,0 +1,64 @@
+package hierarchy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/domain"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/providers"
+)
+
+func TestGetEscalationChainUseCase_Execute_WithInactiveManager_SkipsToNextLevel(t *testing.T) {
+	// Given
+	givenOrgID := uuid.New()
+	givenDirector := &domain.User{ID: uuid.New(), OrganizationID: givenOrgID, Email: "director@acme.com", Status: domain.UserStatusActive}
+	givenManager := &domain.User{ID: uuid.New(), OrganizationID: givenOrgID, Status: domain.UserStatusSuspended, ManagerID: &givenDirector.ID}
+	givenUser := &domain.User{ID: uuid.New(), OrganizationID: givenOrgID, Status: domain.UserStatusActive, ManagerID: &givenManager.ID}
+
+	mockUserRepo := new(providers.MockUserRepository)
+	mockLogger := new(providers.MockLogger)
+
+	useCase := NewGetEscalationChainUseCase(mockUserRepo, mockLogger)
+
+	mockUserRepo.On("GetByID", mock.Anything, givenUser.ID).Return(givenUser, nil)
+	mockUserRepo.On("GetByID", mock.Anything, givenManager.ID).Return(givenManager, nil)
+	mockUserRepo.On("GetByID", mock.Anything, givenDirector.ID).Return(givenDirector, nil)
+
+	// When
+	response, err := useCase.Execute(context.Background(), givenOrgID, givenUser.ID, 0)
+
+	// Then
+	assert.NoError(t, err)
+	assert.Len(t, response.Chain, 1)
+	assert.Equal(t, 1, response.Chain[0].Level)
+	assert.Equal(t, "director@acme.com", response.Chain[0].Email)
+}
+
+func TestGetEscalationChainUseCase_Execute_WithLevels_StopsAtLimit(t *testing.T) {
+	// Given
+	givenOrgID := uuid.New()
+	givenDirector := &domain.User{ID: uuid.New(), OrganizationID: givenOrgID, Status: domain.UserStatusActive}
+	givenManager := &domain.User{ID: uuid.New(), OrganizationID: givenOrgID, Status: domain.UserStatusActive, ManagerID: &givenDirector.ID}
+	givenUser := &domain.User{ID: uuid.New(), OrganizationID: givenOrgID, Status: domain.UserStatusActive, ManagerID: &givenManager.ID}
+
+	mockUserRepo := new(providers.MockUserRepository)
+	mockLogger := new(providers.MockLogger)
+
+	useCase := NewGetEscalationChainUseCase(mockUserRepo, mockLogger)
+
+	mockUserRepo.On("GetByID", mock.Anything, givenUser.ID).Return(givenUser, nil)
+	mockUserRepo.On("GetByID", mock.Anything, givenManager.ID).Return(givenManager, nil)
+
+	// When
+	response, err := useCase.Execute(context.Background(), givenOrgID, givenUser.ID, 1)
+
+	// Then
+	assert.NoError(t, err)
+	assert.Len(t, response.Chain, 1)
+	assert.Equal(t, givenManager.ID, response.Chain[0].UserID)
+	mockUserRepo.AssertNotCalled(t, "GetByID", mock.Anything, givenDirector.ID)
+}
@@ -0,0 +1,119 @@
+package hierarchy
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	pkgErrors "github.com/giia/giia-core-engine/pkg/errors"
+	pkgLogger "github.com/giia/giia-core-engine/pkg/logger"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/domain"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/providers"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/usecases/audit"
+)
+
+// maxChainDepth bounds every walk up the reporting line, so a corrupted
+// hierarchy cannot loop forever.
+const maxChainDepth = 50
+
+type SetManagerUseCase struct {
+	userRepo  providers.UserRepository
+	auditRepo providers.AuditLogRepository
+	logger    pkgLogger.Logger
+}
+
+func NewSetManagerUseCase(
+	userRepo providers.UserRepository,
+	auditRepo providers.AuditLogRepository,
+	logger pkgLogger.Logger,
+) *SetManagerUseCase {
+	return &SetManagerUseCase{
+		userRepo:  userRepo,
+		auditRepo: auditRepo,
+		logger:    logger,
+	}
+}
+
+// Execute sets or clears the manager of a user in orgID. The manager must be
+// an active user of the same organization and must not already report,
+// directly or indirectly, to the user.
+func (uc *SetManagerUseCase) Execute(ctx context.Context, orgID, actorID, userID uuid.UUID, req *domain.SetManagerRequest) (*domain.UserResponse, error) {
+	if orgID == uuid.Nil {
+		return nil, pkgErrors.NewBadRequest("organization ID cannot be empty")
+	}
+
+	user, err := uc.userRepo.GetByID(ctx, userID)
+	if err != nil || user.OrganizationID != orgID {
+		return nil, pkgErrors.NewNotFound("user not found")
+	}
+
+	var managerID *uuid.UUID
+	if req.ManagerID != "" {
+		id, err := uuid.Parse(req.ManagerID)
+		if err != nil {
+			return nil, pkgErrors.NewBadRequest("invalid manager ID format")
+		}
+
+		if id == userID {
+			return nil, pkgErrors.NewBadRequest("a user cannot be their own manager")
+		}
+
+		manager, err := uc.userRepo.GetByID(ctx, id)
+		if err != nil || manager.OrganizationID != orgID {
+			return nil, pkgErrors.NewNotFound("manager not found")
+		}
+
+		if manager.Status != domain.UserStatusActive {
+			return nil, pkgErrors.NewBadRequest("manager must be an active user")
+		}
+
+		if err := uc.checkNoCycle(ctx, userID, manager); err != nil {
+			return nil, err
+		}
+
+		managerID = &id
+	}
+
+	user.ManagerID = managerID
+	if err := uc.userRepo.Update(ctx, user); err != nil {
+		uc.logger.Error(ctx, err, "Failed to update user manager", pkgLogger.Tags{
+			"organization_id": orgID.String(),
+			"user_id":         userID.String(),
+		})
+		return nil, pkgErrors.NewInternalServerError("failed to update manager")
+	}
+
+	audit.Record(ctx, uc.auditRepo, uc.logger, orgID, actorID, domain.AuditActionManagerChanged, "user:"+userID.String(), "")
+
+	uc.logger.Info(ctx, "User manager updated", pkgLogger.Tags{
+		"organization_id": orgID.String(),
+		"user_id":         userID.String(),
+		"manager_id":      req.ManagerID,
+	})
+
+	return user.ToResponse(), nil
+}
+
+// checkNoCycle walks up from the proposed manager and rejects the change if
+// the chain reaches userID.
+func (uc *SetManagerUseCase) checkNoCycle(ctx context.Context, userID uuid.UUID, manager *domain.User) error {
+	current := manager
+	for depth := 0; current.ManagerID != nil; depth++ {
+		if *current.ManagerID == userID {
+			return pkgErrors.NewConflict("manager change would create a reporting cycle")
+		}
+
+		if depth >= maxChainDepth {
+			return pkgErrors.NewBadRequest("reporting chain is too deep")
+		}
+
+		next, err := uc.userRepo.GetByID(ctx, *current.ManagerID)
+		if err != nil {
+			// A dangling reference ends the chain; the FK clears it on delete
+			return nil
+		}
+		current = next
+	}
+
+	return nil
+}
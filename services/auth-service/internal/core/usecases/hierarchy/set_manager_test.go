@@ -0,0 +1,98 @@
+package hierarchy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/domain"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/providers"
+)
+
+func TestSetManagerUseCase_Execute_WithActiveManager_UpdatesUserAndAudits(t *testing.T) {
+	// Given
+	givenOrgID := uuid.New()
+	givenActorID := uuid.New()
+	givenUser := &domain.User{ID: uuid.New(), OrganizationID: givenOrgID, Status: domain.UserStatusActive}
+	givenManager := &domain.User{ID: uuid.New(), OrganizationID: givenOrgID, Status: domain.UserStatusActive}
+
+	mockUserRepo := new(providers.MockUserRepository)
+	mockAuditRepo := new(providers.MockAuditLogRepository)
+	mockLogger := new(providers.MockLogger)
+
+	useCase := NewSetManagerUseCase(mockUserRepo, mockAuditRepo, mockLogger)
+
+	mockUserRepo.On("GetByID", mock.Anything, givenUser.ID).Return(givenUser, nil)
+	mockUserRepo.On("GetByID", mock.Anything, givenManager.ID).Return(givenManager, nil)
+	mockUserRepo.On("Update", mock.Anything, mock.MatchedBy(func(u *domain.User) bool {
+		return u.ManagerID != nil && *u.ManagerID == givenManager.ID
+	})).Return(nil)
+	mockAuditRepo.On("Create", mock.Anything, mock.MatchedBy(func(l *domain.AuditLog) bool {
+		return l.Action == domain.AuditActionManagerChanged && l.ActorID == givenActorID
+	})).Return(nil)
+	mockLogger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	// When
+	response, err := useCase.Execute(context.Background(), givenOrgID, givenActorID, givenUser.ID, &domain.SetManagerRequest{ManagerID: givenManager.ID.String()})
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, givenManager.ID, *response.ManagerID)
+	mockUserRepo.AssertExpectations(t)
+	mockAuditRepo.AssertExpectations(t)
+}
+
+func TestSetManagerUseCase_Execute_WithManagerReportingToUser_ReturnsConflict(t *testing.T) {
+	// Given
+	givenOrgID := uuid.New()
+	givenUser := &domain.User{ID: uuid.New(), OrganizationID: givenOrgID, Status: domain.UserStatusActive}
+	givenLead := &domain.User{ID: uuid.New(), OrganizationID: givenOrgID, Status: domain.UserStatusActive, ManagerID: &givenUser.ID}
+	givenManager := &domain.User{ID: uuid.New(), OrganizationID: givenOrgID, Status: domain.UserStatusActive, ManagerID: &givenLead.ID}
+
+	mockUserRepo := new(providers.MockUserRepository)
+	mockAuditRepo := new(providers.MockAuditLogRepository)
+	mockLogger := new(providers.MockLogger)
+
+	useCase := NewSetManagerUseCase(mockUserRepo, mockAuditRepo, mockLogger)
+
+	mockUserRepo.On("GetByID", mock.Anything, givenUser.ID).Return(givenUser, nil)
+	mockUserRepo.On("GetByID", mock.Anything, givenManager.ID).Return(givenManager, nil)
+	mockUserRepo.On("GetByID", mock.Anything, givenLead.ID).Return(givenLead, nil)
+
+	// When
+	response, err := useCase.Execute(context.Background(), givenOrgID, uuid.New(), givenUser.ID, &domain.SetManagerRequest{ManagerID: givenManager.ID.String()})
+
+	// Then
+	assert.Error(t, err)
+	assert.Nil(t, response)
+	assert.Contains(t, err.Error(), "reporting cycle")
+	mockUserRepo.AssertNotCalled(t, "Update")
+}
+
+func TestSetManagerUseCase_Execute_WithManagerInOtherOrganization_ReturnsNotFound(t *testing.T) {
+	// Given
+	givenOrgID := uuid.New()
+	givenUser := &domain.User{ID: uuid.New(), OrganizationID: givenOrgID, Status: domain.UserStatusActive}
+	givenManager := &domain.User{ID: uuid.New(), OrganizationID: uuid.New(), Status: domain.UserStatusActive}
+
+	mockUserRepo := new(providers.MockUserRepository)
+	mockAuditRepo := new(providers.MockAuditLogRepository)
+	mockLogger := new(providers.MockLogger)
+
+	useCase := NewSetManagerUseCase(mockUserRepo, mockAuditRepo, mockLogger)
+
+	mockUserRepo.On("GetByID", mock.Anything, givenUser.ID).Return(givenUser, nil)
+	mockUserRepo.On("GetByID", mock.Anything, givenManager.ID).Return(givenManager, nil)
+
+	// When
+	response, err := useCase.Execute(context.Background(), givenOrgID, uuid.New(), givenUser.ID, &domain.SetManagerRequest{ManagerID: givenManager.ID.String()})
+
+	// Then
+	assert.Error(t, err)
+	assert.Nil(t, response)
+	assert.Contains(t, err.Error(), "manager not found")
+	mockUserRepo.AssertNotCalled(t, "Update")
+}
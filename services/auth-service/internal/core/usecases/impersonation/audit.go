@@ -0,0 +1,72 @@
+package impersonation
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	pkgErrors "github.com/giia/giia-core-engine/pkg/errors"
+	pkgLogger "github.com/giia/giia-core-engine/pkg/logger"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/domain"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/providers"
+)
+
+const (
+	// consentTTL bounds how long a request waits for the customer and how long
+	// an approval remains usable.
+	consentTTL = 1 * time.Hour
+
+	// sessionTTL is the lifetime of an impersonation access token.
+	sessionTTL = 30 * time.Minute
+)
+
+// recordLifecycle writes an audit entry for an impersonation state change. A
+// state change that cannot be audited is rejected.
+func recordLifecycle(
+	ctx context.Context,
+	auditRepo providers.AuditLogRepository,
+	logger pkgLogger.Logger,
+	session *domain.ImpersonationSession,
+	actorID uuid.UUID,
+	action string,
+) error {
+	impersonatorID := session.ImpersonatorID
+	sessionID := session.ID
+
+	entry := &domain.AuditLog{
+		OrganizationID:  session.OrganizationID,
+		ActorID:         actorID,
+		ImpersonatorID:  &impersonatorID,
+		ImpersonationID: &sessionID,
+		Action:          action,
+		Resource:        "user:" + session.TargetUserID.String(),
+		Details:         session.Reason,
+	}
+
+	if err := auditRepo.Create(ctx, entry); err != nil {
+		logger.Error(ctx, err, "Failed to write impersonation audit log", pkgLogger.Tags{
+			"impersonation_id": session.ID.String(),
+			"action":           action,
+		})
+		return pkgErrors.NewInternalServerError("failed to write audit log")
+	}
+
+	return nil
+}
+
+func getSession(ctx context.Context, repo providers.ImpersonationRepository, logger pkgLogger.Logger, sessionID uuid.UUID) (*domain.ImpersonationSession, error) {
+	if sessionID == uuid.Nil {
+		return nil, pkgErrors.NewBadRequest("impersonation ID cannot be empty")
+	}
+
+	session, err := repo.GetByID(ctx, sessionID)
+	if err != nil {
+		logger.Error(ctx, err, "Failed to get impersonation session", pkgLogger.Tags{
+			"impersonation_id": sessionID.String(),
+		})
+		return nil, pkgErrors.NewNotFound("impersonation request not found")
+	}
+
+	return session, nil
+}
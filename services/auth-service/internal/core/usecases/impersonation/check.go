@@ -0,0 +1,41 @@
+package impersonation
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	pkgErrors "github.com/giia/giia-core-engine/pkg/errors"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/domain"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/providers"
+)
+
+type CheckImpersonationUseCase struct {
+	impersonationRepo providers.ImpersonationRepository
+}
+
+func NewCheckImpersonationUseCase(impersonationRepo providers.ImpersonationRepository) *CheckImpersonationUseCase {
+	return &CheckImpersonationUseCase{
+		impersonationRepo: impersonationRepo,
+	}
+}
+
+// Execute confirms an impersonation token still belongs to a live session, so
+// ending a session revokes its token before the token itself expires.
+func (uc *CheckImpersonationUseCase) Execute(ctx context.Context, sessionID uuid.UUID) (*domain.ImpersonationSession, error) {
+	session, err := uc.impersonationRepo.GetByID(ctx, sessionID)
+	if err != nil {
+		return nil, pkgErrors.NewUnauthorized("impersonation session not found")
+	}
+
+	if session.Status != domain.ImpersonationStatusActive {
+		return nil, pkgErrors.NewUnauthorized("impersonation session is no longer active")
+	}
+
+	if session.ExpiresAt == nil || time.Now().After(*session.ExpiresAt) {
+		return nil, pkgErrors.NewUnauthorized("impersonation session has expired")
+	}
+
+	return session, nil
+}
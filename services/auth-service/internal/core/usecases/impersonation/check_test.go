@@ -0,0 +1,59 @@
+package impersonation
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/domain"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/providers"
+)
+
+func TestCheckImpersonationUseCase_Execute_WithActiveSession_ReturnsSession(t *testing.T) {
+	// Given
+	givenExpiresAt := time.Now().Add(10 * time.Minute)
+	givenSession := &domain.ImpersonationSession{
+		ID:        uuid.New(),
+		Status:    domain.ImpersonationStatusActive,
+		ExpiresAt: &givenExpiresAt,
+	}
+
+	mockImpersonationRepo := new(providers.MockImpersonationRepository)
+	useCase := NewCheckImpersonationUseCase(mockImpersonationRepo)
+
+	mockImpersonationRepo.On("GetByID", mock.Anything, givenSession.ID).Return(givenSession, nil)
+
+	// When
+	session, err := useCase.Execute(context.Background(), givenSession.ID)
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, givenSession, session)
+}
+
+func TestCheckImpersonationUseCase_Execute_WithEndedSession_ReturnsUnauthorized(t *testing.T) {
+	// Given
+	givenExpiresAt := time.Now().Add(10 * time.Minute)
+	givenSession := &domain.ImpersonationSession{
+		ID:        uuid.New(),
+		Status:    domain.ImpersonationStatusEnded,
+		ExpiresAt: &givenExpiresAt,
+	}
+
+	mockImpersonationRepo := new(providers.MockImpersonationRepository)
+	useCase := NewCheckImpersonationUseCase(mockImpersonationRepo)
+
+	mockImpersonationRepo.On("GetByID", mock.Anything, givenSession.ID).Return(givenSession, nil)
+
+	// When
+	session, err := useCase.Execute(context.Background(), givenSession.ID)
+
+	// Then
+	assert.Error(t, err)
+	assert.Nil(t, session)
+	assert.Contains(t, err.Error(), "no longer active")
+}
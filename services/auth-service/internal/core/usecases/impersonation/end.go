@@ -0,0 +1,70 @@
+package impersonation
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	pkgErrors "github.com/giia/giia-core-engine/pkg/errors"
+	pkgLogger "github.com/giia/giia-core-engine/pkg/logger"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/domain"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/providers"
+)
+
+type EndImpersonationUseCase struct {
+	impersonationRepo providers.ImpersonationRepository
+	auditRepo         providers.AuditLogRepository
+	logger            pkgLogger.Logger
+}
+
+func NewEndImpersonationUseCase(
+	impersonationRepo providers.ImpersonationRepository,
+	auditRepo providers.AuditLogRepository,
+	logger pkgLogger.Logger,
+) *EndImpersonationUseCase {
+	return &EndImpersonationUseCase{
+		impersonationRepo: impersonationRepo,
+		auditRepo:         auditRepo,
+		logger:            logger,
+	}
+}
+
+// Execute ends a session. Either the impersonator or the impersonated user may
+// end it; outstanding tokens stop working immediately.
+func (uc *EndImpersonationUseCase) Execute(ctx context.Context, actorID, sessionID uuid.UUID) error {
+	session, err := getSession(ctx, uc.impersonationRepo, uc.logger, sessionID)
+	if err != nil {
+		return err
+	}
+
+	if actorID != session.ImpersonatorID && actorID != session.TargetUserID {
+		return pkgErrors.NewForbidden("not allowed to end this impersonation session")
+	}
+
+	if session.Status != domain.ImpersonationStatusActive && session.Status != domain.ImpersonationStatusApproved {
+		return pkgErrors.NewConflict("impersonation session is not active")
+	}
+
+	now := time.Now()
+	session.Status = domain.ImpersonationStatusEnded
+	session.EndedAt = &now
+
+	if err := uc.impersonationRepo.Update(ctx, session); err != nil {
+		uc.logger.Error(ctx, err, "Failed to end impersonation session", pkgLogger.Tags{
+			"impersonation_id": session.ID.String(),
+		})
+		return pkgErrors.NewInternalServerError("failed to end impersonation session")
+	}
+
+	if err := recordLifecycle(ctx, uc.auditRepo, uc.logger, session, actorID, domain.AuditActionImpersonationEnded); err != nil {
+		return err
+	}
+
+	uc.logger.Info(ctx, "Impersonation session ended", pkgLogger.Tags{
+		"impersonation_id": session.ID.String(),
+		"ended_by":         actorID.String(),
+	})
+
+	return nil
+}
@@ -0,0 +1,73 @@
+package impersonation
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/domain"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/providers"
+)
+
+func TestEndImpersonationUseCase_Execute_WithTargetUser_EndsSession(t *testing.T) {
+	// Given
+	givenExpiresAt := time.Now().Add(10 * time.Minute)
+	givenSession := &domain.ImpersonationSession{
+		ID:             uuid.New(),
+		ImpersonatorID: uuid.New(),
+		TargetUserID:   uuid.New(),
+		Status:         domain.ImpersonationStatusActive,
+		ExpiresAt:      &givenExpiresAt,
+	}
+
+	mockImpersonationRepo := new(providers.MockImpersonationRepository)
+	mockAuditRepo := new(providers.MockAuditLogRepository)
+	mockLogger := new(providers.MockLogger)
+
+	useCase := NewEndImpersonationUseCase(mockImpersonationRepo, mockAuditRepo, mockLogger)
+
+	mockImpersonationRepo.On("GetByID", mock.Anything, givenSession.ID).Return(givenSession, nil)
+	mockImpersonationRepo.On("Update", mock.Anything, givenSession).Return(nil)
+	mockAuditRepo.On("Create", mock.Anything, mock.MatchedBy(func(l *domain.AuditLog) bool {
+		return l.Action == domain.AuditActionImpersonationEnded && l.ActorID == givenSession.TargetUserID
+	})).Return(nil)
+	mockLogger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	// When
+	err := useCase.Execute(context.Background(), givenSession.TargetUserID, givenSession.ID)
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, domain.ImpersonationStatusEnded, givenSession.Status)
+	assert.NotNil(t, givenSession.EndedAt)
+	mockAuditRepo.AssertExpectations(t)
+}
+
+func TestEndImpersonationUseCase_Execute_WithUnrelatedUser_ReturnsForbidden(t *testing.T) {
+	// Given
+	givenSession := &domain.ImpersonationSession{
+		ID:             uuid.New(),
+		ImpersonatorID: uuid.New(),
+		TargetUserID:   uuid.New(),
+		Status:         domain.ImpersonationStatusActive,
+	}
+
+	mockImpersonationRepo := new(providers.MockImpersonationRepository)
+	mockAuditRepo := new(providers.MockAuditLogRepository)
+	mockLogger := new(providers.MockLogger)
+
+	useCase := NewEndImpersonationUseCase(mockImpersonationRepo, mockAuditRepo, mockLogger)
+
+	mockImpersonationRepo.On("GetByID", mock.Anything, givenSession.ID).Return(givenSession, nil)
+
+	// When
+	err := useCase.Execute(context.Background(), uuid.New(), givenSession.ID)
+
+	// Then
+	assert.Error(t, err)
+	mockImpersonationRepo.AssertNotCalled(t, "Update")
+}
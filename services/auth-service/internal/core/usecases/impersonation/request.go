@@ -0,0 +1,121 @@
+package impersonation
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	pkgErrors "github.com/giia/giia-core-engine/pkg/errors"
+	pkgLogger "github.com/giia/giia-core-engine/pkg/logger"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/domain"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/providers"
+)
+
+type RequestImpersonationUseCase struct {
+	impersonationRepo providers.ImpersonationRepository
+	userRepo          providers.UserRepository
+	auditRepo         providers.AuditLogRepository
+	eventPublisher    providers.SecurityEventPublisher
+	logger            pkgLogger.Logger
+}
+
+func NewRequestImpersonationUseCase(
+	impersonationRepo providers.ImpersonationRepository,
+	userRepo providers.UserRepository,
+	auditRepo providers.AuditLogRepository,
+	eventPublisher providers.SecurityEventPublisher,
+	logger pkgLogger.Logger,
+) *RequestImpersonationUseCase {
+	return &RequestImpersonationUseCase{
+		impersonationRepo: impersonationRepo,
+		userRepo:          userRepo,
+		auditRepo:         auditRepo,
+		eventPublisher:    eventPublisher,
+		logger:            logger,
+	}
+}
+
+func (uc *RequestImpersonationUseCase) Execute(ctx context.Context, impersonatorID uuid.UUID, req *domain.RequestImpersonationRequest) (*domain.ImpersonationSession, error) {
+	targetUserID, err := uuid.Parse(req.TargetUserID)
+	if err != nil {
+		return nil, pkgErrors.NewBadRequest("invalid target user ID format")
+	}
+
+	if targetUserID == impersonatorID {
+		return nil, pkgErrors.NewBadRequest("cannot impersonate yourself")
+	}
+
+	reason := strings.TrimSpace(req.Reason)
+	if reason == "" {
+		return nil, pkgErrors.NewBadRequest("reason is required")
+	}
+
+	impersonator, err := uc.userRepo.GetByID(ctx, impersonatorID)
+	if err != nil {
+		uc.logger.Error(ctx, err, "Failed to get impersonator", pkgLogger.Tags{
+			"user_id": impersonatorID.String(),
+		})
+		return nil, pkgErrors.NewNotFound("user not found")
+	}
+
+	target, err := uc.userRepo.GetByID(ctx, targetUserID)
+	if err != nil || target.OrganizationID != impersonator.OrganizationID {
+		return nil, pkgErrors.NewNotFound("target user not found")
+	}
+
+	if target.Status != domain.UserStatusActive {
+		return nil, pkgErrors.NewBadRequest("target user is not active")
+	}
+
+	readOnly := true
+	if req.ReadOnly != nil {
+		readOnly = *req.ReadOnly
+	}
+
+	session := &domain.ImpersonationSession{
+		ID:             uuid.New(),
+		OrganizationID: target.OrganizationID,
+		ImpersonatorID: impersonatorID,
+		TargetUserID:   targetUserID,
+		Reason:         reason,
+		ReadOnly:       readOnly,
+		Status:         domain.ImpersonationStatusPending,
+		ConsentExpires: time.Now().Add(consentTTL),
+	}
+
+	if err := uc.impersonationRepo.Create(ctx, session); err != nil {
+		uc.logger.Error(ctx, err, "Failed to create impersonation request", pkgLogger.Tags{
+			"impersonator_id": impersonatorID.String(),
+			"target_user_id":  targetUserID.String(),
+		})
+		return nil, pkgErrors.NewInternalServerError("failed to create impersonation request")
+	}
+
+	if err := recordLifecycle(ctx, uc.auditRepo, uc.logger, session, impersonatorID, domain.AuditActionImpersonationRequested); err != nil {
+		return nil, err
+	}
+
+	// The target user is asked for consent through the notification center
+	if err := uc.eventPublisher.PublishSecurityEvent(ctx, &domain.SecurityEvent{
+		Type:           domain.SecurityEventImpersonationRequested,
+		UserID:         target.ID,
+		OrganizationID: target.OrganizationID,
+		Email:          target.Email,
+		OccurredAt:     time.Now().UTC(),
+	}); err != nil {
+		uc.logger.Error(ctx, err, "Failed to publish impersonation request event", pkgLogger.Tags{
+			"impersonation_id": session.ID.String(),
+		})
+	}
+
+	uc.logger.Info(ctx, "Impersonation requested", pkgLogger.Tags{
+		"impersonation_id": session.ID.String(),
+		"impersonator_id":  impersonatorID.String(),
+		"target_user_id":   targetUserID.String(),
+		"read_only":        readOnly,
+	})
+
+	return session, nil
+}
@@ -0,0 +1,131 @@
+package impersonation
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/domain"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/providers"
+)
+
+func TestRequestImpersonationUseCase_Execute_WithValidRequest_CreatesPendingReadOnlySession(t *testing.T) {
+	// Given
+	givenOrgID := uuid.New()
+	givenImpersonator := &domain.User{ID: uuid.New(), OrganizationID: givenOrgID, Status: domain.UserStatusActive}
+	givenTarget := &domain.User{ID: uuid.New(), OrganizationID: givenOrgID, Email: "customer@example.com", Status: domain.UserStatusActive}
+
+	mockImpersonationRepo := new(providers.MockImpersonationRepository)
+	mockUserRepo := new(providers.MockUserRepository)
+	mockAuditRepo := new(providers.MockAuditLogRepository)
+	mockPublisher := new(providers.MockSecurityEventPublisher)
+	mockLogger := new(providers.MockLogger)
+
+	useCase := NewRequestImpersonationUseCase(mockImpersonationRepo, mockUserRepo, mockAuditRepo, mockPublisher, mockLogger)
+
+	mockUserRepo.On("GetByID", mock.Anything, givenImpersonator.ID).Return(givenImpersonator, nil)
+	mockUserRepo.On("GetByID", mock.Anything, givenTarget.ID).Return(givenTarget, nil)
+	mockImpersonationRepo.On("Create", mock.Anything, mock.MatchedBy(func(s *domain.ImpersonationSession) bool {
+		return s.Status == domain.ImpersonationStatusPending && s.ReadOnly && s.TargetUserID == givenTarget.ID
+	})).Return(nil)
+	mockAuditRepo.On("Create", mock.Anything, mock.MatchedBy(func(l *domain.AuditLog) bool {
+		return l.Action == domain.AuditActionImpersonationRequested && l.ActorID == givenImpersonator.ID
+	})).Return(nil)
+	mockPublisher.On("PublishSecurityEvent", mock.Anything, mock.MatchedBy(func(e *domain.SecurityEvent) bool {
+		return e.Type == domain.SecurityEventImpersonationRequested && e.UserID == givenTarget.ID
+	})).Return(nil)
+	mockLogger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	// When
+	session, err := useCase.Execute(context.Background(), givenImpersonator.ID, &domain.RequestImpersonationRequest{
+		TargetUserID: givenTarget.ID.String(),
+		Reason:       "Ticket #123: cannot see buffer profile",
+	})
+
+	// Then
+	assert.NoError(t, err)
+	assert.NotNil(t, session)
+	assert.True(t, session.ReadOnly)
+	mockImpersonationRepo.AssertExpectations(t)
+	mockAuditRepo.AssertExpectations(t)
+	mockPublisher.AssertExpectations(t)
+}
+
+func TestRequestImpersonationUseCase_Execute_WithSelfAsTarget_ReturnsBadRequest(t *testing.T) {
+	// Given
+	givenUserID := uuid.New()
+
+	mockImpersonationRepo := new(providers.MockImpersonationRepository)
+	mockUserRepo := new(providers.MockUserRepository)
+	mockAuditRepo := new(providers.MockAuditLogRepository)
+	mockPublisher := new(providers.MockSecurityEventPublisher)
+	mockLogger := new(providers.MockLogger)
+
+	useCase := NewRequestImpersonationUseCase(mockImpersonationRepo, mockUserRepo, mockAuditRepo, mockPublisher, mockLogger)
+
+	// When
+	session, err := useCase.Execute(context.Background(), givenUserID, &domain.RequestImpersonationRequest{
+		TargetUserID: givenUserID.String(),
+		Reason:       "testing",
+	})
+
+	// Then
+	assert.Error(t, err)
+	assert.Nil(t, session)
+	assert.Contains(t, err.Error(), "cannot impersonate yourself")
+	mockImpersonationRepo.AssertNotCalled(t, "Create")
+}
+
+func TestRequestImpersonationUseCase_Execute_WithEmptyReason_ReturnsBadRequest(t *testing.T) {
+	// Given
+	mockImpersonationRepo := new(providers.MockImpersonationRepository)
+	mockUserRepo := new(providers.MockUserRepository)
+	mockAuditRepo := new(providers.MockAuditLogRepository)
+	mockPublisher := new(providers.MockSecurityEventPublisher)
+	mockLogger := new(providers.MockLogger)
+
+	useCase := NewRequestImpersonationUseCase(mockImpersonationRepo, mockUserRepo, mockAuditRepo, mockPublisher, mockLogger)
+
+	// When
+	session, err := useCase.Execute(context.Background(), uuid.New(), &domain.RequestImpersonationRequest{
+		TargetUserID: uuid.New().String(),
+		Reason:       "   ",
+	})
+
+	// Then
+	assert.Error(t, err)
+	assert.Nil(t, session)
+	assert.Contains(t, err.Error(), "reason is required")
+}
+
+func TestRequestImpersonationUseCase_Execute_WithTargetInOtherOrganization_ReturnsNotFound(t *testing.T) {
+	// Given
+	givenImpersonator := &domain.User{ID: uuid.New(), OrganizationID: uuid.New(), Status: domain.UserStatusActive}
+	givenTarget := &domain.User{ID: uuid.New(), OrganizationID: uuid.New(), Status: domain.UserStatusActive}
+
+	mockImpersonationRepo := new(providers.MockImpersonationRepository)
+	mockUserRepo := new(providers.MockUserRepository)
+	mockAuditRepo := new(providers.MockAuditLogRepository)
+	mockPublisher := new(providers.MockSecurityEventPublisher)
+	mockLogger := new(providers.MockLogger)
+
+	useCase := NewRequestImpersonationUseCase(mockImpersonationRepo, mockUserRepo, mockAuditRepo, mockPublisher, mockLogger)
+
+	mockUserRepo.On("GetByID", mock.Anything, givenImpersonator.ID).Return(givenImpersonator, nil)
+	mockUserRepo.On("GetByID", mock.Anything, givenTarget.ID).Return(givenTarget, nil)
+
+	// When
+	session, err := useCase.Execute(context.Background(), givenImpersonator.ID, &domain.RequestImpersonationRequest{
+		TargetUserID: givenTarget.ID.String(),
+		Reason:       "support",
+	})
+
+	// Then
+	assert.Error(t, err)
+	assert.Nil(t, session)
+	assert.Contains(t, err.Error(), "target user not found")
+	mockImpersonationRepo.AssertNotCalled(t, "Create")
+}
@@ -0,0 +1,78 @@
+package impersonation
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	pkgErrors "github.com/giia/giia-core-engine/pkg/errors"
+	pkgLogger "github.com/giia/giia-core-engine/pkg/logger"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/domain"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/providers"
+)
+
+type RespondImpersonationUseCase struct {
+	impersonationRepo providers.ImpersonationRepository
+	auditRepo         providers.AuditLogRepository
+	logger            pkgLogger.Logger
+}
+
+func NewRespondImpersonationUseCase(
+	impersonationRepo providers.ImpersonationRepository,
+	auditRepo providers.AuditLogRepository,
+	logger pkgLogger.Logger,
+) *RespondImpersonationUseCase {
+	return &RespondImpersonationUseCase{
+		impersonationRepo: impersonationRepo,
+		auditRepo:         auditRepo,
+		logger:            logger,
+	}
+}
+
+// Execute records the target user's consent decision.
+func (uc *RespondImpersonationUseCase) Execute(ctx context.Context, userID, sessionID uuid.UUID, approve bool) (*domain.ImpersonationSession, error) {
+	session, err := getSession(ctx, uc.impersonationRepo, uc.logger, sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if session.TargetUserID != userID {
+		return nil, pkgErrors.NewForbidden("only the impersonated user can respond to this request")
+	}
+
+	if session.Status != domain.ImpersonationStatusPending {
+		return nil, pkgErrors.NewConflict("impersonation request is no longer pending")
+	}
+
+	now := time.Now()
+	if now.After(session.ConsentExpires) {
+		return nil, pkgErrors.NewConflict("impersonation request has expired")
+	}
+
+	action := domain.AuditActionImpersonationDenied
+	session.Status = domain.ImpersonationStatusDenied
+	if approve {
+		action = domain.AuditActionImpersonationApproved
+		session.Status = domain.ImpersonationStatusApproved
+	}
+	session.RespondedAt = &now
+
+	if err := uc.impersonationRepo.Update(ctx, session); err != nil {
+		uc.logger.Error(ctx, err, "Failed to update impersonation request", pkgLogger.Tags{
+			"impersonation_id": session.ID.String(),
+		})
+		return nil, pkgErrors.NewInternalServerError("failed to update impersonation request")
+	}
+
+	if err := recordLifecycle(ctx, uc.auditRepo, uc.logger, session, userID, action); err != nil {
+		return nil, err
+	}
+
+	uc.logger.Info(ctx, "Impersonation request answered", pkgLogger.Tags{
+		"impersonation_id": session.ID.String(),
+		"status":           string(session.Status),
+	})
+
+	return session, nil
+}
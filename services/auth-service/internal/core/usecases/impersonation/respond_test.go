@@ -0,0 +1,104 @@
+package impersonation
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/domain"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/providers"
+)
+
+func TestRespondImpersonationUseCase_Execute_WithApprovalFromTarget_ApprovesSession(t *testing.T) {
+	// Given
+	givenSession := &domain.ImpersonationSession{
+		ID:             uuid.New(),
+		ImpersonatorID: uuid.New(),
+		TargetUserID:   uuid.New(),
+		Status:         domain.ImpersonationStatusPending,
+		ConsentExpires: time.Now().Add(time.Hour),
+	}
+
+	mockImpersonationRepo := new(providers.MockImpersonationRepository)
+	mockAuditRepo := new(providers.MockAuditLogRepository)
+	mockLogger := new(providers.MockLogger)
+
+	useCase := NewRespondImpersonationUseCase(mockImpersonationRepo, mockAuditRepo, mockLogger)
+
+	mockImpersonationRepo.On("GetByID", mock.Anything, givenSession.ID).Return(givenSession, nil)
+	mockImpersonationRepo.On("Update", mock.Anything, givenSession).Return(nil)
+	mockAuditRepo.On("Create", mock.Anything, mock.MatchedBy(func(l *domain.AuditLog) bool {
+		return l.Action == domain.AuditActionImpersonationApproved && l.ActorID == givenSession.TargetUserID
+	})).Return(nil)
+	mockLogger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	// When
+	session, err := useCase.Execute(context.Background(), givenSession.TargetUserID, givenSession.ID, true)
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, domain.ImpersonationStatusApproved, session.Status)
+	assert.NotNil(t, session.RespondedAt)
+	mockImpersonationRepo.AssertExpectations(t)
+	mockAuditRepo.AssertExpectations(t)
+}
+
+func TestRespondImpersonationUseCase_Execute_WithResponseFromImpersonator_ReturnsForbidden(t *testing.T) {
+	// Given
+	givenSession := &domain.ImpersonationSession{
+		ID:             uuid.New(),
+		ImpersonatorID: uuid.New(),
+		TargetUserID:   uuid.New(),
+		Status:         domain.ImpersonationStatusPending,
+		ConsentExpires: time.Now().Add(time.Hour),
+	}
+
+	mockImpersonationRepo := new(providers.MockImpersonationRepository)
+	mockAuditRepo := new(providers.MockAuditLogRepository)
+	mockLogger := new(providers.MockLogger)
+
+	useCase := NewRespondImpersonationUseCase(mockImpersonationRepo, mockAuditRepo, mockLogger)
+
+	mockImpersonationRepo.On("GetByID", mock.Anything, givenSession.ID).Return(givenSession, nil)
+
+	// When
+	session, err := useCase.Execute(context.Background(), givenSession.ImpersonatorID, givenSession.ID, true)
+
+	// Then
+	assert.Error(t, err)
+	assert.Nil(t, session)
+	assert.Contains(t, err.Error(), "only the impersonated user")
+	mockImpersonationRepo.AssertNotCalled(t, "Update")
+}
+
+func TestRespondImpersonationUseCase_Execute_WithExpiredRequest_ReturnsConflict(t *testing.T) {
+	// Given
+	givenSession := &domain.ImpersonationSession{
+		ID:             uuid.New(),
+		ImpersonatorID: uuid.New(),
+		TargetUserID:   uuid.New(),
+		Status:         domain.ImpersonationStatusPending,
+		ConsentExpires: time.Now().Add(-time.Minute),
+	}
+
+	mockImpersonationRepo := new(providers.MockImpersonationRepository)
+	mockAuditRepo := new(providers.MockAuditLogRepository)
+	mockLogger := new(providers.MockLogger)
+
+	useCase := NewRespondImpersonationUseCase(mockImpersonationRepo, mockAuditRepo, mockLogger)
+
+	mockImpersonationRepo.On("GetByID", mock.Anything, givenSession.ID).Return(givenSession, nil)
+
+	// When
+	session, err := useCase.Execute(context.Background(), givenSession.TargetUserID, givenSession.ID, true)
+
+	// Then
+	assert.Error(t, err)
+	assert.Nil(t, session)
+	assert.Contains(t, err.Error(), "expired")
+	mockImpersonationRepo.AssertNotCalled(t, "Update")
+}
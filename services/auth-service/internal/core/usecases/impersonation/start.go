@@ -0,0 +1,118 @@
+package impersonation
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	pkgErrors "github.com/giia/giia-core-engine/pkg/errors"
+	pkgLogger "github.com/giia/giia-core-engine/pkg/logger"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/domain"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/providers"
+)
+
+type StartImpersonationUseCase struct {
+	impersonationRepo providers.ImpersonationRepository
+	userRepo          providers.UserRepository
+	jwtManager        providers.JWTManager
+	auditRepo         providers.AuditLogRepository
+	logger            pkgLogger.Logger
+}
+
+func NewStartImpersonationUseCase(
+	impersonationRepo providers.ImpersonationRepository,
+	userRepo providers.UserRepository,
+	jwtManager providers.JWTManager,
+	auditRepo providers.AuditLogRepository,
+	logger pkgLogger.Logger,
+) *StartImpersonationUseCase {
+	return &StartImpersonationUseCase{
+		impersonationRepo: impersonationRepo,
+		userRepo:          userRepo,
+		jwtManager:        jwtManager,
+		auditRepo:         auditRepo,
+		logger:            logger,
+	}
+}
+
+// Execute issues a short-lived access token for the target user once consent
+// has been given. Each approval starts at most one session.
+func (uc *StartImpersonationUseCase) Execute(ctx context.Context, impersonatorID, sessionID uuid.UUID) (*domain.ImpersonationTokenResponse, error) {
+	session, err := getSession(ctx, uc.impersonationRepo, uc.logger, sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if session.ImpersonatorID != impersonatorID {
+		return nil, pkgErrors.NewForbidden("impersonation request belongs to another user")
+	}
+
+	if session.Status != domain.ImpersonationStatusApproved {
+		return nil, pkgErrors.NewConflict("impersonation request has not been approved")
+	}
+
+	now := time.Now()
+	if now.After(session.ConsentExpires) {
+		return nil, pkgErrors.NewConflict("impersonation consent has expired")
+	}
+
+	target, err := uc.userRepo.GetByID(ctx, session.TargetUserID)
+	if err != nil {
+		uc.logger.Error(ctx, err, "Failed to get impersonated user", pkgLogger.Tags{
+			"user_id": session.TargetUserID.String(),
+		})
+		return nil, pkgErrors.NewNotFound("target user not found")
+	}
+
+	if target.Status != domain.UserStatusActive {
+		return nil, pkgErrors.NewBadRequest("target user is not active")
+	}
+
+	token, err := uc.jwtManager.GenerateImpersonationToken(target.ID, target.OrganizationID, target.Email, nil, &providers.ImpersonationClaims{
+		ImpersonatorID:  impersonatorID,
+		ImpersonationID: session.ID,
+		ReadOnly:        session.ReadOnly,
+		Expiry:          sessionTTL,
+	})
+	if err != nil {
+		uc.logger.Error(ctx, err, "Failed to generate impersonation token", pkgLogger.Tags{
+			"impersonation_id": session.ID.String(),
+		})
+		return nil, pkgErrors.NewInternalServerError("failed to generate impersonation token")
+	}
+
+	expiresAt := now.Add(sessionTTL)
+	session.Status = domain.ImpersonationStatusActive
+	session.StartedAt = &now
+	session.ExpiresAt = &expiresAt
+
+	// The approval is consumed by a conditional update so two concurrent
+	// starts cannot both hand out a token for the same consent
+	started, err := uc.impersonationRepo.StartApproved(ctx, session)
+	if err != nil {
+		uc.logger.Error(ctx, err, "Failed to start impersonation session", pkgLogger.Tags{
+			"impersonation_id": session.ID.String(),
+		})
+		return nil, pkgErrors.NewInternalServerError("failed to start impersonation session")
+	}
+	if !started {
+		return nil, pkgErrors.NewConflict("impersonation request has already been started")
+	}
+
+	if err := recordLifecycle(ctx, uc.auditRepo, uc.logger, session, impersonatorID, domain.AuditActionImpersonationStarted); err != nil {
+		return nil, err
+	}
+
+	uc.logger.Info(ctx, "Impersonation session started", pkgLogger.Tags{
+		"impersonation_id": session.ID.String(),
+		"impersonator_id":  impersonatorID.String(),
+		"target_user_id":   target.ID.String(),
+	})
+
+	return &domain.ImpersonationTokenResponse{
+		AccessToken: token,
+		ExpiresAt:   expiresAt,
+		ReadOnly:    session.ReadOnly,
+	}, nil
+}
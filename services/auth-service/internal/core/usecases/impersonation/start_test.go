@@ -0,0 +1,160 @@
+package impersonation
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/domain"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/providers"
+)
+
+func TestStartImpersonationUseCase_Execute_WithApprovedSession_IssuesToken(t *testing.T) {
+	// Given
+	givenTarget := &domain.User{ID: uuid.New(), OrganizationID: uuid.New(), Email: "customer@example.com", Status: domain.UserStatusActive}
+	givenSession := &domain.ImpersonationSession{
+		ID:             uuid.New(),
+		OrganizationID: givenTarget.OrganizationID,
+		ImpersonatorID: uuid.New(),
+		TargetUserID:   givenTarget.ID,
+		ReadOnly:       true,
+		Status:         domain.ImpersonationStatusApproved,
+		ConsentExpires: time.Now().Add(time.Hour),
+	}
+
+	mockImpersonationRepo := new(providers.MockImpersonationRepository)
+	mockUserRepo := new(providers.MockUserRepository)
+	mockJWTManager := new(providers.MockJWTManager)
+	mockAuditRepo := new(providers.MockAuditLogRepository)
+	mockLogger := new(providers.MockLogger)
+
+	useCase := NewStartImpersonationUseCase(mockImpersonationRepo, mockUserRepo, mockJWTManager, mockAuditRepo, mockLogger)
+
+	mockImpersonationRepo.On("GetByID", mock.Anything, givenSession.ID).Return(givenSession, nil)
+	mockUserRepo.On("GetByID", mock.Anything, givenTarget.ID).Return(givenTarget, nil)
+	mockJWTManager.On("GenerateImpersonationToken", givenTarget.ID, givenTarget.OrganizationID, givenTarget.Email, mock.Anything,
+		mock.MatchedBy(func(c *providers.ImpersonationClaims) bool {
+			return c.ImpersonatorID == givenSession.ImpersonatorID && c.ImpersonationID == givenSession.ID && c.ReadOnly
+		})).Return("impersonation-token", nil)
+	mockImpersonationRepo.On("StartApproved", mock.Anything, givenSession).Return(true, nil)
+	mockAuditRepo.On("Create", mock.Anything, mock.MatchedBy(func(l *domain.AuditLog) bool {
+		return l.Action == domain.AuditActionImpersonationStarted
+	})).Return(nil)
+	mockLogger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	// When
+	response, err := useCase.Execute(context.Background(), givenSession.ImpersonatorID, givenSession.ID)
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, "impersonation-token", response.AccessToken)
+	assert.True(t, response.ReadOnly)
+	assert.Equal(t, domain.ImpersonationStatusActive, givenSession.Status)
+	mockJWTManager.AssertExpectations(t)
+	mockAuditRepo.AssertExpectations(t)
+}
+
+func TestStartImpersonationUseCase_Execute_WithPendingSession_ReturnsConflict(t *testing.T) {
+	// Given
+	givenSession := &domain.ImpersonationSession{
+		ID:             uuid.New(),
+		ImpersonatorID: uuid.New(),
+		TargetUserID:   uuid.New(),
+		Status:         domain.ImpersonationStatusPending,
+		ConsentExpires: time.Now().Add(time.Hour),
+	}
+
+	mockImpersonationRepo := new(providers.MockImpersonationRepository)
+	mockUserRepo := new(providers.MockUserRepository)
+	mockJWTManager := new(providers.MockJWTManager)
+	mockAuditRepo := new(providers.MockAuditLogRepository)
+	mockLogger := new(providers.MockLogger)
+
+	useCase := NewStartImpersonationUseCase(mockImpersonationRepo, mockUserRepo, mockJWTManager, mockAuditRepo, mockLogger)
+
+	mockImpersonationRepo.On("GetByID", mock.Anything, givenSession.ID).Return(givenSession, nil)
+
+	// When
+	response, err := useCase.Execute(context.Background(), givenSession.ImpersonatorID, givenSession.ID)
+
+	// Then
+	assert.Error(t, err)
+	assert.Nil(t, response)
+	assert.Contains(t, err.Error(), "has not been approved")
+	mockJWTManager.AssertNotCalled(t, "GenerateImpersonationToken")
+}
+
+func TestStartImpersonationUseCase_Execute_WithAuditFailure_ReturnsInternalError(t *testing.T) {
+	// Given
+	givenTarget := &domain.User{ID: uuid.New(), OrganizationID: uuid.New(), Status: domain.UserStatusActive}
+	givenSession := &domain.ImpersonationSession{
+		ID:             uuid.New(),
+		ImpersonatorID: uuid.New(),
+		TargetUserID:   givenTarget.ID,
+		Status:         domain.ImpersonationStatusApproved,
+		ConsentExpires: time.Now().Add(time.Hour),
+	}
+
+	mockImpersonationRepo := new(providers.MockImpersonationRepository)
+	mockUserRepo := new(providers.MockUserRepository)
+	mockJWTManager := new(providers.MockJWTManager)
+	mockAuditRepo := new(providers.MockAuditLogRepository)
+	mockLogger := new(providers.MockLogger)
+
+	useCase := NewStartImpersonationUseCase(mockImpersonationRepo, mockUserRepo, mockJWTManager, mockAuditRepo, mockLogger)
+
+	mockImpersonationRepo.On("GetByID", mock.Anything, givenSession.ID).Return(givenSession, nil)
+	mockUserRepo.On("GetByID", mock.Anything, givenTarget.ID).Return(givenTarget, nil)
+	mockJWTManager.On("GenerateImpersonationToken", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return("impersonation-token", nil)
+	mockImpersonationRepo.On("StartApproved", mock.Anything, givenSession).Return(true, nil)
+	mockAuditRepo.On("Create", mock.Anything, mock.Anything).Return(errors.New("database unavailable"))
+	mockLogger.On("Error", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
+
+	// When
+	response, err := useCase.Execute(context.Background(), givenSession.ImpersonatorID, givenSession.ID)
+
+	// Then
+	assert.Error(t, err)
+	assert.Nil(t, response)
+	assert.Contains(t, err.Error(), "failed to write audit log")
+}
+
+func TestStartImpersonationUseCase_Execute_WithApprovalAlreadyConsumed_ReturnsConflict(t *testing.T) {
+	// Given
+	givenTarget := &domain.User{ID: uuid.New(), OrganizationID: uuid.New(), Status: domain.UserStatusActive}
+	givenSession := &domain.ImpersonationSession{
+		ID:             uuid.New(),
+		ImpersonatorID: uuid.New(),
+		TargetUserID:   givenTarget.ID,
+		Status:         domain.ImpersonationStatusApproved,
+		ConsentExpires: time.Now().Add(time.Hour),
+	}
+
+	mockImpersonationRepo := new(providers.MockImpersonationRepository)
+	mockUserRepo := new(providers.MockUserRepository)
+	mockJWTManager := new(providers.MockJWTManager)
+	mockAuditRepo := new(providers.MockAuditLogRepository)
+	mockLogger := new(providers.MockLogger)
+
+	useCase := NewStartImpersonationUseCase(mockImpersonationRepo, mockUserRepo, mockJWTManager, mockAuditRepo, mockLogger)
+
+	mockImpersonationRepo.On("GetByID", mock.Anything, givenSession.ID).Return(givenSession, nil)
+	mockUserRepo.On("GetByID", mock.Anything, givenTarget.ID).Return(givenTarget, nil)
+	mockJWTManager.On("GenerateImpersonationToken", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return("impersonation-token", nil)
+	mockImpersonationRepo.On("StartApproved", mock.Anything, givenSession).Return(false, nil)
+
+	// When
+	response, err := useCase.Execute(context.Background(), givenSession.ImpersonatorID, givenSession.ID)
+
+	// Then
+	assert.Error(t, err)
+	assert.Nil(t, response)
+	assert.Contains(t, err.Error(), "has already been started")
+	mockImpersonationRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	mockAuditRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,187 @@
+package membership
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	pkgErrors "github.com/giia/giia-core-engine/pkg/errors"
+	pkgLogger "github.com/giia/giia-core-engine/pkg/logger"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/domain"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/providers"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/usecases/audit"
+)
+
+type AddMemberUseCase struct {
+	membershipRepo providers.MembershipRepository
+	userRepo       providers.UserRepository
+	roleRepo       providers.RoleRepository
+	cache          providers.PermissionCache
+	auditRepo      providers.AuditLogRepository
+	logger         pkgLogger.Logger
+}
+
+func NewAddMemberUseCase(
+	membershipRepo providers.MembershipRepository,
+	userRepo providers.UserRepository,
+	roleRepo providers.RoleRepository,
+	cache providers.PermissionCache,
+	auditRepo providers.AuditLogRepository,
+	logger pkgLogger.Logger,
+) *AddMemberUseCase {
+	return &AddMemberUseCase{
+		membershipRepo: membershipRepo,
+		userRepo:       userRepo,
+		roleRepo:       roleRepo,
+		cache:          cache,
+		auditRepo:      auditRepo,
+		logger:         logger,
+	}
+}
+
+// Execute gives an existing user from another organization access to orgID
+// with the requested roles. A previously revoked membership is reactivated.
+func (uc *AddMemberUseCase) Execute(ctx context.Context, orgID, actorID uuid.UUID, req *domain.AddMemberRequest) (*domain.MemberResponse, error) {
+	if orgID == uuid.Nil {
+		return nil, pkgErrors.NewBadRequest("organization ID cannot be empty")
+	}
+
+	email := strings.ToLower(strings.TrimSpace(req.Email))
+	if email == "" {
+		return nil, pkgErrors.NewBadRequest("email is required")
+	}
+
+	if len(req.RoleIDs) == 0 {
+		return nil, pkgErrors.NewBadRequest("at least one role is required")
+	}
+
+	user, err := uc.userRepo.GetByEmail(ctx, email)
+	if err != nil {
+		uc.logger.Error(ctx, err, "Failed to get user by email", pkgLogger.Tags{
+			"organization_id": orgID.String(),
+		})
+		return nil, pkgErrors.NewNotFound("user not found")
+	}
+
+	if user.OrganizationID == orgID {
+		return nil, pkgErrors.NewConflict("user already belongs to this organization")
+	}
+
+	if user.Status != domain.UserStatusActive {
+		return nil, pkgErrors.NewBadRequest("user is not active")
+	}
+
+	roles, err := uc.resolveRoles(ctx, orgID, req.RoleIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	membership, err := uc.upsertMembership(ctx, user.ID, orgID, actorID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, role := range roles {
+		if err := uc.roleRepo.AssignRoleToUserInOrganization(ctx, user.ID, role.ID, orgID, actorID); err != nil {
+			uc.logger.Error(ctx, err, "Failed to assign member role", pkgLogger.Tags{
+				"organization_id": orgID.String(),
+				"user_id":         user.ID.String(),
+				"role_id":         role.ID.String(),
+			})
+			return nil, pkgErrors.NewInternalServerError("failed to assign member roles")
+		}
+	}
+
+	if err := uc.cache.InvalidateUserPermissions(ctx, user.ID.String()); err != nil {
+		uc.logger.Error(ctx, err, "Failed to invalidate user permissions cache", pkgLogger.Tags{
+			"user_id": user.ID.String(),
+		})
+	}
+
+	audit.Record(ctx, uc.auditRepo, uc.logger, orgID, actorID, domain.AuditActionMemberAdded, "user:"+user.ID.String(), "")
+
+	uc.logger.Info(ctx, "Organization member added", pkgLogger.Tags{
+		"organization_id":      orgID.String(),
+		"user_id":              user.ID.String(),
+		"home_organization_id": user.OrganizationID.String(),
+		"roles_count":          len(roles),
+	})
+
+	return &domain.MemberResponse{
+		UserID:    user.ID,
+		Email:     user.Email,
+		FirstName: user.FirstName,
+		LastName:  user.LastName,
+		Roles:     roleNames(roles),
+		JoinedAt:  membership.CreatedAt,
+	}, nil
+}
+
+// resolveRoles loads the requested roles, accepting only system roles and
+// roles owned by orgID.
+func (uc *AddMemberUseCase) resolveRoles(ctx context.Context, orgID uuid.UUID, roleIDs []string) ([]*domain.Role, error) {
+	roles := make([]*domain.Role, 0, len(roleIDs))
+	for _, rawID := range roleIDs {
+		roleID, err := uuid.Parse(rawID)
+		if err != nil {
+			return nil, pkgErrors.NewBadRequest("invalid role ID format")
+		}
+
+		role, err := uc.roleRepo.GetByID(ctx, roleID)
+		if err != nil {
+			uc.logger.Error(ctx, err, "Failed to get role", pkgLogger.Tags{
+				"role_id": roleID.String(),
+			})
+			return nil, pkgErrors.NewNotFound("role not found")
+		}
+
+		if !role.IsSystem && (role.OrganizationID == nil || *role.OrganizationID != orgID) {
+			return nil, pkgErrors.NewBadRequest("role does not belong to this organization")
+		}
+
+		roles = append(roles, role)
+	}
+	return roles, nil
+}
+
+func (uc *AddMemberUseCase) upsertMembership(ctx context.Context, userID, orgID, actorID uuid.UUID) (*domain.OrganizationMembership, error) {
+	existing, err := uc.membershipRepo.Get(ctx, userID, orgID)
+	if err == nil {
+		if existing.IsActive() {
+			return nil, pkgErrors.NewConflict("user is already a member of this organization")
+		}
+
+		existing.Status = domain.MembershipStatusActive
+		existing.InvitedBy = &actorID
+		existing.RevokedAt = nil
+		existing.CreatedAt = time.Now()
+
+		if err := uc.membershipRepo.Update(ctx, existing); err != nil {
+			uc.logger.Error(ctx, err, "Failed to reactivate membership", pkgLogger.Tags{
+				"organization_id": orgID.String(),
+				"user_id":         userID.String(),
+			})
+			return nil, pkgErrors.NewInternalServerError("failed to add member")
+		}
+		return existing, nil
+	}
+
+	membership := &domain.OrganizationMembership{
+		UserID:         userID,
+		OrganizationID: orgID,
+		Status:         domain.MembershipStatusActive,
+		InvitedBy:      &actorID,
+	}
+
+	if err := uc.membershipRepo.Create(ctx, membership); err != nil {
+		uc.logger.Error(ctx, err, "Failed to create membership", pkgLogger.Tags{
+			"organization_id": orgID.String(),
+			"user_id":         userID.String(),
+		})
+		return nil, pkgErrors.NewInternalServerError("failed to add member")
+	}
+
+	return membership, nil
+}
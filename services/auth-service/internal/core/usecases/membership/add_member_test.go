@@ -0,0 +1,152 @@
+package membership
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/domain"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/providers"
+)
+
+type addMemberMocks struct {
+	membershipRepo *providers.MockMembershipRepository
+	userRepo       *providers.MockUserRepository
+	roleRepo       *providers.MockRoleRepository
+	cache          *providers.MockPermissionCache
+	auditRepo      *providers.MockAuditLogRepository
+	logger         *providers.MockLogger
+}
+
+func newAddMemberUseCase() (*AddMemberUseCase, *addMemberMocks) {
+	m := &addMemberMocks{
+		membershipRepo: new(providers.MockMembershipRepository),
+		userRepo:       new(providers.MockUserRepository),
+		roleRepo:       new(providers.MockRoleRepository),
+		cache:          new(providers.MockPermissionCache),
+		auditRepo:      new(providers.MockAuditLogRepository),
+		logger:         new(providers.MockLogger),
+	}
+	m.logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	m.logger.On("Error", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
+
+	return NewAddMemberUseCase(m.membershipRepo, m.userRepo, m.roleRepo, m.cache, m.auditRepo, m.logger), m
+}
+
+func TestAddMemberUseCase_Execute_WithUserFromAnotherOrganization_CreatesMembershipAndRoles(t *testing.T) {
+	// Given
+	givenOrgID := uuid.New()
+	givenActorID := uuid.New()
+	givenUser := &domain.User{ID: uuid.New(), OrganizationID: uuid.New(), Email: "consultant@partner.com", Status: domain.UserStatusActive}
+	givenRole := &domain.Role{ID: uuid.New(), Name: "Planner", OrganizationID: &givenOrgID}
+
+	useCase, m := newAddMemberUseCase()
+
+	m.userRepo.On("GetByEmail", mock.Anything, "consultant@partner.com").Return(givenUser, nil)
+	m.roleRepo.On("GetByID", mock.Anything, givenRole.ID).Return(givenRole, nil)
+	m.membershipRepo.On("Get", mock.Anything, givenUser.ID, givenOrgID).Return(nil, errors.New("record not found"))
+	m.membershipRepo.On("Create", mock.Anything, mock.MatchedBy(func(ms *domain.OrganizationMembership) bool {
+		return ms.UserID == givenUser.ID && ms.OrganizationID == givenOrgID && ms.Status == domain.MembershipStatusActive
+	})).Return(nil)
+	m.roleRepo.On("AssignRoleToUserInOrganization", mock.Anything, givenUser.ID, givenRole.ID, givenOrgID, givenActorID).Return(nil)
+	m.cache.On("InvalidateUserPermissions", mock.Anything, givenUser.ID.String()).Return(nil)
+	m.auditRepo.On("Create", mock.Anything, mock.MatchedBy(func(l *domain.AuditLog) bool {
+		return l.Action == domain.AuditActionMemberAdded && l.OrganizationID == givenOrgID
+	})).Return(nil)
+
+	// When
+	member, err := useCase.Execute(context.Background(), givenOrgID, givenActorID, &domain.AddMemberRequest{
+		Email:   " Consultant@Partner.com ",
+		RoleIDs: []string{givenRole.ID.String()},
+	})
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, givenUser.ID, member.UserID)
+	assert.Equal(t, []string{"Planner"}, member.Roles)
+	m.membershipRepo.AssertExpectations(t)
+	m.roleRepo.AssertExpectations(t)
+	m.cache.AssertExpectations(t)
+	m.auditRepo.AssertExpectations(t)
+}
+
+func TestAddMemberUseCase_Execute_WithRoleFromAnotherOrganization_ReturnsBadRequest(t *testing.T) {
+	// Given
+	givenOrgID := uuid.New()
+	givenOtherOrgID := uuid.New()
+	givenUser := &domain.User{ID: uuid.New(), OrganizationID: uuid.New(), Status: domain.UserStatusActive}
+	givenRole := &domain.Role{ID: uuid.New(), Name: "Planner", OrganizationID: &givenOtherOrgID}
+
+	useCase, m := newAddMemberUseCase()
+
+	m.userRepo.On("GetByEmail", mock.Anything, "consultant@partner.com").Return(givenUser, nil)
+	m.roleRepo.On("GetByID", mock.Anything, givenRole.ID).Return(givenRole, nil)
+
+	// When
+	member, err := useCase.Execute(context.Background(), givenOrgID, uuid.New(), &domain.AddMemberRequest{
+		Email:   "consultant@partner.com",
+		RoleIDs: []string{givenRole.ID.String()},
+	})
+
+	// Then
+	assert.Error(t, err)
+	assert.Nil(t, member)
+	assert.Contains(t, err.Error(), "role does not belong to this organization")
+	m.membershipRepo.AssertNotCalled(t, "Create")
+}
+
+func TestAddMemberUseCase_Execute_WithHomeOrganizationUser_ReturnsConflict(t *testing.T) {
+	// Given
+	givenOrgID := uuid.New()
+	givenUser := &domain.User{ID: uuid.New(), OrganizationID: givenOrgID, Status: domain.UserStatusActive}
+
+	useCase, m := newAddMemberUseCase()
+
+	m.userRepo.On("GetByEmail", mock.Anything, "ana@acme.com").Return(givenUser, nil)
+
+	// When
+	member, err := useCase.Execute(context.Background(), givenOrgID, uuid.New(), &domain.AddMemberRequest{
+		Email:   "ana@acme.com",
+		RoleIDs: []string{uuid.New().String()},
+	})
+
+	// Then
+	assert.Error(t, err)
+	assert.Nil(t, member)
+	assert.Contains(t, err.Error(), "already belongs to this organization")
+}
+
+func TestAddMemberUseCase_Execute_WithRevokedMembership_ReactivatesIt(t *testing.T) {
+	// Given
+	givenOrgID := uuid.New()
+	givenActorID := uuid.New()
+	givenUser := &domain.User{ID: uuid.New(), OrganizationID: uuid.New(), Status: domain.UserStatusActive}
+	givenRole := &domain.Role{ID: uuid.New(), Name: "viewer", IsSystem: true}
+	givenMembership := &domain.OrganizationMembership{UserID: givenUser.ID, OrganizationID: givenOrgID, Status: domain.MembershipStatusRevoked}
+
+	useCase, m := newAddMemberUseCase()
+
+	m.userRepo.On("GetByEmail", mock.Anything, "consultant@partner.com").Return(givenUser, nil)
+	m.roleRepo.On("GetByID", mock.Anything, givenRole.ID).Return(givenRole, nil)
+	m.membershipRepo.On("Get", mock.Anything, givenUser.ID, givenOrgID).Return(givenMembership, nil)
+	m.membershipRepo.On("Update", mock.Anything, givenMembership).Return(nil)
+	m.roleRepo.On("AssignRoleToUserInOrganization", mock.Anything, givenUser.ID, givenRole.ID, givenOrgID, givenActorID).Return(nil)
+	m.cache.On("InvalidateUserPermissions", mock.Anything, givenUser.ID.String()).Return(nil)
+	m.auditRepo.On("Create", mock.Anything, mock.Anything).Return(nil)
+
+	// When
+	_, err := useCase.Execute(context.Background(), givenOrgID, givenActorID, &domain.AddMemberRequest{
+		Email:   "consultant@partner.com",
+		RoleIDs: []string{givenRole.ID.String()},
+	})
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, domain.MembershipStatusActive, givenMembership.Status)
+	assert.Nil(t, givenMembership.RevokedAt)
+	m.membershipRepo.AssertNotCalled(t, "Create")
+}
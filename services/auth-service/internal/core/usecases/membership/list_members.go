@@ -0,0 +1,73 @@
+package membership
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	pkgErrors "github.com/giia/giia-core-engine/pkg/errors"
+	pkgLogger "github.com/giia/giia-core-engine/pkg/logger"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/domain"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/providers"
+)
+
+type ListMembersUseCase struct {
+	membershipRepo providers.MembershipRepository
+	roleRepo       providers.RoleRepository
+	logger         pkgLogger.Logger
+}
+
+func NewListMembersUseCase(
+	membershipRepo providers.MembershipRepository,
+	roleRepo providers.RoleRepository,
+	logger pkgLogger.Logger,
+) *ListMembersUseCase {
+	return &ListMembersUseCase{
+		membershipRepo: membershipRepo,
+		roleRepo:       roleRepo,
+		logger:         logger,
+	}
+}
+
+// Execute lists the active guest members of orgID with the roles they hold
+// there. Users whose home organization is orgID are not included.
+func (uc *ListMembersUseCase) Execute(ctx context.Context, orgID uuid.UUID) ([]*domain.MemberResponse, error) {
+	if orgID == uuid.Nil {
+		return nil, pkgErrors.NewBadRequest("organization ID cannot be empty")
+	}
+
+	memberships, err := uc.membershipRepo.ListByOrganization(ctx, orgID)
+	if err != nil {
+		uc.logger.Error(ctx, err, "Failed to list memberships", pkgLogger.Tags{
+			"organization_id": orgID.String(),
+		})
+		return nil, pkgErrors.NewInternalServerError("failed to list members")
+	}
+
+	members := make([]*domain.MemberResponse, 0, len(memberships))
+	for _, membership := range memberships {
+		roles, err := uc.roleRepo.GetUserRolesInOrganization(ctx, membership.UserID, orgID)
+		if err != nil {
+			uc.logger.Error(ctx, err, "Failed to get member roles", pkgLogger.Tags{
+				"organization_id": orgID.String(),
+				"user_id":         membership.UserID.String(),
+			})
+			return nil, pkgErrors.NewInternalServerError("failed to list members")
+		}
+
+		member := &domain.MemberResponse{
+			UserID:   membership.UserID,
+			Roles:    roleNames(roles),
+			JoinedAt: membership.CreatedAt,
+		}
+		if membership.User != nil {
+			member.Email = membership.User.Email
+			member.FirstName = membership.User.FirstName
+			member.LastName = membership.User.LastName
+		}
+
+		members = append(members, member)
+	}
+
+	return members, nil
+}
@@ -0,0 +1,93 @@
+package membership
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	pkgErrors "github.com/giia/giia-core-engine/pkg/errors"
+	pkgLogger "github.com/giia/giia-core-engine/pkg/logger"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/domain"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/providers"
+)
+
+type ListUserOrganizationsUseCase struct {
+	userRepo       providers.UserRepository
+	orgRepo        providers.OrganizationRepository
+	membershipRepo providers.MembershipRepository
+	logger         pkgLogger.Logger
+}
+
+func NewListUserOrganizationsUseCase(
+	userRepo providers.UserRepository,
+	orgRepo providers.OrganizationRepository,
+	membershipRepo providers.MembershipRepository,
+	logger pkgLogger.Logger,
+) *ListUserOrganizationsUseCase {
+	return &ListUserOrganizationsUseCase{
+		userRepo:       userRepo,
+		orgRepo:        orgRepo,
+		membershipRepo: membershipRepo,
+		logger:         logger,
+	}
+}
+
+// Execute lists the organizations the user can switch into: the home
+// organization first, followed by active guest memberships in active
+// organizations.
+func (uc *ListUserOrganizationsUseCase) Execute(ctx context.Context, userID uuid.UUID) ([]*domain.UserOrganization, error) {
+	if userID == uuid.Nil {
+		return nil, pkgErrors.NewBadRequest("user ID cannot be empty")
+	}
+
+	user, err := uc.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		uc.logger.Error(ctx, err, "Failed to get user", pkgLogger.Tags{
+			"user_id": userID.String(),
+		})
+		return nil, pkgErrors.NewNotFound("user not found")
+	}
+
+	home, err := uc.orgRepo.GetByID(ctx, user.OrganizationID)
+	if err != nil {
+		uc.logger.Error(ctx, err, "Failed to get home organization", pkgLogger.Tags{
+			"organization_id": user.OrganizationID.String(),
+		})
+		return nil, pkgErrors.NewNotFound("organization not found")
+	}
+
+	organizations := []*domain.UserOrganization{
+		{OrganizationID: home.ID, Name: home.Name, Slug: home.Slug, Home: true},
+	}
+
+	memberships, err := uc.membershipRepo.ListByUser(ctx, userID)
+	if err != nil {
+		uc.logger.Error(ctx, err, "Failed to list user memberships", pkgLogger.Tags{
+			"user_id": userID.String(),
+		})
+		return nil, pkgErrors.NewInternalServerError("failed to list organizations")
+	}
+
+	for _, membership := range memberships {
+		org, err := uc.orgRepo.GetByID(ctx, membership.OrganizationID)
+		if err != nil {
+			uc.logger.Warn(ctx, "Skipping membership for unknown organization", pkgLogger.Tags{
+				"user_id":         userID.String(),
+				"organization_id": membership.OrganizationID.String(),
+			})
+			continue
+		}
+
+		if org.Status != domain.OrganizationStatusActive {
+			continue
+		}
+
+		organizations = append(organizations, &domain.UserOrganization{
+			OrganizationID: org.ID,
+			Name:           org.Name,
+			Slug:           org.Slug,
+		})
+	}
+
+	return organizations, nil
+}
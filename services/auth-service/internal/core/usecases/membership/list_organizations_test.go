@@ -0,0 +1,47 @@
+package membership
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/domain"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/providers"
+)
+
+func TestListUserOrganizationsUseCase_Execute_WithMemberships_ListsHomeFirstAndSkipsInactive(t *testing.T) {
+	// Given
+	givenUser := &domain.User{ID: uuid.New(), OrganizationID: uuid.New()}
+	givenGuestOrg := &domain.Organization{ID: uuid.New(), Name: "Partner", Slug: "partner", Status: domain.OrganizationStatusActive}
+	givenSuspendedOrg := &domain.Organization{ID: uuid.New(), Slug: "suspended", Status: domain.OrganizationStatusSuspended}
+
+	mockUserRepo := new(providers.MockUserRepository)
+	mockOrgRepo := new(providers.MockOrganizationRepository)
+	mockMembershipRepo := new(providers.MockMembershipRepository)
+	mockLogger := new(providers.MockLogger)
+
+	useCase := NewListUserOrganizationsUseCase(mockUserRepo, mockOrgRepo, mockMembershipRepo, mockLogger)
+
+	mockUserRepo.On("GetByID", mock.Anything, givenUser.ID).Return(givenUser, nil)
+	mockOrgRepo.On("GetByID", mock.Anything, givenUser.OrganizationID).Return(&domain.Organization{ID: givenUser.OrganizationID, Slug: "acme"}, nil)
+	mockMembershipRepo.On("ListByUser", mock.Anything, givenUser.ID).Return([]*domain.OrganizationMembership{
+		{OrganizationID: givenGuestOrg.ID, Status: domain.MembershipStatusActive},
+		{OrganizationID: givenSuspendedOrg.ID, Status: domain.MembershipStatusActive},
+	}, nil)
+	mockOrgRepo.On("GetByID", mock.Anything, givenGuestOrg.ID).Return(givenGuestOrg, nil)
+	mockOrgRepo.On("GetByID", mock.Anything, givenSuspendedOrg.ID).Return(givenSuspendedOrg, nil)
+
+	// When
+	organizations, err := useCase.Execute(context.Background(), givenUser.ID)
+
+	// Then
+	assert.NoError(t, err)
+	assert.Len(t, organizations, 2)
+	assert.True(t, organizations[0].Home)
+	assert.Equal(t, "acme", organizations[0].Slug)
+	assert.False(t, organizations[1].Home)
+	assert.Equal(t, "partner", organizations[1].Slug)
+}
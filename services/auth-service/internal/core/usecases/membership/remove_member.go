@@ -0,0 +1,91 @@
+package membership
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	pkgErrors "github.com/giia/giia-core-engine/pkg/errors"
+	pkgLogger "github.com/giia/giia-core-engine/pkg/logger"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/domain"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/providers"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/usecases/audit"
+)
+
+type RemoveMemberUseCase struct {
+	membershipRepo providers.MembershipRepository
+	roleRepo       providers.RoleRepository
+	cache          providers.PermissionCache
+	auditRepo      providers.AuditLogRepository
+	logger         pkgLogger.Logger
+}
+
+func NewRemoveMemberUseCase(
+	membershipRepo providers.MembershipRepository,
+	roleRepo providers.RoleRepository,
+	cache providers.PermissionCache,
+	auditRepo providers.AuditLogRepository,
+	logger pkgLogger.Logger,
+) *RemoveMemberUseCase {
+	return &RemoveMemberUseCase{
+		membershipRepo: membershipRepo,
+		roleRepo:       roleRepo,
+		cache:          cache,
+		auditRepo:      auditRepo,
+		logger:         logger,
+	}
+}
+
+// Execute revokes the user's membership in orgID and drops the roles they
+// held there. Tokens already minted for orgID stop passing permission checks
+// immediately because the roles behind them are gone.
+func (uc *RemoveMemberUseCase) Execute(ctx context.Context, orgID, actorID, userID uuid.UUID) error {
+	if orgID == uuid.Nil {
+		return pkgErrors.NewBadRequest("organization ID cannot be empty")
+	}
+
+	if userID == uuid.Nil {
+		return pkgErrors.NewBadRequest("user ID cannot be empty")
+	}
+
+	membership, err := uc.membershipRepo.Get(ctx, userID, orgID)
+	if err != nil || !membership.IsActive() {
+		return pkgErrors.NewNotFound("membership not found")
+	}
+
+	if err := uc.roleRepo.RemoveUserRolesInOrganization(ctx, userID, orgID); err != nil {
+		uc.logger.Error(ctx, err, "Failed to remove member roles", pkgLogger.Tags{
+			"organization_id": orgID.String(),
+			"user_id":         userID.String(),
+		})
+		return pkgErrors.NewInternalServerError("failed to remove member")
+	}
+
+	now := time.Now()
+	membership.Status = domain.MembershipStatusRevoked
+	membership.RevokedAt = &now
+
+	if err := uc.membershipRepo.Update(ctx, membership); err != nil {
+		uc.logger.Error(ctx, err, "Failed to revoke membership", pkgLogger.Tags{
+			"organization_id": orgID.String(),
+			"user_id":         userID.String(),
+		})
+		return pkgErrors.NewInternalServerError("failed to remove member")
+	}
+
+	if err := uc.cache.InvalidateUserPermissions(ctx, userID.String()); err != nil {
+		uc.logger.Error(ctx, err, "Failed to invalidate user permissions cache", pkgLogger.Tags{
+			"user_id": userID.String(),
+		})
+	}
+
+	audit.Record(ctx, uc.auditRepo, uc.logger, orgID, actorID, domain.AuditActionMemberRemoved, "user:"+userID.String(), "")
+
+	uc.logger.Info(ctx, "Organization member removed", pkgLogger.Tags{
+		"organization_id": orgID.String(),
+		"user_id":         userID.String(),
+	})
+
+	return nil
+}
@@ -0,0 +1,73 @@
+package membership
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/domain"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/providers"
+)
+
+func TestRemoveMemberUseCase_Execute_WithActiveMembership_RevokesAndDropsRoles(t *testing.T) {
+	// Given
+	givenOrgID := uuid.New()
+	givenUserID := uuid.New()
+	givenMembership := &domain.OrganizationMembership{UserID: givenUserID, OrganizationID: givenOrgID, Status: domain.MembershipStatusActive}
+
+	mockMembershipRepo := new(providers.MockMembershipRepository)
+	mockRoleRepo := new(providers.MockRoleRepository)
+	mockCache := new(providers.MockPermissionCache)
+	mockAuditRepo := new(providers.MockAuditLogRepository)
+	mockLogger := new(providers.MockLogger)
+
+	useCase := NewRemoveMemberUseCase(mockMembershipRepo, mockRoleRepo, mockCache, mockAuditRepo, mockLogger)
+
+	mockMembershipRepo.On("Get", mock.Anything, givenUserID, givenOrgID).Return(givenMembership, nil)
+	mockRoleRepo.On("RemoveUserRolesInOrganization", mock.Anything, givenUserID, givenOrgID).Return(nil)
+	mockMembershipRepo.On("Update", mock.Anything, givenMembership).Return(nil)
+	mockCache.On("InvalidateUserPermissions", mock.Anything, givenUserID.String()).Return(nil)
+	mockAuditRepo.On("Create", mock.Anything, mock.MatchedBy(func(l *domain.AuditLog) bool {
+		return l.Action == domain.AuditActionMemberRemoved
+	})).Return(nil)
+	mockLogger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	// When
+	err := useCase.Execute(context.Background(), givenOrgID, uuid.New(), givenUserID)
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, domain.MembershipStatusRevoked, givenMembership.Status)
+	assert.NotNil(t, givenMembership.RevokedAt)
+	mockRoleRepo.AssertExpectations(t)
+	mockCache.AssertExpectations(t)
+	mockAuditRepo.AssertExpectations(t)
+}
+
+func TestRemoveMemberUseCase_Execute_WithUnknownMembership_ReturnsNotFound(t *testing.T) {
+	// Given
+	givenOrgID := uuid.New()
+	givenUserID := uuid.New()
+
+	mockMembershipRepo := new(providers.MockMembershipRepository)
+	mockRoleRepo := new(providers.MockRoleRepository)
+	mockCache := new(providers.MockPermissionCache)
+	mockAuditRepo := new(providers.MockAuditLogRepository)
+	mockLogger := new(providers.MockLogger)
+
+	useCase := NewRemoveMemberUseCase(mockMembershipRepo, mockRoleRepo, mockCache, mockAuditRepo, mockLogger)
+
+	mockMembershipRepo.On("Get", mock.Anything, givenUserID, givenOrgID).Return(nil, errors.New("record not found"))
+
+	// When
+	err := useCase.Execute(context.Background(), givenOrgID, uuid.New(), givenUserID)
+
+	// Then
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "membership not found")
+	mockRoleRepo.AssertNotCalled(t, "RemoveUserRolesInOrganization")
+}
@@ -0,0 +1,11 @@
+package membership
+
+import "github.com/giia/giia-core-engine/services/auth-service/internal/core/domain"
+
+func roleNames(roles []*domain.Role) []string {
+	names := make([]string, 0, len(roles))
+	for _, role := range roles {
+		names = append(names, role.Name)
+	}
+	return names
+}
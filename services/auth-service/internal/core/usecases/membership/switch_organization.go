@@ -0,0 +1,140 @@
+package membership
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	pkgErrors "github.com/giia/giia-core-engine/pkg/errors"
+	pkgLogger "github.com/giia/giia-core-engine/pkg/logger"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/domain"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/providers"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/usecases/audit"
+)
+
+type SwitchOrganizationUseCase struct {
+	userRepo       providers.UserRepository
+	orgRepo        providers.OrganizationRepository
+	membershipRepo providers.MembershipRepository
+	roleRepo       providers.RoleRepository
+	jwtManager     providers.JWTManager
+	permissions    providers.PermissionResolver
+	auditRepo      providers.AuditLogRepository
+	logger         pkgLogger.Logger
+}
+
+func NewSwitchOrganizationUseCase(
+	userRepo providers.UserRepository,
+	orgRepo providers.OrganizationRepository,
+	membershipRepo providers.MembershipRepository,
+	roleRepo providers.RoleRepository,
+	jwtManager providers.JWTManager,
+	permissions providers.PermissionResolver,
+	auditRepo providers.AuditLogRepository,
+	logger pkgLogger.Logger,
+) *SwitchOrganizationUseCase {
+	return &SwitchOrganizationUseCase{
+		userRepo:       userRepo,
+		orgRepo:        orgRepo,
+		membershipRepo: membershipRepo,
+		roleRepo:       roleRepo,
+		jwtManager:     jwtManager,
+		permissions:    permissions,
+		auditRepo:      auditRepo,
+		logger:         logger,
+	}
+}
+
+// Execute mints an access token scoped to orgID carrying the roles the user
+// holds there. Refresh tokens stay bound to the home organization, so clients
+// switch again after refreshing.
+func (uc *SwitchOrganizationUseCase) Execute(ctx context.Context, userID, orgID uuid.UUID) (*domain.SwitchOrganizationResponse, error) {
+	if userID == uuid.Nil {
+		return nil, pkgErrors.NewBadRequest("user ID cannot be empty")
+	}
+
+	if orgID == uuid.Nil {
+		return nil, pkgErrors.NewBadRequest("organization ID cannot be empty")
+	}
+
+	user, err := uc.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		uc.logger.Error(ctx, err, "Failed to get user", pkgLogger.Tags{
+			"user_id": userID.String(),
+		})
+		return nil, pkgErrors.NewNotFound("user not found")
+	}
+
+	if user.Status != domain.UserStatusActive {
+		return nil, pkgErrors.NewForbidden("user is not active")
+	}
+
+	if user.OrganizationID != orgID {
+		membership, err := uc.membershipRepo.Get(ctx, userID, orgID)
+		if err != nil || !membership.IsActive() {
+			return nil, pkgErrors.NewForbidden("user is not a member of this organization")
+		}
+	}
+
+	org, err := uc.orgRepo.GetByID(ctx, orgID)
+	if err != nil {
+		uc.logger.Error(ctx, err, "Failed to get organization", pkgLogger.Tags{
+			"organization_id": orgID.String(),
+		})
+		return nil, pkgErrors.NewNotFound("organization not found")
+	}
+
+	if org.Status != domain.OrganizationStatusActive {
+		return nil, pkgErrors.NewForbidden("organization is not active")
+	}
+
+	roles, err := uc.roleRepo.GetUserRolesInOrganization(ctx, userID, orgID)
+	if err != nil {
+		uc.logger.Error(ctx, err, "Failed to get user roles in organization", pkgLogger.Tags{
+			"user_id":         userID.String(),
+			"organization_id": orgID.String(),
+		})
+		return nil, pkgErrors.NewInternalServerError("failed to get user roles")
+	}
+
+	names := roleNames(roles)
+
+	// Embedded permissions are an optimization for other services; without
+	// them they call CheckPermission, so a lookup failure does not block the
+	// switch
+	var permissions []string
+	if uc.permissions != nil {
+		permissions, err = uc.permissions.ResolvePermissions(ctx, userID, orgID)
+		if err != nil {
+			uc.logger.Warn(ctx, "Issuing access token without embedded permissions", pkgLogger.Tags{
+				"user_id":         userID.String(),
+				"organization_id": orgID.String(),
+				"error":           err.Error(),
+			})
+			permissions = nil
+		}
+	}
+
+	accessToken, err := uc.jwtManager.GenerateAccessToken(user.ID, orgID, user.Email, names, permissions)
+	if err != nil {
+		uc.logger.Error(ctx, err, "Failed to generate access token", pkgLogger.Tags{
+			"user_id": userID.String(),
+		})
+		return nil, pkgErrors.NewInternalServerError("failed to generate access token")
+	}
+
+	audit.Record(ctx, uc.auditRepo, uc.logger, orgID, userID, domain.AuditActionOrganizationSwitched, "user:"+userID.String(), "")
+
+	uc.logger.Info(ctx, "User switched organization", pkgLogger.Tags{
+		"user_id":         userID.String(),
+		"organization_id": orgID.String(),
+		"home":            user.OrganizationID == orgID,
+	})
+
+	return &domain.SwitchOrganizationResponse{
+		AccessToken:    accessToken,
+		ExpiresIn:      int(uc.jwtManager.GetAccessExpiry().Seconds()),
+		OrganizationID: orgID,
+		Roles:          names,
+	}, nil
+}
@@ -0,0 +1,134 @@
+package membership
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/domain"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/providers"
+)
+
+type switchMocks struct {
+	userRepo       *providers.MockUserRepository
+	orgRepo        *providers.MockOrganizationRepository
+	membershipRepo *providers.MockMembershipRepository
+	roleRepo       *providers.MockRoleRepository
+	jwtManager     *providers.MockJWTManager
+	permissions    *providers.MockPermissionResolver
+	auditRepo      *providers.MockAuditLogRepository
+	logger         *providers.MockLogger
+}
+
+func newSwitchOrganizationUseCase() (*SwitchOrganizationUseCase, *switchMocks) {
+	m := &switchMocks{
+		userRepo:       new(providers.MockUserRepository),
+		orgRepo:        new(providers.MockOrganizationRepository),
+		membershipRepo: new(providers.MockMembershipRepository),
+		roleRepo:       new(providers.MockRoleRepository),
+		jwtManager:     new(providers.MockJWTManager),
+		permissions:    new(providers.MockPermissionResolver),
+		auditRepo:      new(providers.MockAuditLogRepository),
+		logger:         new(providers.MockLogger),
+	}
+	m.logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	return NewSwitchOrganizationUseCase(m.userRepo, m.orgRepo, m.membershipRepo, m.roleRepo, m.jwtManager, m.permissions, m.auditRepo, m.logger), m
+}
+
+func TestSwitchOrganizationUseCase_Execute_WithActiveMembership_IssuesScopedToken(t *testing.T) {
+	// Given
+	givenGuestOrgID := uuid.New()
+	givenUser := &domain.User{ID: uuid.New(), OrganizationID: uuid.New(), Email: "consultant@partner.com", Status: domain.UserStatusActive}
+
+	useCase, m := newSwitchOrganizationUseCase()
+
+	m.userRepo.On("GetByID", mock.Anything, givenUser.ID).Return(givenUser, nil)
+	m.membershipRepo.On("Get", mock.Anything, givenUser.ID, givenGuestOrgID).Return(&domain.OrganizationMembership{Status: domain.MembershipStatusActive}, nil)
+	m.orgRepo.On("GetByID", mock.Anything, givenGuestOrgID).Return(&domain.Organization{ID: givenGuestOrgID, Status: domain.OrganizationStatusActive}, nil)
+	m.roleRepo.On("GetUserRolesInOrganization", mock.Anything, givenUser.ID, givenGuestOrgID).Return([]*domain.Role{{Name: "Planner"}}, nil)
+	m.permissions.On("ResolvePermissions", mock.Anything, givenUser.ID, givenGuestOrgID).Return([]string{"execution:po:read"}, nil)
+	m.jwtManager.On("GenerateAccessToken", givenUser.ID, givenGuestOrgID, givenUser.Email, []string{"Planner"}, []string{"execution:po:read"}).Return("scoped-token", nil)
+	m.jwtManager.On("GetAccessExpiry").Return(15 * time.Minute)
+	m.auditRepo.On("Create", mock.Anything, mock.MatchedBy(func(l *domain.AuditLog) bool {
+		return l.Action == domain.AuditActionOrganizationSwitched && l.OrganizationID == givenGuestOrgID
+	})).Return(nil)
+
+	// When
+	response, err := useCase.Execute(context.Background(), givenUser.ID, givenGuestOrgID)
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, "scoped-token", response.AccessToken)
+	assert.Equal(t, givenGuestOrgID, response.OrganizationID)
+	assert.Equal(t, 900, response.ExpiresIn)
+	m.jwtManager.AssertExpectations(t)
+	m.auditRepo.AssertExpectations(t)
+}
+
+func TestSwitchOrganizationUseCase_Execute_WithHomeOrganization_SkipsMembershipLookup(t *testing.T) {
+	// Given
+	givenUser := &domain.User{ID: uuid.New(), OrganizationID: uuid.New(), Email: "ana@acme.com", Status: domain.UserStatusActive}
+
+	useCase, m := newSwitchOrganizationUseCase()
+
+	m.userRepo.On("GetByID", mock.Anything, givenUser.ID).Return(givenUser, nil)
+	m.orgRepo.On("GetByID", mock.Anything, givenUser.OrganizationID).Return(&domain.Organization{ID: givenUser.OrganizationID, Status: domain.OrganizationStatusActive}, nil)
+	m.roleRepo.On("GetUserRolesInOrganization", mock.Anything, givenUser.ID, givenUser.OrganizationID).Return([]*domain.Role{{Name: "admin"}}, nil)
+	m.permissions.On("ResolvePermissions", mock.Anything, givenUser.ID, givenUser.OrganizationID).Return([]string{"*:*:*"}, nil)
+	m.jwtManager.On("GenerateAccessToken", givenUser.ID, givenUser.OrganizationID, givenUser.Email, []string{"admin"}, []string{"*:*:*"}).Return("home-token", nil)
+	m.jwtManager.On("GetAccessExpiry").Return(15 * time.Minute)
+	m.auditRepo.On("Create", mock.Anything, mock.Anything).Return(nil)
+
+	// When
+	response, err := useCase.Execute(context.Background(), givenUser.ID, givenUser.OrganizationID)
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, "home-token", response.AccessToken)
+	m.membershipRepo.AssertNotCalled(t, "Get")
+}
+
+func TestSwitchOrganizationUseCase_Execute_WithoutMembership_ReturnsForbidden(t *testing.T) {
+	// Given
+	givenOrgID := uuid.New()
+	givenUser := &domain.User{ID: uuid.New(), OrganizationID: uuid.New(), Status: domain.UserStatusActive}
+
+	useCase, m := newSwitchOrganizationUseCase()
+
+	m.userRepo.On("GetByID", mock.Anything, givenUser.ID).Return(givenUser, nil)
+	m.membershipRepo.On("Get", mock.Anything, givenUser.ID, givenOrgID).Return(nil, errors.New("record not found"))
+
+	// When
+	response, err := useCase.Execute(context.Background(), givenUser.ID, givenOrgID)
+
+	// Then
+	assert.Error(t, err)
+	assert.Nil(t, response)
+	assert.Contains(t, err.Error(), "not a member of this organization")
+	m.jwtManager.AssertNotCalled(t, "GenerateAccessToken")
+}
+
+func TestSwitchOrganizationUseCase_Execute_WithRevokedMembership_ReturnsForbidden(t *testing.T) {
+	// Given
+	givenOrgID := uuid.New()
+	givenUser := &domain.User{ID: uuid.New(), OrganizationID: uuid.New(), Status: domain.UserStatusActive}
+
+	useCase, m := newSwitchOrganizationUseCase()
+
+	m.userRepo.On("GetByID", mock.Anything, givenUser.ID).Return(givenUser, nil)
+	m.membershipRepo.On("Get", mock.Anything, givenUser.ID, givenOrgID).Return(&domain.OrganizationMembership{Status: domain.MembershipStatusRevoked}, nil)
+
+	// When
+	response, err := useCase.Execute(context.Background(), givenUser.ID, givenOrgID)
+
+	// Then
+	assert.Error(t, err)
+	assert.Nil(t, response)
+	m.orgRepo.AssertNotCalled(t, "GetByID")
+}
@@ -0,0 +1,198 @@
+package organization
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/giia/giia-core-engine/pkg/calendar"
+	pkgErrors "github.com/giia/giia-core-engine/pkg/errors"
+	pkgLogger "github.com/giia/giia-core-engine/pkg/logger"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/domain"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/providers"
+)
+
+const (
+	maxCalendarHolidays  = 1000
+	maxCalendarShutdowns = 100
+)
+
+var weekdaysByName = map[string]time.Weekday{
+	"sunday":    time.Sunday,
+	"monday":    time.Monday,
+	"tuesday":   time.Tuesday,
+	"wednesday": time.Wednesday,
+	"thursday":  time.Thursday,
+	"friday":    time.Friday,
+	"saturday":  time.Saturday,
+}
+
+// BuildCalendar turns stored calendar settings into a pkg/calendar Calendar
+// for working-day arithmetic.
+func BuildCalendar(settings *domain.CalendarSettings) (*calendar.Calendar, error) {
+	workdays := make([]time.Weekday, 0, len(settings.Workdays))
+	for _, name := range settings.Workdays {
+		wd, ok := weekdaysByName[strings.ToLower(strings.TrimSpace(name))]
+		if !ok {
+			return nil, pkgErrors.NewBadRequest(fmt.Sprintf("invalid workday %q", name))
+		}
+		workdays = append(workdays, wd)
+	}
+
+	holidays := make([]time.Time, 0, len(settings.Holidays))
+	for _, h := range settings.Holidays {
+		date, err := time.Parse(calendar.DateLayout, h.Date)
+		if err != nil {
+			return nil, pkgErrors.NewBadRequest(fmt.Sprintf("invalid holiday date %q, expected YYYY-MM-DD", h.Date))
+		}
+		holidays = append(holidays, date)
+	}
+
+	shutdowns := make([]calendar.Period, 0, len(settings.Shutdowns))
+	for _, s := range settings.Shutdowns {
+		start, err := time.Parse(calendar.DateLayout, s.Start)
+		if err != nil {
+			return nil, pkgErrors.NewBadRequest(fmt.Sprintf("invalid shutdown start %q, expected YYYY-MM-DD", s.Start))
+		}
+		end, err := time.Parse(calendar.DateLayout, s.End)
+		if err != nil {
+			return nil, pkgErrors.NewBadRequest(fmt.Sprintf("invalid shutdown end %q, expected YYYY-MM-DD", s.End))
+		}
+		shutdowns = append(shutdowns, calendar.Period{Start: start, End: end})
+	}
+
+	cal, err := calendar.New(workdays, holidays, shutdowns)
+	if err != nil {
+		return nil, pkgErrors.NewBadRequest(err.Error())
+	}
+	return cal, nil
+}
+
+type GetCalendarUseCase struct {
+	orgRepo providers.OrganizationRepository
+	logger  pkgLogger.Logger
+}
+
+func NewGetCalendarUseCase(orgRepo providers.OrganizationRepository, logger pkgLogger.Logger) *GetCalendarUseCase {
+	return &GetCalendarUseCase{
+		orgRepo: orgRepo,
+		logger:  logger,
+	}
+}
+
+func (uc *GetCalendarUseCase) Execute(ctx context.Context, orgID uuid.UUID) (*domain.CalendarSettings, error) {
+	if orgID == uuid.Nil {
+		return nil, pkgErrors.NewBadRequest("organization ID cannot be empty")
+	}
+
+	org, err := uc.orgRepo.GetByID(ctx, orgID)
+	if err != nil {
+		uc.logger.Error(ctx, err, "Failed to get organization", pkgLogger.Tags{
+			"organization_id": orgID.String(),
+		})
+		return nil, pkgErrors.NewNotFound("organization not found")
+	}
+
+	return org.CalendarSettings(), nil
+}
+
+type UpdateCalendarUseCase struct {
+	orgRepo providers.OrganizationRepository
+	logger  pkgLogger.Logger
+}
+
+func NewUpdateCalendarUseCase(orgRepo providers.OrganizationRepository, logger pkgLogger.Logger) *UpdateCalendarUseCase {
+	return &UpdateCalendarUseCase{
+		orgRepo: orgRepo,
+		logger:  logger,
+	}
+}
+
+// Execute validates and replaces the organization's working calendar.
+// Holidays are deduplicated and both lists are stored sorted by date.
+func (uc *UpdateCalendarUseCase) Execute(ctx context.Context, orgID uuid.UUID, req *domain.CalendarSettings) (*domain.CalendarSettings, error) {
+	if orgID == uuid.Nil {
+		return nil, pkgErrors.NewBadRequest("organization ID cannot be empty")
+	}
+
+	if len(req.Holidays) > maxCalendarHolidays {
+		return nil, pkgErrors.NewBadRequest(fmt.Sprintf("a calendar can have at most %d holidays", maxCalendarHolidays))
+	}
+
+	if len(req.Shutdowns) > maxCalendarShutdowns {
+		return nil, pkgErrors.NewBadRequest(fmt.Sprintf("a calendar can have at most %d shutdowns", maxCalendarShutdowns))
+	}
+
+	settings := normalizeCalendar(req)
+	if _, err := BuildCalendar(settings); err != nil {
+		return nil, err
+	}
+
+	org, err := uc.orgRepo.GetByID(ctx, orgID)
+	if err != nil {
+		uc.logger.Error(ctx, err, "Failed to get organization", pkgLogger.Tags{
+			"organization_id": orgID.String(),
+		})
+		return nil, pkgErrors.NewNotFound("organization not found")
+	}
+
+	org.SetCalendarSettings(settings)
+	org.UpdatedAt = time.Now()
+
+	if err := uc.orgRepo.Update(ctx, org); err != nil {
+		uc.logger.Error(ctx, err, "Failed to update organization calendar", pkgLogger.Tags{
+			"organization_id": orgID.String(),
+		})
+		return nil, pkgErrors.NewInternalServerError("failed to update organization settings")
+	}
+
+	uc.logger.Info(ctx, "Organization calendar updated", pkgLogger.Tags{
+		"organization_id": orgID.String(),
+		"holidays":        len(settings.Holidays),
+		"shutdowns":       len(settings.Shutdowns),
+	})
+
+	return settings, nil
+}
+
+func normalizeCalendar(req *domain.CalendarSettings) *domain.CalendarSettings {
+	settings := &domain.CalendarSettings{
+		Workdays:  []string{},
+		Holidays:  []domain.CalendarHoliday{},
+		Shutdowns: []domain.CalendarShutdown{},
+	}
+
+	seenWorkdays := make(map[string]bool)
+	for _, name := range req.Workdays {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if !seenWorkdays[name] {
+			seenWorkdays[name] = true
+			settings.Workdays = append(settings.Workdays, name)
+		}
+	}
+
+	seenHolidays := make(map[string]bool)
+	for _, h := range req.Holidays {
+		date := strings.TrimSpace(h.Date)
+		if !seenHolidays[date] {
+			seenHolidays[date] = true
+			settings.Holidays = append(settings.Holidays, domain.CalendarHoliday{Date: date, Name: strings.TrimSpace(h.Name)})
+		}
+	}
+	sort.Slice(settings.Holidays, func(i, j int) bool { return settings.Holidays[i].Date < settings.Holidays[j].Date })
+
+	for _, s := range req.Shutdowns {
+		settings.Shutdowns = append(settings.Shutdowns, domain.CalendarShutdown{
+			Start: strings.TrimSpace(s.Start),
+			End:   strings.TrimSpace(s.End),
+			Name:  strings.TrimSpace(s.Name),
+		})
+	}
+	sort.Slice(settings.Shutdowns, func(i, j int) bool { return settings.Shutdowns[i].Start < settings.Shutdowns[j].Start })
+
+	return settings
+}
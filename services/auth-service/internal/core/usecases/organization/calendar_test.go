@@ -0,0 +1,86 @@
+package organization
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/domain"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/providers"
+)
+
+func TestUpdateCalendarUseCase_Execute_WithValidCalendar_StoresNormalizedCalendar(t *testing.T) {
+	// Given
+	givenOrg := &domain.Organization{ID: uuid.New()}
+
+	mockOrgRepo := new(providers.MockOrganizationRepository)
+	mockLogger := new(providers.MockLogger)
+
+	useCase := NewUpdateCalendarUseCase(mockOrgRepo, mockLogger)
+
+	mockOrgRepo.On("GetByID", mock.Anything, givenOrg.ID).Return(givenOrg, nil)
+	mockOrgRepo.On("Update", mock.Anything, givenOrg).Return(nil)
+	mockLogger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	// When
+	settings, err := useCase.Execute(context.Background(), givenOrg.ID, &domain.CalendarSettings{
+		Workdays: []string{"Monday", "tuesday", "wednesday", "thursday", "friday", "saturday"},
+		Holidays: []domain.CalendarHoliday{
+			{Date: "2026-12-25", Name: "Navidad"},
+			{Date: "2026-07-09", Name: "Independencia"},
+			{Date: "2026-12-25"},
+		},
+		Shutdowns: []domain.CalendarShutdown{{Start: "2026-01-05", End: "2026-01-16", Name: "Summer shutdown"}},
+	})
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, "monday", settings.Workdays[0])
+	assert.Len(t, settings.Holidays, 2)
+	assert.Equal(t, "2026-07-09", settings.Holidays[0].Date)
+	assert.Equal(t, settings, givenOrg.CalendarSettings())
+
+	cal, err := BuildCalendar(givenOrg.CalendarSettings())
+	assert.NoError(t, err)
+	assert.False(t, cal.IsWorkday(time.Date(2026, 12, 25, 0, 0, 0, 0, time.UTC)))
+	assert.True(t, cal.IsWorkday(time.Date(2026, 12, 26, 0, 0, 0, 0, time.UTC)))
+}
+
+func TestUpdateCalendarUseCase_Execute_WithInvertedShutdown_ReturnsBadRequest(t *testing.T) {
+	// Given
+	givenOrgID := uuid.New()
+
+	mockOrgRepo := new(providers.MockOrganizationRepository)
+	mockLogger := new(providers.MockLogger)
+
+	useCase := NewUpdateCalendarUseCase(mockOrgRepo, mockLogger)
+
+	// When
+	settings, err := useCase.Execute(context.Background(), givenOrgID, &domain.CalendarSettings{
+		Shutdowns: []domain.CalendarShutdown{{Start: "2026-01-16", End: "2026-01-05"}},
+	})
+
+	// Then
+	assert.Error(t, err)
+	assert.Nil(t, settings)
+	mockOrgRepo.AssertNotCalled(t, "GetByID")
+}
+
+func TestUpdateCalendarUseCase_Execute_WithUnknownWorkday_ReturnsBadRequest(t *testing.T) {
+	// Given
+	mockOrgRepo := new(providers.MockOrganizationRepository)
+	mockLogger := new(providers.MockLogger)
+
+	useCase := NewUpdateCalendarUseCase(mockOrgRepo, mockLogger)
+
+	// When
+	_, err := useCase.Execute(context.Background(), uuid.New(), &domain.CalendarSettings{Workdays: []string{"funday"}})
+
+	// Then
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid workday")
+}
@@ -0,0 +1,47 @@
+package organization
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	pkgErrors "github.com/giia/giia-core-engine/pkg/errors"
+	pkgLogger "github.com/giia/giia-core-engine/pkg/logger"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/domain"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/providers"
+)
+
+type GetSettingsUseCase struct {
+	orgRepo providers.OrganizationRepository
+	logger  pkgLogger.Logger
+}
+
+func NewGetSettingsUseCase(orgRepo providers.OrganizationRepository, logger pkgLogger.Logger) *GetSettingsUseCase {
+	return &GetSettingsUseCase{
+		orgRepo: orgRepo,
+		logger:  logger,
+	}
+}
+
+func (uc *GetSettingsUseCase) Execute(ctx context.Context, orgID uuid.UUID) (*domain.OrganizationSettingsResponse, error) {
+	if orgID == uuid.Nil {
+		return nil, pkgErrors.NewBadRequest("organization ID cannot be empty")
+	}
+
+	org, err := uc.orgRepo.GetByID(ctx, orgID)
+	if err != nil {
+		uc.logger.Error(ctx, err, "Failed to get organization", pkgLogger.Tags{
+			"organization_id": orgID.String(),
+		})
+		return nil, pkgErrors.NewNotFound("organization not found")
+	}
+
+	locale := org.LocaleSettings()
+
+	return &domain.OrganizationSettingsResponse{
+		OrganizationID: org.ID.String(),
+		Locale:         *locale,
+		Effective:      domain.ResolveLocale(locale),
+		Auth:           *org.AuthSettings(),
+	}, nil
+}
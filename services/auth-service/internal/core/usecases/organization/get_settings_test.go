@@ -0,0 +1,61 @@
+package organization
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/domain"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/providers"
+)
+
+func TestGetSettingsUseCase_Execute_WithPartialLocale_FallsBackToSystemDefaults(t *testing.T) {
+	// Given
+	givenOrg := &domain.Organization{
+		ID: uuid.New(),
+		Settings: map[string]interface{}{
+			"locale": map[string]interface{}{"currency": "EUR"},
+		},
+	}
+
+	mockOrgRepo := new(providers.MockOrganizationRepository)
+	mockLogger := new(providers.MockLogger)
+
+	useCase := NewGetSettingsUseCase(mockOrgRepo, mockLogger)
+
+	mockOrgRepo.On("GetByID", mock.Anything, givenOrg.ID).Return(givenOrg, nil)
+
+	// When
+	response, err := useCase.Execute(context.Background(), givenOrg.ID)
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, "EUR", response.Effective.Currency)
+	assert.Equal(t, domain.SystemLocale.Timezone, response.Effective.Timezone)
+	assert.Empty(t, response.Locale.Timezone)
+}
+
+func TestGetSettingsUseCase_Execute_WithUnknownOrganization_ReturnsNotFound(t *testing.T) {
+	// Given
+	givenOrgID := uuid.New()
+
+	mockOrgRepo := new(providers.MockOrganizationRepository)
+	mockLogger := new(providers.MockLogger)
+
+	useCase := NewGetSettingsUseCase(mockOrgRepo, mockLogger)
+
+	mockOrgRepo.On("GetByID", mock.Anything, givenOrgID).Return(nil, errors.New("record not found"))
+	mockLogger.On("Error", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
+
+	// When
+	response, err := useCase.Execute(context.Background(), givenOrgID)
+
+	// Then
+	assert.Error(t, err)
+	assert.Nil(t, response)
+	assert.Contains(t, err.Error(), "organization not found")
+}
@@ -0,0 +1,58 @@
+package organization
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	pkgErrors "github.com/giia/giia-core-engine/pkg/errors"
+	pkgLogger "github.com/giia/giia-core-engine/pkg/logger"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/domain"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/providers"
+)
+
+type UpdateAuthSettingsUseCase struct {
+	orgRepo providers.OrganizationRepository
+	logger  pkgLogger.Logger
+}
+
+func NewUpdateAuthSettingsUseCase(orgRepo providers.OrganizationRepository, logger pkgLogger.Logger) *UpdateAuthSettingsUseCase {
+	return &UpdateAuthSettingsUseCase{
+		orgRepo: orgRepo,
+		logger:  logger,
+	}
+}
+
+// Execute replaces the organization's sign-in options, e.g. enabling magic
+// link login.
+func (uc *UpdateAuthSettingsUseCase) Execute(ctx context.Context, orgID uuid.UUID, req *domain.AuthSettings) (*domain.AuthSettings, error) {
+	if orgID == uuid.Nil {
+		return nil, pkgErrors.NewBadRequest("organization ID cannot be empty")
+	}
+
+	org, err := uc.orgRepo.GetByID(ctx, orgID)
+	if err != nil {
+		uc.logger.Error(ctx, err, "Failed to get organization", pkgLogger.Tags{
+			"organization_id": orgID.String(),
+		})
+		return nil, pkgErrors.NewNotFound("organization not found")
+	}
+
+	org.SetAuthSettings(req)
+	org.UpdatedAt = time.Now()
+
+	if err := uc.orgRepo.Update(ctx, org); err != nil {
+		uc.logger.Error(ctx, err, "Failed to update organization auth settings", pkgLogger.Tags{
+			"organization_id": orgID.String(),
+		})
+		return nil, pkgErrors.NewInternalServerError("failed to update organization settings")
+	}
+
+	uc.logger.Info(ctx, "Organization auth settings updated", pkgLogger.Tags{
+		"organization_id":    orgID.String(),
+		"magic_link_enabled": req.MagicLinkEnabled,
+	})
+
+	return org.AuthSettings(), nil
+}
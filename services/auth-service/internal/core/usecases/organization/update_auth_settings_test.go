@@ -0,0 +1,39 @@
+package organization
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/domain"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/providers"
+)
+
+func TestUpdateAuthSettingsUseCase_Execute_WithNewSettings_StoresThemAndKeepsLocaleSettings(t *testing.T) {
+	// Given
+	givenOrg := &domain.Organization{ID: uuid.New()}
+	givenOrg.SetLocaleSettings(&domain.LocaleSettings{Currency: "ARS"})
+
+	mockOrgRepo := new(providers.MockOrganizationRepository)
+	mockLogger := new(providers.MockLogger)
+
+	useCase := NewUpdateAuthSettingsUseCase(mockOrgRepo, mockLogger)
+
+	mockOrgRepo.On("GetByID", mock.Anything, givenOrg.ID).Return(givenOrg, nil)
+	mockOrgRepo.On("Update", mock.Anything, givenOrg).Return(nil)
+	mockLogger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	// When
+	settings, err := useCase.Execute(context.Background(), givenOrg.ID, &domain.AuthSettings{MagicLinkEnabled: true, CaptchaRequired: true})
+
+	// Then
+	assert.NoError(t, err)
+	assert.True(t, settings.MagicLinkEnabled)
+	assert.True(t, givenOrg.AuthSettings().MagicLinkEnabled)
+	assert.True(t, givenOrg.AuthSettings().CaptchaRequired)
+	assert.Equal(t, "ARS", givenOrg.LocaleSettings().Currency)
+	mockOrgRepo.AssertExpectations(t)
+}
@@ -0,0 +1,100 @@
+package organization
+
+import (
+	"context"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	pkgErrors "github.com/giia/giia-core-engine/pkg/errors"
+	pkgLogger "github.com/giia/giia-core-engine/pkg/logger"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/domain"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/providers"
+)
+
+var currencyPattern = regexp.MustCompile(`^[A-Z]{3}$`)
+
+type UpdateSettingsUseCase struct {
+	orgRepo providers.OrganizationRepository
+	logger  pkgLogger.Logger
+}
+
+func NewUpdateSettingsUseCase(orgRepo providers.OrganizationRepository, logger pkgLogger.Logger) *UpdateSettingsUseCase {
+	return &UpdateSettingsUseCase{
+		orgRepo: orgRepo,
+		logger:  logger,
+	}
+}
+
+// Execute replaces the organization's locale defaults. Empty fields fall back
+// to the system defaults.
+func (uc *UpdateSettingsUseCase) Execute(ctx context.Context, orgID uuid.UUID, req *domain.LocaleSettings) (*domain.OrganizationSettingsResponse, error) {
+	if orgID == uuid.Nil {
+		return nil, pkgErrors.NewBadRequest("organization ID cannot be empty")
+	}
+
+	locale := domain.LocaleSettings{
+		Currency:   strings.ToUpper(strings.TrimSpace(req.Currency)),
+		Language:   strings.ToLower(strings.TrimSpace(req.Language)),
+		DateFormat: strings.TrimSpace(req.DateFormat),
+		Timezone:   strings.TrimSpace(req.Timezone),
+	}
+
+	if err := validateLocale(&locale); err != nil {
+		return nil, err
+	}
+
+	org, err := uc.orgRepo.GetByID(ctx, orgID)
+	if err != nil {
+		uc.logger.Error(ctx, err, "Failed to get organization", pkgLogger.Tags{
+			"organization_id": orgID.String(),
+		})
+		return nil, pkgErrors.NewNotFound("organization not found")
+	}
+
+	org.SetLocaleSettings(&locale)
+	org.UpdatedAt = time.Now()
+
+	if err := uc.orgRepo.Update(ctx, org); err != nil {
+		uc.logger.Error(ctx, err, "Failed to update organization settings", pkgLogger.Tags{
+			"organization_id": orgID.String(),
+		})
+		return nil, pkgErrors.NewInternalServerError("failed to update organization settings")
+	}
+
+	uc.logger.Info(ctx, "Organization settings updated", pkgLogger.Tags{
+		"organization_id": orgID.String(),
+	})
+
+	return &domain.OrganizationSettingsResponse{
+		OrganizationID: org.ID.String(),
+		Locale:         locale,
+		Effective:      domain.ResolveLocale(&locale),
+		Auth:           *org.AuthSettings(),
+	}, nil
+}
+
+func validateLocale(locale *domain.LocaleSettings) error {
+	if locale.Currency != "" && !currencyPattern.MatchString(locale.Currency) {
+		return pkgErrors.NewBadRequest("currency must be an ISO 4217 code")
+	}
+
+	if locale.Language != "" && !slices.Contains(domain.SupportedLanguages, locale.Language) {
+		return pkgErrors.NewBadRequest("unsupported language")
+	}
+
+	if locale.DateFormat != "" && !slices.Contains(domain.SupportedDateFormats, locale.DateFormat) {
+		return pkgErrors.NewBadRequest("unsupported date format")
+	}
+
+	if locale.Timezone != "" {
+		if _, err := time.LoadLocation(locale.Timezone); err != nil {
+			return pkgErrors.NewBadRequest("invalid timezone")
+		}
+	}
+
+	return nil
+}
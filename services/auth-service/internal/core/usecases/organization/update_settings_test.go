@@ -0,0 +1,78 @@
+package organization
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/domain"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/providers"
+)
+
+func TestUpdateSettingsUseCase_Execute_WithValidLocale_StoresAndResolves(t *testing.T) {
+	// Given
+	givenOrg := &domain.Organization{ID: uuid.New(), Name: "Acme"}
+
+	mockOrgRepo := new(providers.MockOrganizationRepository)
+	mockLogger := new(providers.MockLogger)
+
+	useCase := NewUpdateSettingsUseCase(mockOrgRepo, mockLogger)
+
+	mockOrgRepo.On("GetByID", mock.Anything, givenOrg.ID).Return(givenOrg, nil)
+	mockOrgRepo.On("Update", mock.Anything, givenOrg).Return(nil)
+	mockLogger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	// When
+	response, err := useCase.Execute(context.Background(), givenOrg.ID, &domain.LocaleSettings{
+		Currency: "ars",
+		Language: "es",
+		Timezone: "America/Argentina/Buenos_Aires",
+	})
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, "ARS", response.Locale.Currency)
+	assert.Equal(t, "YYYY-MM-DD", response.Effective.DateFormat)
+	assert.Equal(t, "ARS", givenOrg.LocaleSettings().Currency)
+	mockOrgRepo.AssertExpectations(t)
+}
+
+func TestUpdateSettingsUseCase_Execute_WithInvalidTimezone_ReturnsBadRequest(t *testing.T) {
+	// Given
+	mockOrgRepo := new(providers.MockOrganizationRepository)
+	mockLogger := new(providers.MockLogger)
+
+	useCase := NewUpdateSettingsUseCase(mockOrgRepo, mockLogger)
+
+	// When
+	response, err := useCase.Execute(context.Background(), uuid.New(), &domain.LocaleSettings{
+		Timezone: "Mars/Olympus_Mons",
+	})
+
+	// Then
+	assert.Error(t, err)
+	assert.Nil(t, response)
+	assert.Contains(t, err.Error(), "invalid timezone")
+	mockOrgRepo.AssertNotCalled(t, "GetByID")
+}
+
+func TestUpdateSettingsUseCase_Execute_WithUnsupportedLanguage_ReturnsBadRequest(t *testing.T) {
+	// Given
+	mockOrgRepo := new(providers.MockOrganizationRepository)
+	mockLogger := new(providers.MockLogger)
+
+	useCase := NewUpdateSettingsUseCase(mockOrgRepo, mockLogger)
+
+	// When
+	response, err := useCase.Execute(context.Background(), uuid.New(), &domain.LocaleSettings{
+		Language: "fr",
+	})
+
+	// Then
+	assert.Error(t, err)
+	assert.Nil(t, response)
+	assert.Contains(t, err.Error(), "unsupported language")
+}
@@ -50,6 +50,34 @@ func (uc *CheckPermissionUseCase) Execute(ctx context.Context, userID uuid.UUID,
 	return allowed, nil
 }
 
+// ExecuteInOrganization checks the permission against the roles the user
+// holds in orgID rather than in their home organization.
+func (uc *CheckPermissionUseCase) ExecuteInOrganization(ctx context.Context, userID, orgID uuid.UUID, requiredPermission string) (bool, error) {
+	if userID == uuid.Nil {
+		return false, pkgErrors.NewBadRequest("user ID cannot be empty")
+	}
+
+	if requiredPermission == "" {
+		return false, pkgErrors.NewBadRequest("permission cannot be empty")
+	}
+
+	userPermissions, err := uc.getUserPermissions.ExecuteInOrganization(ctx, userID, orgID)
+	if err != nil {
+		return false, err
+	}
+
+	allowed := uc.checkPermissionMatch(userPermissions, requiredPermission)
+
+	uc.logger.Debug(ctx, "Permission check completed", pkgLogger.Tags{
+		"user_id":         userID.String(),
+		"organization_id": orgID.String(),
+		"permission":      requiredPermission,
+		"allowed":         allowed,
+	})
+
+	return allowed, nil
+}
+
 func (uc *CheckPermissionUseCase) checkPermissionMatch(userPermissions []string, requiredPermission string) bool {
 	for _, userPerm := range userPermissions {
 		if userPerm == "*:*:*" {
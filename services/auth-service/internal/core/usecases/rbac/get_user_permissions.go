@@ -8,6 +8,7 @@ import (
 
 	pkgErrors "github.com/giia/giia-core-engine/pkg/errors"
 	pkgLogger "github.com/giia/giia-core-engine/pkg/logger"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/domain"
 	"github.com/giia/giia-core-engine/services/auth-service/internal/core/providers"
 )
 
@@ -36,12 +37,48 @@ func NewGetUserPermissionsUseCase(
 	}
 }
 
+// Execute returns the permissions granted by the user's home-organization
+// roles.
 func (uc *GetUserPermissionsUseCase) Execute(ctx context.Context, userID uuid.UUID) ([]string, error) {
 	if userID == uuid.Nil {
 		return nil, pkgErrors.NewBadRequest("user ID cannot be empty")
 	}
 
-	cached, err := uc.cache.GetUserPermissions(ctx, userID.String())
+	return uc.resolve(ctx, userID, userID.String(), func() ([]*domain.Role, error) {
+		return uc.roleRepo.GetUserRoles(ctx, userID)
+	})
+}
+
+// ExecuteInOrganization returns the permissions the user holds in orgID,
+// which may be a guest organization the user is a member of.
+func (uc *GetUserPermissionsUseCase) ExecuteInOrganization(ctx context.Context, userID, orgID uuid.UUID) ([]string, error) {
+	if userID == uuid.Nil {
+		return nil, pkgErrors.NewBadRequest("user ID cannot be empty")
+	}
+
+	if orgID == uuid.Nil {
+		return uc.Execute(ctx, userID)
+	}
+
+	cacheKey := providers.OrganizationScopedCacheKey(userID.String(), orgID.String())
+	return uc.resolve(ctx, userID, cacheKey, func() ([]*domain.Role, error) {
+		return uc.roleRepo.GetUserRolesInOrganization(ctx, userID, orgID)
+	})
+}
+
+// ResolvePermissions implements providers.PermissionResolver for token
+// issuance.
+func (uc *GetUserPermissionsUseCase) ResolvePermissions(ctx context.Context, userID, orgID uuid.UUID) ([]string, error) {
+	return uc.ExecuteInOrganization(ctx, userID, orgID)
+}
+
+func (uc *GetUserPermissionsUseCase) resolve(
+	ctx context.Context,
+	userID uuid.UUID,
+	cacheKey string,
+	loadRoles func() ([]*domain.Role, error),
+) ([]string, error) {
+	cached, err := uc.cache.GetUserPermissions(ctx, cacheKey)
 	if err == nil && cached != nil {
 		uc.logger.Debug(ctx, "Cache hit for user permissions", pkgLogger.Tags{
 			"user_id":           userID.String(),
@@ -54,7 +91,7 @@ func (uc *GetUserPermissionsUseCase) Execute(ctx context.Context, userID uuid.UU
 		"user_id": userID.String(),
 	})
 
-	roles, err := uc.roleRepo.GetUserRoles(ctx, userID)
+	roles, err := loadRoles()
 	if err != nil {
 		uc.logger.Error(ctx, err, "Failed to get user roles", pkgLogger.Tags{
 			"user_id": userID.String(),
@@ -104,7 +141,7 @@ func (uc *GetUserPermissionsUseCase) Execute(ctx context.Context, userID uuid.UU
 		}
 	}
 
-	if err := uc.cache.SetUserPermissions(ctx, userID.String(), permissionCodes, permissionCacheTTL); err != nil {
+	if err := uc.cache.SetUserPermissions(ctx, cacheKey, permissionCodes, permissionCacheTTL); err != nil {
 		uc.logger.Error(ctx, err, "Failed to cache user permissions", pkgLogger.Tags{
 			"user_id": userID.String(),
 		})
@@ -332,3 +332,36 @@ func TestGetUserPermissionsUseCase_Execute_WhenCacheSetFails_StillReturnsPermiss
 	assert.Contains(t, permissions, "user:read")
 	mockCache.AssertExpectations(t)
 }
+
+func TestGetUserPermissionsUseCase_ExecuteInOrganization_WithCacheMiss_UsesOrganizationRolesAndScopedKey(t *testing.T) {
+	// Given
+	givenUserID := uuid.New()
+	givenOrgID := uuid.New()
+	givenRole := &domain.Role{ID: uuid.New(), Name: "Planner", OrganizationID: &givenOrgID}
+	givenCacheKey := providers.OrganizationScopedCacheKey(givenUserID.String(), givenOrgID.String())
+
+	mockRoleRepo := new(providers.MockRoleRepository)
+	mockPermRepo := new(providers.MockPermissionRepository)
+	mockCache := new(providers.MockPermissionCache)
+	mockLogger := new(providers.MockLogger)
+
+	resolveInheritanceUC := NewResolveInheritanceUseCase(mockRoleRepo, mockPermRepo, mockLogger)
+	useCase := NewGetUserPermissionsUseCase(mockRoleRepo, resolveInheritanceUC, mockCache, mockLogger)
+
+	mockCache.On("GetUserPermissions", mock.Anything, givenCacheKey).Return(([]string)(nil), nil)
+	mockRoleRepo.On("GetUserRolesInOrganization", mock.Anything, givenUserID, givenOrgID).Return([]*domain.Role{givenRole}, nil)
+	mockRoleRepo.On("GetByID", mock.Anything, givenRole.ID).Return(givenRole, nil)
+	mockPermRepo.On("GetRolePermissions", mock.Anything, givenRole.ID).Return([]*domain.Permission{{Code: "ddmrp:buffers:read"}}, nil)
+	mockCache.On("SetUserPermissions", mock.Anything, givenCacheKey, []string{"ddmrp:buffers:read"}, 5*time.Minute).Return(nil)
+	mockLogger.On("Debug", mock.Anything, mock.Anything, mock.Anything).Return()
+	mockLogger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	// When
+	permissions, err := useCase.ExecuteInOrganization(context.Background(), givenUserID, givenOrgID)
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"ddmrp:buffers:read"}, permissions)
+	mockRoleRepo.AssertNotCalled(t, "GetUserRoles")
+	mockCache.AssertExpectations(t)
+}
@@ -0,0 +1,202 @@
+package tenant
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+
+	pkgErrors "github.com/giia/giia-core-engine/pkg/errors"
+	pkgLogger "github.com/giia/giia-core-engine/pkg/logger"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/domain"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/providers"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/usecases/audit"
+)
+
+const exportUserPageSize = 500
+
+type ExportOrganizationUseCase struct {
+	orgRepo   providers.OrganizationRepository
+	roleRepo  providers.RoleRepository
+	permRepo  providers.PermissionRepository
+	userRepo  providers.UserRepository
+	auditRepo providers.AuditLogRepository
+	logger    pkgLogger.Logger
+}
+
+func NewExportOrganizationUseCase(
+	orgRepo providers.OrganizationRepository,
+	roleRepo providers.RoleRepository,
+	permRepo providers.PermissionRepository,
+	userRepo providers.UserRepository,
+	auditRepo providers.AuditLogRepository,
+	logger pkgLogger.Logger,
+) *ExportOrganizationUseCase {
+	return &ExportOrganizationUseCase{
+		orgRepo:   orgRepo,
+		roleRepo:  roleRepo,
+		permRepo:  permRepo,
+		userRepo:  userRepo,
+		auditRepo: auditRepo,
+		logger:    logger,
+	}
+}
+
+// Execute builds the archive for orgID: settings, organization roles with
+// their permission codes, and users with their role assignments.
+func (uc *ExportOrganizationUseCase) Execute(ctx context.Context, orgID, actorID uuid.UUID) (*domain.OrganizationExport, error) {
+	if orgID == uuid.Nil {
+		return nil, pkgErrors.NewBadRequest("organization ID cannot be empty")
+	}
+
+	org, err := uc.orgRepo.GetByID(ctx, orgID)
+	if err != nil {
+		uc.logger.Error(ctx, err, "Failed to get organization", pkgLogger.Tags{
+			"organization_id": orgID.String(),
+		})
+		return nil, pkgErrors.NewNotFound("organization not found")
+	}
+
+	roles, err := uc.roleRepo.List(ctx, &orgID)
+	if err != nil {
+		uc.logger.Error(ctx, err, "Failed to list roles for export", pkgLogger.Tags{
+			"organization_id": orgID.String(),
+		})
+		return nil, pkgErrors.NewInternalServerError("failed to export roles")
+	}
+
+	refs := roleRefs(roles, orgID)
+
+	archive := &domain.OrganizationExport{
+		Version:    domain.OrganizationExportVersion,
+		ExportedAt: time.Now().UTC(),
+		Organization: domain.ExportedOrganization{
+			Name:     org.Name,
+			Slug:     org.Slug,
+			Settings: org.Settings,
+		},
+		Roles: make([]domain.ExportedRole, 0),
+		Users: make([]domain.ExportedUser, 0),
+	}
+
+	for _, role := range roles {
+		if !isOrganizationRole(role, orgID) {
+			continue
+		}
+
+		exported, err := uc.exportRole(ctx, role, refs)
+		if err != nil {
+			return nil, err
+		}
+		archive.Roles = append(archive.Roles, *exported)
+	}
+
+	users, err := uc.exportUsers(ctx, orgID, refs)
+	if err != nil {
+		return nil, err
+	}
+	archive.Users = users
+
+	audit.Record(ctx, uc.auditRepo, uc.logger, orgID, actorID, domain.AuditActionOrganizationExported, "organization:"+orgID.String(), org.Slug)
+
+	uc.logger.Info(ctx, "Organization exported", pkgLogger.Tags{
+		"organization_id": orgID.String(),
+		"roles":           len(archive.Roles),
+		"users":           len(archive.Users),
+	})
+
+	return archive, nil
+}
+
+func (uc *ExportOrganizationUseCase) exportRole(ctx context.Context, role *domain.Role, refs map[uuid.UUID]string) (*domain.ExportedRole, error) {
+	permissions, err := uc.permRepo.GetRolePermissions(ctx, role.ID)
+	if err != nil {
+		uc.logger.Error(ctx, err, "Failed to get role permissions for export", pkgLogger.Tags{
+			"role_id": role.ID.String(),
+		})
+		return nil, pkgErrors.NewInternalServerError("failed to export role permissions")
+	}
+
+	codes := make([]string, 0, len(permissions))
+	for _, permission := range permissions {
+		codes = append(codes, permission.Code)
+	}
+	sort.Strings(codes)
+
+	exported := &domain.ExportedRole{
+		Ref:         refs[role.ID],
+		Name:        role.Name,
+		Description: role.Description,
+		Permissions: codes,
+	}
+	if role.ParentRoleID != nil {
+		exported.ParentRef = refs[*role.ParentRoleID]
+	}
+
+	return exported, nil
+}
+
+func (uc *ExportOrganizationUseCase) exportUsers(ctx context.Context, orgID uuid.UUID, refs map[uuid.UUID]string) ([]domain.ExportedUser, error) {
+	exported := make([]domain.ExportedUser, 0)
+
+	for offset := 0; ; offset += exportUserPageSize {
+		users, err := uc.userRepo.ListByOrganization(ctx, orgID, offset, exportUserPageSize)
+		if err != nil {
+			uc.logger.Error(ctx, err, "Failed to list users for export", pkgLogger.Tags{
+				"organization_id": orgID.String(),
+			})
+			return nil, pkgErrors.NewInternalServerError("failed to export users")
+		}
+
+		for _, user := range users {
+			roles, err := uc.roleRepo.GetUserRoles(ctx, user.ID)
+			if err != nil {
+				uc.logger.Error(ctx, err, "Failed to get user roles for export", pkgLogger.Tags{
+					"user_id": user.ID.String(),
+				})
+				return nil, pkgErrors.NewInternalServerError("failed to export user roles")
+			}
+
+			var userRoleRefs []string
+			for _, role := range roles {
+				if ref, ok := refs[role.ID]; ok {
+					userRoleRefs = append(userRoleRefs, ref)
+				}
+			}
+
+			exported = append(exported, domain.ExportedUser{
+				Email:     user.Email,
+				FirstName: user.FirstName,
+				LastName:  user.LastName,
+				Phone:     user.Phone,
+				Status:    user.Status,
+				RoleRefs:  userRoleRefs,
+			})
+		}
+
+		if len(users) < exportUserPageSize {
+			return exported, nil
+		}
+	}
+}
+
+// roleRefs names every role visible to the organization: its own roles by
+// source ID and system roles by name, since system role IDs differ between
+// environments.
+func roleRefs(roles []*domain.Role, orgID uuid.UUID) map[uuid.UUID]string {
+	refs := make(map[uuid.UUID]string, len(roles))
+	for _, role := range roles {
+		switch {
+		case role.IsSystem:
+			refs[role.ID] = domain.SystemRoleRefPrefix + role.Name
+		case isOrganizationRole(role, orgID):
+			refs[role.ID] = role.ID.String()
+		}
+	}
+	return refs
+}
+
+func isOrganizationRole(role *domain.Role, orgID uuid.UUID) bool {
+	return !role.IsSystem && role.OrganizationID != nil && *role.OrganizationID == orgID
+}
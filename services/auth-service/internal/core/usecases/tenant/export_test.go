@@ -0,0 +1,91 @@
+package tenant
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/domain"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/providers"
+)
+
+func TestExportOrganizationUseCase_Execute_WithCustomRoles_ExportsRefsAndPermissionCodes(t *testing.T) {
+	// Given
+	givenOrgID := uuid.New()
+	givenActorID := uuid.New()
+
+	givenAdmin := &domain.Role{ID: uuid.New(), Name: "admin", IsSystem: true}
+	givenPlanner := &domain.Role{ID: uuid.New(), Name: "Planner", OrganizationID: &givenOrgID, ParentRoleID: &givenAdmin.ID}
+	givenUser := &domain.User{ID: uuid.New(), Email: "ana@acme.com", Password: "hash", Status: domain.UserStatusActive, OrganizationID: givenOrgID}
+
+	mockOrgRepo := new(providers.MockOrganizationRepository)
+	mockRoleRepo := new(providers.MockRoleRepository)
+	mockPermRepo := new(providers.MockPermissionRepository)
+	mockUserRepo := new(providers.MockUserRepository)
+	mockAuditRepo := new(providers.MockAuditLogRepository)
+	mockLogger := new(providers.MockLogger)
+
+	useCase := NewExportOrganizationUseCase(mockOrgRepo, mockRoleRepo, mockPermRepo, mockUserRepo, mockAuditRepo, mockLogger)
+
+	mockOrgRepo.On("GetByID", mock.Anything, givenOrgID).Return(&domain.Organization{
+		ID:       givenOrgID,
+		Slug:     "acme-sandbox",
+		Settings: map[string]interface{}{"locale": map[string]interface{}{"currency": "ARS"}},
+	}, nil)
+	mockRoleRepo.On("List", mock.Anything, &givenOrgID).Return([]*domain.Role{givenAdmin, givenPlanner}, nil)
+	mockPermRepo.On("GetRolePermissions", mock.Anything, givenPlanner.ID).Return([]*domain.Permission{
+		{Code: "ddmrp:buffers:read"},
+		{Code: "catalog:products:read"},
+	}, nil)
+	mockUserRepo.On("ListByOrganization", mock.Anything, givenOrgID, 0, exportUserPageSize).Return([]*domain.User{givenUser}, nil)
+	mockRoleRepo.On("GetUserRoles", mock.Anything, givenUser.ID).Return([]*domain.Role{givenAdmin, givenPlanner}, nil)
+	mockAuditRepo.On("Create", mock.Anything, mock.MatchedBy(func(l *domain.AuditLog) bool {
+		return l.Action == domain.AuditActionOrganizationExported && l.ActorID == givenActorID
+	})).Return(nil)
+	mockLogger.On("Info", mock.Anything, "Organization exported", mock.Anything).Return()
+
+	// When
+	archive, err := useCase.Execute(context.Background(), givenOrgID, givenActorID)
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, domain.OrganizationExportVersion, archive.Version)
+	assert.Equal(t, "acme-sandbox", archive.Organization.Slug)
+	assert.Len(t, archive.Roles, 1)
+	assert.Equal(t, givenPlanner.ID.String(), archive.Roles[0].Ref)
+	assert.Equal(t, "system:admin", archive.Roles[0].ParentRef)
+	assert.Equal(t, []string{"catalog:products:read", "ddmrp:buffers:read"}, archive.Roles[0].Permissions)
+	assert.Len(t, archive.Users, 1)
+	assert.Equal(t, []string{"system:admin", givenPlanner.ID.String()}, archive.Users[0].RoleRefs)
+	mockAuditRepo.AssertExpectations(t)
+}
+
+func TestExportOrganizationUseCase_Execute_WithUnknownOrganization_ReturnsNotFound(t *testing.T) {
+	// Given
+	givenOrgID := uuid.New()
+
+	mockOrgRepo := new(providers.MockOrganizationRepository)
+	mockRoleRepo := new(providers.MockRoleRepository)
+	mockPermRepo := new(providers.MockPermissionRepository)
+	mockUserRepo := new(providers.MockUserRepository)
+	mockAuditRepo := new(providers.MockAuditLogRepository)
+	mockLogger := new(providers.MockLogger)
+
+	useCase := NewExportOrganizationUseCase(mockOrgRepo, mockRoleRepo, mockPermRepo, mockUserRepo, mockAuditRepo, mockLogger)
+
+	mockOrgRepo.On("GetByID", mock.Anything, givenOrgID).Return(nil, errors.New("record not found"))
+	mockLogger.On("Error", mock.Anything, mock.Anything, "Failed to get organization", mock.Anything).Return()
+
+	// When
+	archive, err := useCase.Execute(context.Background(), givenOrgID, uuid.New())
+
+	// Then
+	assert.Error(t, err)
+	assert.Nil(t, archive)
+	assert.Contains(t, err.Error(), "organization not found")
+	mockRoleRepo.AssertNotCalled(t, "List")
+}
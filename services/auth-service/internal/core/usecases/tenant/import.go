@@ -0,0 +1,458 @@
+package tenant
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+
+	pkgErrors "github.com/giia/giia-core-engine/pkg/errors"
+	pkgLogger "github.com/giia/giia-core-engine/pkg/logger"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/domain"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/providers"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/usecases/audit"
+)
+
+const (
+	newRoleMapping = "new"
+
+	// importInvitationTTL is how long imported users have to set their
+	// password from the invitation email.
+	importInvitationTTL = 7 * 24 * time.Hour
+)
+
+type ImportOrganizationUseCase struct {
+	orgRepo      providers.OrganizationRepository
+	roleRepo     providers.RoleRepository
+	permRepo     providers.PermissionRepository
+	userRepo     providers.UserRepository
+	tokenRepo    providers.TokenRepository
+	auditRepo    providers.AuditLogRepository
+	emailService providers.EmailService
+	logger       pkgLogger.Logger
+}
+
+func NewImportOrganizationUseCase(
+	orgRepo providers.OrganizationRepository,
+	roleRepo providers.RoleRepository,
+	permRepo providers.PermissionRepository,
+	userRepo providers.UserRepository,
+	tokenRepo providers.TokenRepository,
+	auditRepo providers.AuditLogRepository,
+	emailService providers.EmailService,
+	logger pkgLogger.Logger,
+) *ImportOrganizationUseCase {
+	return &ImportOrganizationUseCase{
+		orgRepo:      orgRepo,
+		roleRepo:     roleRepo,
+		permRepo:     permRepo,
+		userRepo:     userRepo,
+		tokenRepo:    tokenRepo,
+		auditRepo:    auditRepo,
+		emailService: emailService,
+		logger:       logger,
+	}
+}
+
+// importInviter is who imported users see as having invited them.
+type importInviter struct {
+	name             string
+	organizationName string
+}
+
+// importPlan holds what validation resolved against this environment.
+type importPlan struct {
+	roles         []domain.ExportedRole
+	permissionIDs map[string]uuid.UUID
+	systemRoleIDs map[string]uuid.UUID
+}
+
+// Execute applies an archive to orgID. The whole archive is validated before
+// anything is written. Roles and users that already exist (by name and
+// email) are reused unchanged, so a failed import can be retried. Imported
+// users are inactive without a usable password; each one is emailed an
+// invitation to set a password, which also activates the account.
+func (uc *ImportOrganizationUseCase) Execute(ctx context.Context, orgID, actorID uuid.UUID, req *domain.ImportOrganizationRequest) (*domain.ImportOrganizationResult, error) {
+	if orgID == uuid.Nil {
+		return nil, pkgErrors.NewBadRequest("organization ID cannot be empty")
+	}
+
+	org, err := uc.orgRepo.GetByID(ctx, orgID)
+	if err != nil {
+		uc.logger.Error(ctx, err, "Failed to get organization", pkgLogger.Tags{
+			"organization_id": orgID.String(),
+		})
+		return nil, pkgErrors.NewNotFound("organization not found")
+	}
+
+	plan, err := uc.validate(ctx, &req.Archive)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &domain.ImportOrganizationResult{
+		DryRun:          req.DryRun,
+		SettingsApplied: len(req.Archive.Organization.Settings),
+		RoleMapping:     make(map[string]string),
+	}
+
+	if !req.DryRun && len(req.Archive.Organization.Settings) > 0 {
+		if org.Settings == nil {
+			org.Settings = make(map[string]interface{})
+		}
+		for key, value := range req.Archive.Organization.Settings {
+			org.Settings[key] = value
+		}
+		if err := uc.orgRepo.Update(ctx, org); err != nil {
+			uc.logger.Error(ctx, err, "Failed to apply imported settings", pkgLogger.Tags{
+				"organization_id": orgID.String(),
+			})
+			return nil, pkgErrors.NewInternalServerError("failed to import organization settings")
+		}
+	}
+
+	for ref, id := range plan.systemRoleIDs {
+		result.RoleMapping[ref] = id.String()
+	}
+
+	for _, role := range plan.roles {
+		if err := uc.importRole(ctx, orgID, role, plan, req.DryRun, result); err != nil {
+			return nil, err
+		}
+	}
+
+	inviter := &importInviter{organizationName: org.Name}
+	if !req.DryRun && len(req.Archive.Users) > 0 {
+		if actor, err := uc.userRepo.GetByID(ctx, actorID); err == nil && actor != nil {
+			inviter.name = strings.TrimSpace(actor.FirstName + " " + actor.LastName)
+		}
+	}
+
+	for _, user := range req.Archive.Users {
+		if err := uc.importUser(ctx, orgID, actorID, user, inviter, req.DryRun, result); err != nil {
+			return nil, err
+		}
+	}
+
+	if !req.DryRun {
+		audit.Record(ctx, uc.auditRepo, uc.logger, orgID, actorID, domain.AuditActionOrganizationImported, "organization:"+orgID.String(),
+			fmt.Sprintf("from %s: %d roles created, %d users created", req.Archive.Organization.Slug, result.RolesCreated, result.UsersCreated))
+	}
+
+	uc.logger.Info(ctx, "Organization import completed", pkgLogger.Tags{
+		"organization_id": orgID.String(),
+		"source_slug":     req.Archive.Organization.Slug,
+		"dry_run":         req.DryRun,
+		"roles_created":   result.RolesCreated,
+		"roles_reused":    result.RolesReused,
+		"users_created":   result.UsersCreated,
+		"users_reused":    result.UsersReused,
+		"users_invited":   result.UsersInvited,
+	})
+
+	return result, nil
+}
+
+func (uc *ImportOrganizationUseCase) validate(ctx context.Context, archive *domain.OrganizationExport) (*importPlan, error) {
+	if archive.Version != domain.OrganizationExportVersion {
+		return nil, pkgErrors.NewBadRequest(fmt.Sprintf("unsupported archive version %d (expected %d)", archive.Version, domain.OrganizationExportVersion))
+	}
+
+	plan := &importPlan{
+		permissionIDs: make(map[string]uuid.UUID),
+		systemRoleIDs: make(map[string]uuid.UUID),
+	}
+
+	archiveRefs := make(map[string]bool, len(archive.Roles))
+	names := make(map[string]bool, len(archive.Roles))
+	for _, role := range archive.Roles {
+		switch {
+		case role.Ref == "" || strings.HasPrefix(role.Ref, domain.SystemRoleRefPrefix):
+			return nil, pkgErrors.NewBadRequest(fmt.Sprintf("role %q has an invalid ref", role.Name))
+		case strings.TrimSpace(role.Name) == "":
+			return nil, pkgErrors.NewBadRequest(fmt.Sprintf("role %s has no name", role.Ref))
+		case archiveRefs[role.Ref]:
+			return nil, pkgErrors.NewBadRequest(fmt.Sprintf("duplicate role ref %s", role.Ref))
+		case names[role.Name]:
+			return nil, pkgErrors.NewBadRequest(fmt.Sprintf("duplicate role name %q", role.Name))
+		}
+		archiveRefs[role.Ref] = true
+		names[role.Name] = true
+	}
+
+	var unknown []string
+	checkRef := func(ref string) error {
+		if archiveRefs[ref] {
+			return nil
+		}
+		if !strings.HasPrefix(ref, domain.SystemRoleRefPrefix) {
+			return pkgErrors.NewBadRequest(fmt.Sprintf("unknown role ref %s", ref))
+		}
+		if _, ok := plan.systemRoleIDs[ref]; ok {
+			return nil
+		}
+
+		name := strings.TrimPrefix(ref, domain.SystemRoleRefPrefix)
+		role, err := uc.roleRepo.GetByName(ctx, name, nil)
+		if err != nil || role == nil || !role.IsSystem {
+			unknown = append(unknown, ref)
+			return nil
+		}
+		plan.systemRoleIDs[ref] = role.ID
+		return nil
+	}
+
+	for _, role := range archive.Roles {
+		if role.ParentRef != "" {
+			if err := checkRef(role.ParentRef); err != nil {
+				return nil, err
+			}
+		}
+
+		for _, code := range role.Permissions {
+			if _, ok := plan.permissionIDs[code]; ok {
+				continue
+			}
+			permission, err := uc.permRepo.GetByCode(ctx, code)
+			if err != nil || permission == nil {
+				unknown = append(unknown, code)
+				continue
+			}
+			plan.permissionIDs[code] = permission.ID
+		}
+	}
+
+	emails := make(map[string]bool, len(archive.Users))
+	for _, user := range archive.Users {
+		email := strings.ToLower(strings.TrimSpace(user.Email))
+		if email == "" {
+			return nil, pkgErrors.NewBadRequest("user without email in archive")
+		}
+		if emails[email] {
+			return nil, pkgErrors.NewBadRequest(fmt.Sprintf("duplicate user %s", email))
+		}
+		emails[email] = true
+
+		for _, ref := range user.RoleRefs {
+			if err := checkRef(ref); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return nil, pkgErrors.NewBadRequest("archive references permissions or system roles missing in this environment: " + strings.Join(unknown, ", "))
+	}
+
+	ordered, err := parentsFirst(archive.Roles)
+	if err != nil {
+		return nil, err
+	}
+	plan.roles = ordered
+
+	return plan, nil
+}
+
+func (uc *ImportOrganizationUseCase) importRole(
+	ctx context.Context,
+	orgID uuid.UUID,
+	exported domain.ExportedRole,
+	plan *importPlan,
+	dryRun bool,
+	result *domain.ImportOrganizationResult,
+) error {
+	existing, err := uc.roleRepo.GetByName(ctx, exported.Name, &orgID)
+	if err == nil && existing != nil && !existing.IsSystem {
+		result.RoleMapping[exported.Ref] = existing.ID.String()
+		result.RolesReused++
+		return nil
+	}
+
+	result.RolesCreated++
+	if dryRun {
+		result.RoleMapping[exported.Ref] = newRoleMapping
+		return nil
+	}
+
+	role := &domain.Role{
+		Name:           exported.Name,
+		Description:    exported.Description,
+		OrganizationID: &orgID,
+	}
+	if exported.ParentRef != "" {
+		parentID := uuid.MustParse(result.RoleMapping[exported.ParentRef])
+		role.ParentRoleID = &parentID
+	}
+
+	if err := uc.roleRepo.Create(ctx, role); err != nil {
+		uc.logger.Error(ctx, err, "Failed to create imported role", pkgLogger.Tags{
+			"organization_id": orgID.String(),
+			"role_name":       exported.Name,
+		})
+		return pkgErrors.NewInternalServerError("failed to import role " + exported.Name)
+	}
+	result.RoleMapping[exported.Ref] = role.ID.String()
+
+	if len(exported.Permissions) == 0 {
+		return nil
+	}
+
+	permissionIDs := make([]uuid.UUID, len(exported.Permissions))
+	for i, code := range exported.Permissions {
+		permissionIDs[i] = plan.permissionIDs[code]
+	}
+	if err := uc.permRepo.AssignPermissionsToRole(ctx, role.ID, permissionIDs); err != nil {
+		uc.logger.Error(ctx, err, "Failed to assign imported role permissions", pkgLogger.Tags{
+			"role_id": role.ID.String(),
+		})
+		return pkgErrors.NewInternalServerError("failed to import permissions for role " + exported.Name)
+	}
+
+	return nil
+}
+
+func (uc *ImportOrganizationUseCase) importUser(
+	ctx context.Context,
+	orgID, actorID uuid.UUID,
+	exported domain.ExportedUser,
+	inviter *importInviter,
+	dryRun bool,
+	result *domain.ImportOrganizationResult,
+) error {
+	email := strings.ToLower(strings.TrimSpace(exported.Email))
+
+	existing, err := uc.userRepo.GetByEmailAndOrg(ctx, email, orgID)
+	if err == nil && existing != nil {
+		result.UsersReused++
+		return nil
+	}
+
+	result.UsersCreated++
+	if dryRun {
+		return nil
+	}
+
+	password, err := unusablePassword()
+	if err != nil {
+		uc.logger.Error(ctx, err, "Failed to generate placeholder password", nil)
+		return pkgErrors.NewInternalServerError("failed to import user " + email)
+	}
+
+	user := &domain.User{
+		Email:          email,
+		Password:       password,
+		FirstName:      exported.FirstName,
+		LastName:       exported.LastName,
+		Phone:          exported.Phone,
+		Status:         domain.UserStatusInactive,
+		OrganizationID: orgID,
+	}
+	if err := uc.userRepo.Create(ctx, user); err != nil {
+		uc.logger.Error(ctx, err, "Failed to create imported user", pkgLogger.Tags{
+			"organization_id": orgID.String(),
+		})
+		return pkgErrors.NewInternalServerError("failed to import user " + email)
+	}
+
+	for _, ref := range exported.RoleRefs {
+		roleID := uuid.MustParse(result.RoleMapping[ref])
+		if err := uc.roleRepo.AssignRoleToUser(ctx, user.ID, roleID, actorID); err != nil {
+			uc.logger.Error(ctx, err, "Failed to assign imported user role", pkgLogger.Tags{
+				"user_id": user.ID.String(),
+				"role_id": roleID.String(),
+			})
+			return pkgErrors.NewInternalServerError("failed to import roles for user " + email)
+		}
+	}
+
+	if uc.invite(ctx, user, inviter) {
+		result.UsersInvited++
+	}
+
+	return nil
+}
+
+// invite emails an imported user a link to set their password. Failures are
+// logged but do not fail the import; the user can still request a password
+// reset.
+func (uc *ImportOrganizationUseCase) invite(ctx context.Context, user *domain.User, inviter *importInviter) bool {
+	token, resetToken, err := domain.GeneratePasswordResetToken(user.ID, importInvitationTTL)
+	if err == nil {
+		err = uc.tokenRepo.StorePasswordResetToken(ctx, resetToken)
+	}
+	if err == nil {
+		err = uc.emailService.SendInvitationEmail(ctx, user.Email, token, inviter.name, inviter.organizationName)
+	}
+
+	if err != nil {
+		uc.logger.Error(ctx, err, "Failed to invite imported user", pkgLogger.Tags{
+			"user_id":         user.ID.String(),
+			"organization_id": user.OrganizationID.String(),
+		})
+		return false
+	}
+
+	return true
+}
+
+// parentsFirst orders roles so each parent from the archive is created
+// before its children, rejecting inheritance cycles.
+func parentsFirst(roles []domain.ExportedRole) ([]domain.ExportedRole, error) {
+	byRef := make(map[string]domain.ExportedRole, len(roles))
+	for _, role := range roles {
+		byRef[role.Ref] = role
+	}
+
+	ordered := make([]domain.ExportedRole, 0, len(roles))
+	state := make(map[string]int, len(roles)) // 1 = visiting, 2 = done
+
+	var visit func(role domain.ExportedRole) error
+	visit = func(role domain.ExportedRole) error {
+		switch state[role.Ref] {
+		case 1:
+			return pkgErrors.NewBadRequest(fmt.Sprintf("role inheritance cycle at %q", role.Name))
+		case 2:
+			return nil
+		}
+
+		state[role.Ref] = 1
+		if parent, ok := byRef[role.ParentRef]; ok {
+			if err := visit(parent); err != nil {
+				return err
+			}
+		}
+		state[role.Ref] = 2
+		ordered = append(ordered, role)
+		return nil
+	}
+
+	for _, role := range roles {
+		if err := visit(role); err != nil {
+			return nil, err
+		}
+	}
+
+	return ordered, nil
+}
+
+// unusablePassword hashes a random secret nobody knows, since the password
+// column is required but imported users must set their own.
+func unusablePassword() (string, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(hex.EncodeToString(secret)), bcrypt.DefaultCost)
+	if err != nil {
+		return "", err
+	}
+	return string(hash), nil
+}
@@ -0,0 +1,224 @@
+package tenant
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/domain"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/providers"
+)
+
+type importMocks struct {
+	orgRepo   *providers.MockOrganizationRepository
+	roleRepo  *providers.MockRoleRepository
+	permRepo  *providers.MockPermissionRepository
+	userRepo  *providers.MockUserRepository
+	tokenRepo *providers.MockTokenRepository
+	auditRepo *providers.MockAuditLogRepository
+	email     *providers.MockEmailService
+	logger    *providers.MockLogger
+}
+
+func newImportUseCase() (*ImportOrganizationUseCase, *importMocks) {
+	m := &importMocks{
+		orgRepo:   new(providers.MockOrganizationRepository),
+		roleRepo:  new(providers.MockRoleRepository),
+		permRepo:  new(providers.MockPermissionRepository),
+		userRepo:  new(providers.MockUserRepository),
+		tokenRepo: new(providers.MockTokenRepository),
+		auditRepo: new(providers.MockAuditLogRepository),
+		email:     new(providers.MockEmailService),
+		logger:    new(providers.MockLogger),
+	}
+	m.logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	m.logger.On("Error", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
+
+	return NewImportOrganizationUseCase(m.orgRepo, m.roleRepo, m.permRepo, m.userRepo, m.tokenRepo, m.auditRepo, m.email, m.logger), m
+}
+
+func givenArchive() domain.OrganizationExport {
+	// The child role is listed before its parent on purpose
+	return domain.OrganizationExport{
+		Version:      domain.OrganizationExportVersion,
+		Organization: domain.ExportedOrganization{Slug: "acme-sandbox"},
+		Roles: []domain.ExportedRole{
+			{Ref: "child", Name: "Junior Planner", ParentRef: "parent", Permissions: []string{"ddmrp:buffers:read"}},
+			{Ref: "parent", Name: "Planner", ParentRef: "system:viewer"},
+		},
+		Users: []domain.ExportedUser{
+			{Email: "Ana@Acme.com", FirstName: "Ana", Status: domain.UserStatusActive, RoleRefs: []string{"child"}},
+		},
+	}
+}
+
+func TestImportOrganizationUseCase_Execute_WithNewRolesAndUsers_RemapsIDsParentsFirst(t *testing.T) {
+	// Given
+	givenOrgID := uuid.New()
+	givenActorID := uuid.New()
+	givenViewerID := uuid.New()
+	givenPermissionID := uuid.New()
+
+	useCase, m := newImportUseCase()
+
+	m.orgRepo.On("GetByID", mock.Anything, givenOrgID).Return(&domain.Organization{ID: givenOrgID, Name: "Acme"}, nil)
+	m.roleRepo.On("GetByName", mock.Anything, "viewer", (*uuid.UUID)(nil)).Return(&domain.Role{ID: givenViewerID, Name: "viewer", IsSystem: true}, nil)
+	m.permRepo.On("GetByCode", mock.Anything, "ddmrp:buffers:read").Return(&domain.Permission{ID: givenPermissionID}, nil)
+	m.roleRepo.On("GetByName", mock.Anything, mock.Anything, &givenOrgID).Return(nil, errors.New("record not found"))
+	m.userRepo.On("GetByID", mock.Anything, givenActorID).Return(&domain.User{ID: givenActorID, FirstName: "Olga", LastName: "Admin"}, nil)
+
+	var created []*domain.Role
+	m.roleRepo.On("Create", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		role := args.Get(1).(*domain.Role)
+		role.ID = uuid.New()
+		created = append(created, role)
+	}).Return(nil)
+	m.permRepo.On("AssignPermissionsToRole", mock.Anything, mock.Anything, []uuid.UUID{givenPermissionID}).Return(nil)
+
+	m.userRepo.On("GetByEmailAndOrg", mock.Anything, "ana@acme.com", givenOrgID).Return(nil, errors.New("record not found"))
+	var createdUser *domain.User
+	m.userRepo.On("Create", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		createdUser = args.Get(1).(*domain.User)
+		createdUser.ID = uuid.New()
+	}).Return(nil)
+	m.roleRepo.On("AssignRoleToUser", mock.Anything, mock.Anything, mock.Anything, givenActorID).Return(nil)
+	var storedHash string
+	m.tokenRepo.On("StorePasswordResetToken", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		storedHash = args.Get(1).(*domain.PasswordResetToken).TokenHash
+	}).Return(nil)
+	m.email.On("SendInvitationEmail", mock.Anything, "ana@acme.com", mock.MatchedBy(func(token string) bool {
+		return domain.HashPasswordResetToken(token) == storedHash
+	}), "Olga Admin", "Acme").Return(nil)
+	m.auditRepo.On("Create", mock.Anything, mock.MatchedBy(func(l *domain.AuditLog) bool {
+		return l.Action == domain.AuditActionOrganizationImported
+	})).Return(nil)
+
+	// When
+	result, err := useCase.Execute(context.Background(), givenOrgID, givenActorID, &domain.ImportOrganizationRequest{Archive: givenArchive()})
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, 2, result.RolesCreated)
+	assert.Equal(t, 1, result.UsersCreated)
+	assert.Equal(t, 1, result.UsersInvited)
+
+	assert.Len(t, created, 2)
+	assert.Equal(t, "Planner", created[0].Name)
+	assert.Equal(t, givenViewerID, *created[0].ParentRoleID)
+	assert.Equal(t, created[0].ID, *created[1].ParentRoleID)
+	assert.Equal(t, givenOrgID, *created[1].OrganizationID)
+
+	assert.Equal(t, "ana@acme.com", createdUser.Email)
+	assert.Equal(t, domain.UserStatusInactive, createdUser.Status)
+	assert.NotEmpty(t, createdUser.Password)
+	m.roleRepo.AssertCalled(t, "AssignRoleToUser", mock.Anything, createdUser.ID, created[1].ID, givenActorID)
+	m.tokenRepo.AssertCalled(t, "StorePasswordResetToken", mock.Anything, mock.MatchedBy(func(token *domain.PasswordResetToken) bool {
+		return token.UserID == createdUser.ID
+	}))
+	m.email.AssertExpectations(t)
+	m.auditRepo.AssertExpectations(t)
+}
+
+func TestImportOrganizationUseCase_Execute_WithUnknownPermission_ReturnsBadRequestWithoutWrites(t *testing.T) {
+	// Given
+	givenOrgID := uuid.New()
+
+	useCase, m := newImportUseCase()
+
+	m.orgRepo.On("GetByID", mock.Anything, givenOrgID).Return(&domain.Organization{ID: givenOrgID}, nil)
+	m.roleRepo.On("GetByName", mock.Anything, "viewer", (*uuid.UUID)(nil)).Return(&domain.Role{ID: uuid.New(), IsSystem: true}, nil)
+	m.permRepo.On("GetByCode", mock.Anything, "ddmrp:buffers:read").Return(nil, errors.New("record not found"))
+
+	// When
+	result, err := useCase.Execute(context.Background(), givenOrgID, uuid.New(), &domain.ImportOrganizationRequest{Archive: givenArchive()})
+
+	// Then
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	assert.Contains(t, err.Error(), "ddmrp:buffers:read")
+	m.roleRepo.AssertNotCalled(t, "Create")
+	m.userRepo.AssertNotCalled(t, "Create")
+	m.orgRepo.AssertNotCalled(t, "Update")
+}
+
+func TestImportOrganizationUseCase_Execute_WithDryRun_ReportsPlanWithoutWrites(t *testing.T) {
+	// Given
+	givenOrgID := uuid.New()
+	givenPlannerID := uuid.New()
+
+	useCase, m := newImportUseCase()
+
+	archive := givenArchive()
+	archive.Organization.Settings = map[string]interface{}{"locale": map[string]interface{}{"currency": "ARS"}}
+
+	m.orgRepo.On("GetByID", mock.Anything, givenOrgID).Return(&domain.Organization{ID: givenOrgID}, nil)
+	m.roleRepo.On("GetByName", mock.Anything, "viewer", (*uuid.UUID)(nil)).Return(&domain.Role{ID: uuid.New(), IsSystem: true}, nil)
+	m.permRepo.On("GetByCode", mock.Anything, "ddmrp:buffers:read").Return(&domain.Permission{ID: uuid.New()}, nil)
+	m.roleRepo.On("GetByName", mock.Anything, "Planner", &givenOrgID).Return(&domain.Role{ID: givenPlannerID, Name: "Planner"}, nil)
+	m.roleRepo.On("GetByName", mock.Anything, "Junior Planner", &givenOrgID).Return(nil, errors.New("record not found"))
+	m.userRepo.On("GetByEmailAndOrg", mock.Anything, "ana@acme.com", givenOrgID).Return(&domain.User{ID: uuid.New()}, nil)
+
+	// When
+	result, err := useCase.Execute(context.Background(), givenOrgID, uuid.New(), &domain.ImportOrganizationRequest{Archive: archive, DryRun: true})
+
+	// Then
+	assert.NoError(t, err)
+	assert.True(t, result.DryRun)
+	assert.Equal(t, 1, result.SettingsApplied)
+	assert.Equal(t, 1, result.RolesReused)
+	assert.Equal(t, 1, result.RolesCreated)
+	assert.Equal(t, 1, result.UsersReused)
+	assert.Equal(t, givenPlannerID.String(), result.RoleMapping["parent"])
+	assert.Equal(t, "new", result.RoleMapping["child"])
+	m.orgRepo.AssertNotCalled(t, "Update")
+	m.roleRepo.AssertNotCalled(t, "Create")
+	m.auditRepo.AssertNotCalled(t, "Create")
+}
+
+func TestImportOrganizationUseCase_Execute_WithInheritanceCycle_ReturnsBadRequest(t *testing.T) {
+	// Given
+	givenOrgID := uuid.New()
+
+	useCase, m := newImportUseCase()
+
+	archive := domain.OrganizationExport{
+		Version: domain.OrganizationExportVersion,
+		Roles: []domain.ExportedRole{
+			{Ref: "a", Name: "A", ParentRef: "b"},
+			{Ref: "b", Name: "B", ParentRef: "a"},
+		},
+	}
+
+	m.orgRepo.On("GetByID", mock.Anything, givenOrgID).Return(&domain.Organization{ID: givenOrgID}, nil)
+
+	// When
+	result, err := useCase.Execute(context.Background(), givenOrgID, uuid.New(), &domain.ImportOrganizationRequest{Archive: archive})
+
+	// Then
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	assert.Contains(t, err.Error(), "cycle")
+	m.roleRepo.AssertNotCalled(t, "Create")
+}
+
+func TestImportOrganizationUseCase_Execute_WithUnsupportedVersion_ReturnsBadRequest(t *testing.T) {
+	// Given
+	givenOrgID := uuid.New()
+
+	useCase, m := newImportUseCase()
+	m.orgRepo.On("GetByID", mock.Anything, givenOrgID).Return(&domain.Organization{ID: givenOrgID}, nil)
+
+	// When
+	result, err := useCase.Execute(context.Background(), givenOrgID, uuid.New(), &domain.ImportOrganizationRequest{
+		Archive: domain.OrganizationExport{Version: 99},
+	})
+
+	// Then
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	assert.Contains(t, err.Error(), "unsupported archive version")
+}
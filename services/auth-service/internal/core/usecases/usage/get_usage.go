@@ -0,0 +1,73 @@
+package usage
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	pkgErrors "github.com/giia/giia-core-engine/pkg/errors"
+	pkgLogger "github.com/giia/giia-core-engine/pkg/logger"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/domain"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/providers"
+)
+
+type GetUsageUseCase struct {
+	userRepo  providers.UserRepository
+	usageRepo providers.UsageRepository
+	logger    pkgLogger.Logger
+}
+
+func NewGetUsageUseCase(
+	userRepo providers.UserRepository,
+	usageRepo providers.UsageRepository,
+	logger pkgLogger.Logger,
+) *GetUsageUseCase {
+	return &GetUsageUseCase{
+		userRepo:  userRepo,
+		usageRepo: usageRepo,
+		logger:    logger,
+	}
+}
+
+// Execute builds the usage report of orgID for period ("2026-10"). An empty
+// period means the current month.
+func (uc *GetUsageUseCase) Execute(ctx context.Context, orgID uuid.UUID, period string) (*domain.UsageReport, error) {
+	if orgID == uuid.Nil {
+		return nil, pkgErrors.NewBadRequest("organization ID cannot be empty")
+	}
+
+	now := time.Now().UTC()
+	if period == "" {
+		period = domain.UsagePeriod(now)
+	}
+
+	if _, err := time.Parse(domain.UsagePeriodLayout, period); err != nil {
+		return nil, pkgErrors.NewBadRequest("period must use the YYYY-MM format")
+	}
+
+	activeUsers, err := uc.userRepo.CountActiveByOrganization(ctx, orgID)
+	if err != nil {
+		uc.logger.Error(ctx, err, "Failed to count active users", pkgLogger.Tags{
+			"organization_id": orgID.String(),
+		})
+		return nil, pkgErrors.NewInternalServerError("failed to get usage")
+	}
+
+	apiCalls, err := uc.usageRepo.GetAPICalls(ctx, orgID, period)
+	if err != nil {
+		uc.logger.Error(ctx, err, "Failed to get API call count", pkgLogger.Tags{
+			"organization_id": orgID.String(),
+			"period":          period,
+		})
+		return nil, pkgErrors.NewInternalServerError("failed to get usage")
+	}
+
+	return &domain.UsageReport{
+		OrganizationID: orgID,
+		Period:         period,
+		ActiveUsers:    activeUsers,
+		APICalls:       apiCalls,
+		GeneratedAt:    now,
+	}, nil
+}
@@ -0,0 +1,56 @@
+package usage
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/domain"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/providers"
+)
+
+func TestGetUsageUseCase_Execute_WithoutPeriod_ReportsCurrentMonth(t *testing.T) {
+	// Given
+	givenOrgID := uuid.New()
+	givenPeriod := domain.UsagePeriod(time.Now())
+
+	mockUserRepo := new(providers.MockUserRepository)
+	mockUsageRepo := new(providers.MockUsageRepository)
+	mockLogger := new(providers.MockLogger)
+
+	useCase := NewGetUsageUseCase(mockUserRepo, mockUsageRepo, mockLogger)
+
+	mockUserRepo.On("CountActiveByOrganization", mock.Anything, givenOrgID).Return(int64(12), nil)
+	mockUsageRepo.On("GetAPICalls", mock.Anything, givenOrgID, givenPeriod).Return(int64(3400), nil)
+
+	// When
+	report, err := useCase.Execute(context.Background(), givenOrgID, "")
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, givenPeriod, report.Period)
+	assert.Equal(t, int64(12), report.ActiveUsers)
+	assert.Equal(t, int64(3400), report.APICalls)
+}
+
+func TestGetUsageUseCase_Execute_WithMalformedPeriod_ReturnsBadRequest(t *testing.T) {
+	// Given
+	mockUserRepo := new(providers.MockUserRepository)
+	mockUsageRepo := new(providers.MockUsageRepository)
+	mockLogger := new(providers.MockLogger)
+
+	useCase := NewGetUsageUseCase(mockUserRepo, mockUsageRepo, mockLogger)
+
+	// When
+	report, err := useCase.Execute(context.Background(), uuid.New(), "10/2026")
+
+	// Then
+	assert.Error(t, err)
+	assert.Nil(t, report)
+	assert.Contains(t, err.Error(), "YYYY-MM")
+	mockUserRepo.AssertNotCalled(t, "CountActiveByOrganization")
+}
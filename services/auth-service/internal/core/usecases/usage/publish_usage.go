@@ -0,0 +1,62 @@
+package usage
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	pkgErrors "github.com/giia/giia-core-engine/pkg/errors"
+	pkgLogger "github.com/giia/giia-core-engine/pkg/logger"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/domain"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/providers"
+)
+
+type PublishUsageUseCase struct {
+	getUsage       *GetUsageUseCase
+	eventPublisher providers.UsageEventPublisher
+	logger         pkgLogger.Logger
+	now            func() time.Time
+}
+
+func NewPublishUsageUseCase(
+	getUsage *GetUsageUseCase,
+	eventPublisher providers.UsageEventPublisher,
+	logger pkgLogger.Logger,
+) *PublishUsageUseCase {
+	return &PublishUsageUseCase{
+		getUsage:       getUsage,
+		eventPublisher: eventPublisher,
+		logger:         logger,
+		now:            time.Now,
+	}
+}
+
+// Execute publishes the report of the previous month. It is meant to run
+// early each month as a scheduled job per organization.
+func (uc *PublishUsageUseCase) Execute(ctx context.Context, orgID uuid.UUID) error {
+	now := uc.now().UTC()
+	period := domain.UsagePeriod(time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC).AddDate(0, -1, 0))
+
+	report, err := uc.getUsage.Execute(ctx, orgID, period)
+	if err != nil {
+		return err
+	}
+
+	if err := uc.eventPublisher.PublishUsageMetered(ctx, report); err != nil {
+		uc.logger.Error(ctx, err, "Failed to publish usage report", pkgLogger.Tags{
+			"organization_id": orgID.String(),
+			"period":          period,
+		})
+		return pkgErrors.NewInternalServerError("failed to publish usage")
+	}
+
+	uc.logger.Info(ctx, "Usage report published", pkgLogger.Tags{
+		"organization_id": orgID.String(),
+		"period":          period,
+		"active_users":    report.ActiveUsers,
+		"api_calls":       report.APICalls,
+	})
+
+	return nil
+}
@@ -0,0 +1,41 @@
+package usage
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/domain"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/providers"
+)
+
+func TestPublishUsageUseCase_Execute_InJanuary_PublishesPreviousDecember(t *testing.T) {
+	// Given
+	givenOrgID := uuid.New()
+
+	mockUserRepo := new(providers.MockUserRepository)
+	mockUsageRepo := new(providers.MockUsageRepository)
+	mockPublisher := new(providers.MockUsageEventPublisher)
+	mockLogger := new(providers.MockLogger)
+
+	useCase := NewPublishUsageUseCase(NewGetUsageUseCase(mockUserRepo, mockUsageRepo, mockLogger), mockPublisher, mockLogger)
+	useCase.now = func() time.Time { return time.Date(2027, 1, 1, 3, 0, 0, 0, time.UTC) }
+
+	mockUserRepo.On("CountActiveByOrganization", mock.Anything, givenOrgID).Return(int64(8), nil)
+	mockUsageRepo.On("GetAPICalls", mock.Anything, givenOrgID, "2026-12").Return(int64(150), nil)
+	mockPublisher.On("PublishUsageMetered", mock.Anything, mock.MatchedBy(func(r *domain.UsageReport) bool {
+		return r.Period == "2026-12" && r.ActiveUsers == 8 && r.APICalls == 150
+	})).Return(nil)
+	mockLogger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	// When
+	err := useCase.Execute(context.Background(), givenOrgID)
+
+	// Then
+	assert.NoError(t, err)
+	mockPublisher.AssertExpectations(t)
+}
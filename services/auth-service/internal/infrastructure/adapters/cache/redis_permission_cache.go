@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/redis/go-redis/v9"
@@ -65,7 +66,17 @@ func (c *redisPermissionCache) SetUserPermissions(ctx context.Context, userID st
 		return err
 	}
 
-	if err := c.client.Set(ctx, key, data, ttl).Err(); err != nil {
+	// Organization-scoped entries are indexed under the user so invalidation
+	// deletes them by lookup instead of scanning the keyspace.
+	pipe := c.client.TxPipeline()
+	pipe.Set(ctx, key, data, ttl)
+	if baseUserID, _, scoped := strings.Cut(userID, "@"); scoped {
+		indexKey := userKeysIndex(baseUserID)
+		pipe.SAdd(ctx, indexKey, key)
+		pipe.Expire(ctx, indexKey, ttl)
+	}
+
+	if _, err := pipe.Exec(ctx); err != nil {
 		c.logger.Error(ctx, err, "Failed to set user permissions in cache", pkgLogger.Tags{
 			"user_id": userID,
 			"ttl":     ttl.String(),
@@ -83,9 +94,15 @@ func (c *redisPermissionCache) SetUserPermissions(ctx context.Context, userID st
 }
 
 func (c *redisPermissionCache) InvalidateUserPermissions(ctx context.Context, userID string) error {
-	key := fmt.Sprintf("user:%s:permissions", userID)
+	keys, err := c.userKeys(ctx, userID)
+	if err != nil {
+		c.logger.Error(ctx, err, "Failed to list user permissions cache keys", pkgLogger.Tags{
+			"user_id": userID,
+		})
+		return err
+	}
 
-	if err := c.client.Del(ctx, key).Err(); err != nil {
+	if err := c.client.Del(ctx, keys...).Err(); err != nil {
 		c.logger.Error(ctx, err, "Failed to invalidate user permissions cache", pkgLogger.Tags{
 			"user_id": userID,
 		})
@@ -104,9 +121,16 @@ func (c *redisPermissionCache) InvalidateUsersWithRole(ctx context.Context, user
 		return nil
 	}
 
-	keys := make([]string, len(userIDs))
-	for i, userID := range userIDs {
-		keys[i] = fmt.Sprintf("user:%s:permissions", userID)
+	keys := make([]string, 0, len(userIDs))
+	for _, userID := range userIDs {
+		userKeys, err := c.userKeys(ctx, userID)
+		if err != nil {
+			c.logger.Error(ctx, err, "Failed to list user permissions cache keys", pkgLogger.Tags{
+				"user_id": userID,
+			})
+			return err
+		}
+		keys = append(keys, userKeys...)
 	}
 
 	if err := c.client.Del(ctx, keys...).Err(); err != nil {
@@ -122,3 +146,22 @@ func (c *redisPermissionCache) InvalidateUsersWithRole(ctx context.Context, user
 
 	return nil
 }
+
+// userKeysIndex is the set of organization-scoped keys cached for a user.
+func userKeysIndex(userID string) string {
+	return fmt.Sprintf("user:%s:permission_keys", userID)
+}
+
+// userKeys returns the home-organization key for userID, the index of its
+// organization-scoped keys and the keys in that index.
+func (c *redisPermissionCache) userKeys(ctx context.Context, userID string) ([]string, error) {
+	indexKey := userKeysIndex(userID)
+	keys := []string{fmt.Sprintf("user:%s:permissions", userID), indexKey}
+
+	scopedKeys, err := c.client.SMembers(ctx, indexKey).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	return append(keys, scopedKeys...), nil
+}
@@ -0,0 +1,70 @@
+package captcha
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSiteVerifier_Verify_WithValidToken_ReturnsTrue(t *testing.T) {
+	// Given
+	var gotSecret, gotResponse, gotRemoteIP string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = r.ParseForm()
+		gotSecret = r.PostForm.Get("secret")
+		gotResponse = r.PostForm.Get("response")
+		gotRemoteIP = r.PostForm.Get("remoteip")
+		_, _ = w.Write([]byte(`{"success": true}`))
+	}))
+	defer server.Close()
+
+	verifier := NewSiteVerifier(server.URL, "site-secret", time.Second)
+
+	// When
+	ok, err := verifier.Verify(context.Background(), "client-token", "203.0.113.7")
+
+	// Then
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, "site-secret", gotSecret)
+	assert.Equal(t, "client-token", gotResponse)
+	assert.Equal(t, "203.0.113.7", gotRemoteIP)
+}
+
+func TestSiteVerifier_Verify_WithRejectedToken_ReturnsFalse(t *testing.T) {
+	// Given
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"success": false, "error-codes": ["invalid-input-response"]}`))
+	}))
+	defer server.Close()
+
+	verifier := NewSiteVerifier(server.URL, "site-secret", time.Second)
+
+	// When
+	ok, err := verifier.Verify(context.Background(), "bad-token", "")
+
+	// Then
+	assert.NoError(t, err)
+	assert.False(t, ok)
+}
+
+func TestSiteVerifier_Verify_WithProviderError_ReturnsError(t *testing.T) {
+	// Given
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	verifier := NewSiteVerifier(server.URL, "site-secret", time.Second)
+
+	// When
+	ok, err := verifier.Verify(context.Background(), "client-token", "")
+
+	// Then
+	assert.Error(t, err)
+	assert.False(t, ok)
+}
@@ -0,0 +1,120 @@
+package email
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	pkgLogger "github.com/giia/giia-core-engine/pkg/logger"
+	"github.com/giia/giia-core-engine/pkg/mailer"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/providers"
+)
+
+type emailService struct {
+	mailer     mailer.Mailer
+	templates  *mailer.TemplateRegistry
+	appBaseURL string
+	logger     pkgLogger.Logger
+}
+
+func NewEmailService(m mailer.Mailer, appBaseURL string, logger pkgLogger.Logger) (providers.EmailService, error) {
+	templates, err := newTemplateRegistry()
+	if err != nil {
+		return nil, fmt.Errorf("failed to register email templates: %w", err)
+	}
+
+	return &emailService{
+		mailer:     m,
+		templates:  templates,
+		appBaseURL: strings.TrimRight(appBaseURL, "/"),
+		logger:     logger,
+	}, nil
+}
+
+func (s *emailService) SendActivationEmail(ctx context.Context, to, token, userName string) error {
+	data := map[string]string{
+		"UserName":      userName,
+		"ActivationURL": s.link("/activate", token),
+	}
+
+	return s.send(ctx, templateActivation, to, data)
+}
+
+func (s *emailService) SendPasswordResetEmail(ctx context.Context, to, token, userName string) error {
+	data := map[string]string{
+		"UserName": userName,
+		"ResetURL": s.link("/reset-password", token),
+	}
+
+	return s.send(ctx, templatePasswordReset, to, data)
+}
+
+func (s *emailService) SendWelcomeEmail(ctx context.Context, to, userName string) error {
+	data := map[string]string{
+		"UserName": userName,
+	}
+
+	return s.send(ctx, templateWelcome, to, data)
+}
+
+func (s *emailService) SendInvitationEmail(ctx context.Context, to, token, inviterName, organizationName string) error {
+	data := map[string]string{
+		"InviterName":      inviterName,
+		"OrganizationName": organizationName,
+		"InvitationURL":    s.link("/accept-invitation", token),
+	}
+
+	return s.send(ctx, templateInvitation, to, data)
+}
+
+func (s *emailService) SendMagicLinkEmail(ctx context.Context, to, token, userName string) error {
+	data := map[string]string{
+		"UserName":     userName,
+		"MagicLinkURL": s.link("/magic-link", token),
+	}
+
+	return s.send(ctx, templateMagicLink, to, data)
+}
+
+func (s *emailService) SendEmailChangeConfirmationEmail(ctx context.Context, to, token, userName, newEmail string) error {
+	data := map[string]string{
+		"UserName":   userName,
+		"NewEmail":   newEmail,
+		"ConfirmURL": s.link("/confirm-email-change", token),
+	}
+
+	return s.send(ctx, templateEmailChange, to, data)
+}
+
+func (s *emailService) link(path, token string) string {
+	return fmt.Sprintf("%s%s?token=%s", s.appBaseURL, path, url.QueryEscape(token))
+}
+
+func (s *emailService) send(ctx context.Context, templateName, to string, data interface{}) error {
+	msg, err := s.templates.NewTemplatedMessage(templateName, []string{to}, data)
+	if err != nil {
+		s.logger.Error(ctx, err, "Failed to render email template", pkgLogger.Tags{
+			"template": templateName,
+		})
+		return err
+	}
+
+	result, err := s.mailer.Send(ctx, msg)
+	if err != nil {
+		s.logger.Error(ctx, err, "Failed to send email", pkgLogger.Tags{
+			"to":       to,
+			"template": templateName,
+		})
+		return err
+	}
+
+	s.logger.Info(ctx, "Email sent successfully", pkgLogger.Tags{
+		"to":         to,
+		"template":   templateName,
+		"provider":   result.Provider,
+		"message_id": result.ProviderMessageID,
+	})
+
+	return nil
+}
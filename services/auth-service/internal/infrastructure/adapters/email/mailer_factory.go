@@ -0,0 +1,61 @@
+package email
+
+import (
+	"context"
+	"fmt"
+
+	pkgLogger "github.com/giia/giia-core-engine/pkg/logger"
+	"github.com/giia/giia-core-engine/pkg/mailer"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/infrastructure/config"
+)
+
+// NewMailer builds the delivery adapter selected by EMAIL_PROVIDER. When store
+// is non-nil every send is recorded in the email send log; failures to record
+// are logged without failing the send.
+func NewMailer(cfg *config.EmailConfig, store mailer.SendLogStore, logger pkgLogger.Logger) (mailer.Mailer, error) {
+	var m mailer.Mailer
+
+	switch cfg.Provider {
+	case "", mailer.ProviderSMTP:
+		m = mailer.NewSMTPMailer(&mailer.SMTPConfig{
+			Host:     cfg.SMTPHost,
+			Port:     cfg.SMTPPort,
+			Username: cfg.SMTPUser,
+			Password: cfg.SMTPPassword,
+			From:     cfg.FromEmail,
+			FromName: cfg.FromName,
+		})
+	case mailer.ProviderSendGrid:
+		if cfg.SendGridAPIKey == "" {
+			return nil, fmt.Errorf("SENDGRID_API_KEY is required for the sendgrid email provider")
+		}
+		m = mailer.NewSendGridMailer(&mailer.SendGridConfig{
+			APIKey:   cfg.SendGridAPIKey,
+			From:     cfg.FromEmail,
+			FromName: cfg.FromName,
+		})
+	case mailer.ProviderSES:
+		if cfg.SESAccessKeyID == "" || cfg.SESSecretAccessKey == "" {
+			return nil, fmt.Errorf("SES_ACCESS_KEY_ID and SES_SECRET_ACCESS_KEY are required for the ses email provider")
+		}
+		m = mailer.NewSESMailer(&mailer.SESConfig{
+			Region:          cfg.SESRegion,
+			AccessKeyID:     cfg.SESAccessKeyID,
+			SecretAccessKey: cfg.SESSecretAccessKey,
+			From:            cfg.FromEmail,
+			FromName:        cfg.FromName,
+		})
+	default:
+		return nil, fmt.Errorf("unsupported email provider %q", cfg.Provider)
+	}
+
+	if store != nil {
+		logged := mailer.NewLoggingMailer(m, store)
+		logged.OnLogError(func(ctx context.Context, err error) {
+			logger.Error(ctx, err, "Failed to record email send log", pkgLogger.Tags{})
+		})
+		m = logged
+	}
+
+	return m, nil
+}
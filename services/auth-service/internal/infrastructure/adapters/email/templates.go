@@ -0,0 +1,239 @@
+package email
+
+import "github.com/giia/giia-core-engine/pkg/mailer"
+
+const (
+	templateActivation    = "account_activation"
+	templatePasswordReset = "password_reset"
+	templateWelcome       = "welcome"
+	templateInvitation    = "organization_invitation"
+	templateMagicLink     = "magic_link"
+	templateEmailChange   = "email_change_confirmation"
+)
+
+const emailStyles = `
+        body { font-family: Arial, sans-serif; line-height: 1.6; color: #333; }
+        .container { max-width: 600px; margin: 0 auto; padding: 20px; }
+        .button {
+            display: inline-block;
+            padding: 12px 24px;
+            color: #ffffff;
+            text-decoration: none;
+            border-radius: 4px;
+            margin: 20px 0;
+        }
+        .footer { margin-top: 30px; padding-top: 20px; border-top: 1px solid #eee; font-size: 12px; color: #666; }
+`
+
+var authTemplates = []mailer.Template{
+	{
+		Name:    templateActivation,
+		Subject: "Activate Your Account",
+		HTML: `
+<!DOCTYPE html>
+<html>
+<head>
+    <style>` + emailStyles + `</style>
+</head>
+<body>
+    <div class="container">
+        <h2>Welcome to GIIA, {{.UserName}}!</h2>
+        <p>Thank you for registering. Please activate your account by clicking the button below:</p>
+        <a href="{{.ActivationURL}}" class="button" style="background-color: #007bff;">Activate Account</a>
+        <p>Or copy and paste this link into your browser:</p>
+        <p style="word-break: break-all;">{{.ActivationURL}}</p>
+        <p>This link will expire in 24 hours.</p>
+        <div class="footer">
+            <p>If you didn't create an account, please ignore this email.</p>
+        </div>
+    </div>
+</body>
+</html>
+`,
+		Text: `Welcome to GIIA, {{.UserName}}!
+
+Thank you for registering. Activate your account by opening the link below:
+
+{{.ActivationURL}}
+
+This link will expire in 24 hours. If you didn't create an account, please ignore this email.
+`,
+	},
+	{
+		Name:    templatePasswordReset,
+		Subject: "Reset Your Password",
+		HTML: `
+<!DOCTYPE html>
+<html>
+<head>
+    <style>` + emailStyles + `</style>
+</head>
+<body>
+    <div class="container">
+        <h2>Password Reset Request</h2>
+        <p>Hi {{.UserName}},</p>
+        <p>We received a request to reset your password. Click the button below to reset it:</p>
+        <a href="{{.ResetURL}}" class="button" style="background-color: #dc3545;">Reset Password</a>
+        <p>Or copy and paste this link into your browser:</p>
+        <p style="word-break: break-all;">{{.ResetURL}}</p>
+        <p>This link will expire in 1 hour.</p>
+        <div class="footer">
+            <p>If you didn't request a password reset, please ignore this email or contact support if you have concerns.</p>
+        </div>
+    </div>
+</body>
+</html>
+`,
+		Text: `Hi {{.UserName}},
+
+We received a request to reset your password. Reset it by opening the link below:
+
+{{.ResetURL}}
+
+This link will expire in 1 hour. If you didn't request a password reset, please ignore this email.
+`,
+	},
+	{
+		Name:    templateWelcome,
+		Subject: "Welcome to GIIA!",
+		HTML: `
+<!DOCTYPE html>
+<html>
+<head>
+    <style>` + emailStyles + `</style>
+</head>
+<body>
+    <div class="container">
+        <h2>Welcome to GIIA!</h2>
+        <p>Hi {{.UserName}},</p>
+        <p>Your account has been successfully activated. You can now log in and start using GIIA.</p>
+        <p>If you have any questions, feel free to reach out to our support team.</p>
+        <div class="footer">
+            <p>Thank you for choosing GIIA!</p>
+        </div>
+    </div>
+</body>
+</html>
+`,
+		Text: `Hi {{.UserName}},
+
+Your account has been successfully activated. You can now log in and start using GIIA.
+
+Thank you for choosing GIIA!
+`,
+	},
+	{
+		Name:    templateInvitation,
+		Subject: "{{.InviterName}} invited you to {{.OrganizationName}} on GIIA",
+		HTML: `
+<!DOCTYPE html>
+<html>
+<head>
+    <style>` + emailStyles + `</style>
+</head>
+<body>
+    <div class="container">
+        <h2>You've been invited to {{.OrganizationName}}</h2>
+        <p>{{.InviterName}} invited you to join {{.OrganizationName}} on GIIA. Click the button below to accept:</p>
+        <a href="{{.InvitationURL}}" class="button" style="background-color: #007bff;">Accept Invitation</a>
+        <p>Or copy and paste this link into your browser:</p>
+        <p style="word-break: break-all;">{{.InvitationURL}}</p>
+        <p>This invitation will expire in 7 days.</p>
+        <div class="footer">
+            <p>If you weren't expecting this invitation, please ignore this email.</p>
+        </div>
+    </div>
+</body>
+</html>
+`,
+		Text: `{{.InviterName}} invited you to join {{.OrganizationName}} on GIIA.
+
+Accept the invitation by opening the link below:
+
+{{.InvitationURL}}
+
+This invitation will expire in 7 days.
+`,
+	},
+	{
+		Name:    templateMagicLink,
+		Subject: "Your GIIA Sign-in Link",
+		HTML: `
+<!DOCTYPE html>
+<html>
+<head>
+    <style>` + emailStyles + `</style>
+</head>
+<body>
+    <div class="container">
+        <h2>Sign in to GIIA</h2>
+        <p>Hi {{.UserName}},</p>
+        <p>Click the button below to sign in. No password is needed:</p>
+        <a href="{{.MagicLinkURL}}" class="button" style="background-color: #007bff;">Sign In</a>
+        <p>Or copy and paste this link into your browser:</p>
+        <p style="word-break: break-all;">{{.MagicLinkURL}}</p>
+        <p>This link will expire in 15 minutes and can only be used once.</p>
+        <div class="footer">
+            <p>If you didn't request a sign-in link, please ignore this email.</p>
+        </div>
+    </div>
+</body>
+</html>
+`,
+		Text: `Hi {{.UserName}},
+
+Sign in to GIIA by opening the link below. No password is needed:
+
+{{.MagicLinkURL}}
+
+This link will expire in 15 minutes and can only be used once. If you didn't request a sign-in link, please ignore this email.
+`,
+	},
+	{
+		Name:    templateEmailChange,
+		Subject: "Confirm Your GIIA Email Change",
+		HTML: `
+<!DOCTYPE html>
+<html>
+<head>
+    <style>` + emailStyles + `</style>
+</head>
+<body>
+    <div class="container">
+        <h2>Confirm Email Change</h2>
+        <p>Hi {{.UserName}},</p>
+        <p>A request was made to change the sign-in email of your GIIA account to <strong>{{.NewEmail}}</strong>.</p>
+        <p>The change is applied only after both the current and the new address confirm it:</p>
+        <a href="{{.ConfirmURL}}" class="button" style="background-color: #007bff;">Confirm Change</a>
+        <p>Or copy and paste this link into your browser:</p>
+        <p style="word-break: break-all;">{{.ConfirmURL}}</p>
+        <p>This link will expire in 24 hours.</p>
+        <div class="footer">
+            <p>If you didn't request this change, do not confirm it and change your password.</p>
+        </div>
+    </div>
+</body>
+</html>
+`,
+		Text: `Hi {{.UserName}},
+
+A request was made to change the sign-in email of your GIIA account to {{.NewEmail}}.
+
+The change is applied only after both the current and the new address confirm it. Confirm by opening the link below:
+
+{{.ConfirmURL}}
+
+This link will expire in 24 hours. If you didn't request this change, do not confirm it and change your password.
+`,
+	},
+}
+
+func newTemplateRegistry() (*mailer.TemplateRegistry, error) {
+	registry := mailer.NewTemplateRegistry()
+	for _, tmpl := range authTemplates {
+		if err := registry.Register(tmpl); err != nil {
+			return nil, err
+		}
+	}
+	return registry, nil
+}
@@ -0,0 +1,48 @@
+package events
+
+import (
+	"context"
+	"time"
+
+	pkgEvents "github.com/giia/giia-core-engine/pkg/events"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/domain"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/providers"
+)
+
+const (
+	eventSource         = "auth-service"
+	securitySubjectRoot = "auth.security."
+)
+
+type securityEventPublisher struct {
+	publisher pkgEvents.Publisher
+}
+
+func NewSecurityEventPublisher(publisher pkgEvents.Publisher) providers.SecurityEventPublisher {
+	return &securityEventPublisher{
+		publisher: publisher,
+	}
+}
+
+// PublishSecurityEvent publishes to auth.security.<type>; consumers turn these
+// into security-type notifications using the included priority.
+func (p *securityEventPublisher) PublishSecurityEvent(ctx context.Context, event *domain.SecurityEvent) error {
+	subject := securitySubjectRoot + string(event.Type)
+
+	occurredAt := event.OccurredAt
+	if occurredAt.IsZero() {
+		occurredAt = time.Now().UTC()
+	}
+
+	evt := pkgEvents.NewEvent(subject, eventSource, event.OrganizationID.String(), map[string]interface{}{
+		"user_id":     event.UserID.String(),
+		"email":       event.Email,
+		"event_type":  string(event.Type),
+		"priority":    string(event.Priority()),
+		"ip_address":  event.IPAddress,
+		"user_agent":  event.UserAgent,
+		"occurred_at": occurredAt.Format(time.RFC3339),
+	})
+
+	return p.publisher.Publish(ctx, subject, evt)
+}
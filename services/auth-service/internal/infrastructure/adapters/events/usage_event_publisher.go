@@ -0,0 +1,35 @@
+package events
+
+import (
+	"context"
+	"time"
+
+	pkgEvents "github.com/giia/giia-core-engine/pkg/events"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/domain"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/providers"
+)
+
+const subjectUsageMetered = "auth.usage.metered"
+
+type usageEventPublisher struct {
+	publisher pkgEvents.Publisher
+}
+
+func NewUsageEventPublisher(publisher pkgEvents.Publisher) providers.UsageEventPublisher {
+	return &usageEventPublisher{
+		publisher: publisher,
+	}
+}
+
+// PublishUsageMetered hands a closed billing period to the billing system,
+// which invoices the tier from these counts.
+func (p *usageEventPublisher) PublishUsageMetered(ctx context.Context, report *domain.UsageReport) error {
+	evt := pkgEvents.NewEvent(subjectUsageMetered, eventSource, report.OrganizationID.String(), map[string]interface{}{
+		"period":       report.Period,
+		"active_users": report.ActiveUsers,
+		"api_calls":    report.APICalls,
+		"generated_at": report.GeneratedAt.Format(time.RFC3339),
+	})
+
+	return p.publisher.Publish(ctx, subjectUsageMetered, evt)
+}
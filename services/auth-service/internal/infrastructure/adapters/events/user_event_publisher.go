@@ -0,0 +1,42 @@
+package events
+
+import (
+	"context"
+	"time"
+
+	pkgEvents "github.com/giia/giia-core-engine/pkg/events"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/domain"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/providers"
+)
+
+const subjectEmailChanged = "auth.user.email_changed"
+
+type userEventPublisher struct {
+	publisher pkgEvents.Publisher
+}
+
+func NewUserEventPublisher(publisher pkgEvents.Publisher) providers.UserEventPublisher {
+	return &userEventPublisher{
+		publisher: publisher,
+	}
+}
+
+// PublishEmailChanged lets notification routing switch the user's delivery
+// address. The old address stays valid as a recovery contact until
+// recovery_email_expires_at.
+func (p *userEventPublisher) PublishEmailChanged(ctx context.Context, event *domain.EmailChangedEvent) error {
+	occurredAt := event.OccurredAt
+	if occurredAt.IsZero() {
+		occurredAt = time.Now().UTC()
+	}
+
+	evt := pkgEvents.NewEvent(subjectEmailChanged, eventSource, event.OrganizationID.String(), map[string]interface{}{
+		"user_id":                   event.UserID.String(),
+		"old_email":                 event.OldEmail,
+		"new_email":                 event.NewEmail,
+		"recovery_email_expires_at": event.RecoveryEmailExpiresAt.Format(time.RFC3339),
+		"occurred_at":               occurredAt.Format(time.RFC3339),
+	})
+
+	return p.publisher.Publish(ctx, subjectEmailChanged, evt)
+}
@@ -0,0 +1,79 @@
+package geoip
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/domain"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/providers"
+)
+
+type httpResolver struct {
+	urlTemplate string
+	client      *http.Client
+}
+
+// NewHTTPResolver resolves addresses against a JSON lookup service such as
+// ipapi.co. urlTemplate must contain a single %s for the IP address, e.g.
+// "https://ipapi.co/%s/json/". An empty template disables geolocation.
+func NewHTTPResolver(urlTemplate string, timeout time.Duration) providers.GeoIPResolver {
+	return &httpResolver{
+		urlTemplate: urlTemplate,
+		client:      &http.Client{Timeout: timeout},
+	}
+}
+
+type lookupResponse struct {
+	CountryCode string  `json:"country_code"`
+	City        string  `json:"city"`
+	Latitude    float64 `json:"latitude"`
+	Longitude   float64 `json:"longitude"`
+}
+
+func (r *httpResolver) Resolve(ctx context.Context, ipAddress string) (*domain.GeoLocation, error) {
+	if r.urlTemplate == "" {
+		return nil, nil
+	}
+
+	ip := net.ParseIP(ipAddress)
+	if ip == nil || ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() {
+		return nil, nil
+	}
+
+	url := fmt.Sprintf(r.urlTemplate, ip.String())
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("geoip lookup returned status %d", resp.StatusCode)
+	}
+
+	var body lookupResponse
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return nil, err
+	}
+
+	if body.Latitude == 0 && body.Longitude == 0 {
+		return nil, nil
+	}
+
+	return &domain.GeoLocation{
+		Country:   strings.ToUpper(body.CountryCode),
+		City:      body.City,
+		Latitude:  body.Latitude,
+		Longitude: body.Longitude,
+	}, nil
+}
@@ -26,13 +26,14 @@ func NewJWTManager(secretKey string, accessExpiry, refreshExpiry time.Duration,
 	}
 }
 
-func (j *JWTManager) GenerateAccessToken(userID, orgID uuid.UUID, email string, roles []string) (string, error) {
+func (j *JWTManager) GenerateAccessToken(userID, orgID uuid.UUID, email string, roles, permissions []string) (string, error) {
 	now := time.Now()
 	claims := &providers.Claims{
 		UserID:         userID.String(),
 		Email:          email,
 		OrganizationID: orgID.String(),
 		Roles:          roles,
+		Permissions:    permissions,
 		RegisteredClaims: jwt.RegisteredClaims{
 			Issuer:    j.issuer,
 			Subject:   userID.String(),
@@ -51,6 +52,36 @@ func (j *JWTManager) GenerateAccessToken(userID, orgID uuid.UUID, email string,
 	return signedToken, nil
 }
 
+// GenerateImpersonationToken issues an access token for the target user that
+// carries the impersonator and is not refreshable.
+func (j *JWTManager) GenerateImpersonationToken(userID, orgID uuid.UUID, email string, roles []string, impersonation *providers.ImpersonationClaims) (string, error) {
+	now := time.Now()
+	claims := &providers.Claims{
+		UserID:          userID.String(),
+		Email:           email,
+		OrganizationID:  orgID.String(),
+		Roles:           roles,
+		Impersonator:    impersonation.ImpersonatorID.String(),
+		ImpersonationID: impersonation.ImpersonationID.String(),
+		ReadOnly:        impersonation.ReadOnly,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    j.issuer,
+			Subject:   userID.String(),
+			ExpiresAt: jwt.NewNumericDate(now.Add(impersonation.Expiry)),
+			IssuedAt:  jwt.NewNumericDate(now),
+			NotBefore: jwt.NewNumericDate(now),
+			ID:        uuid.New().String(),
+		},
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	signedToken, err := token.SignedString([]byte(j.secretKey))
+	if err != nil {
+		return "", pkgErrors.NewInternalServerError("failed to sign impersonation token")
+	}
+	return signedToken, nil
+}
+
 func (j *JWTManager) GenerateRefreshToken(userID uuid.UUID) (string, error) {
 	now := time.Now()
 	claims := &jwt.RegisteredClaims{
@@ -36,7 +36,7 @@ func TestJWTManager_GenerateAccessToken_WithValidInput_ReturnsToken(t *testing.T
 	manager := NewJWTManager("test-secret", 15*time.Minute, 24*time.Hour, "auth-service")
 
 	// When
-	token, err := manager.GenerateAccessToken(givenUserID, givenOrgID, givenEmail, givenRoles)
+	token, err := manager.GenerateAccessToken(givenUserID, givenOrgID, givenEmail, givenRoles, nil)
 
 	// Then
 	assert.NoError(t, err)
@@ -49,12 +49,13 @@ func TestJWTManager_GenerateAccessToken_TokenContainsCorrectClaims(t *testing.T)
 	givenOrgID := uuid.New()
 	givenEmail := "user@example.com"
 	givenRoles := []string{"admin", "editor"}
+	givenPermissions := []string{"execution:po:approve"}
 	givenIssuer := "auth-service"
 
 	manager := NewJWTManager("test-secret", 15*time.Minute, 24*time.Hour, givenIssuer)
 
 	// When
-	tokenString, err := manager.GenerateAccessToken(givenUserID, givenOrgID, givenEmail, givenRoles)
+	tokenString, err := manager.GenerateAccessToken(givenUserID, givenOrgID, givenEmail, givenRoles, givenPermissions)
 	assert.NoError(t, err)
 
 	// Parse token to verify claims
@@ -70,6 +71,7 @@ func TestJWTManager_GenerateAccessToken_TokenContainsCorrectClaims(t *testing.T)
 	assert.Equal(t, givenOrgID.String(), claims.OrganizationID)
 	assert.Equal(t, givenEmail, claims.Email)
 	assert.Equal(t, givenRoles, claims.Roles)
+	assert.Equal(t, givenPermissions, claims.Permissions)
 	assert.Equal(t, givenIssuer, claims.Issuer)
 	assert.Equal(t, givenUserID.String(), claims.Subject)
 	assert.NotEmpty(t, claims.ID)
@@ -83,7 +85,7 @@ func TestJWTManager_GenerateAccessToken_TokenExpiresAtCorrectTime(t *testing.T)
 	beforeGeneration := time.Now()
 
 	// When
-	tokenString, err := manager.GenerateAccessToken(uuid.New(), uuid.New(), "user@example.com", []string{})
+	tokenString, err := manager.GenerateAccessToken(uuid.New(), uuid.New(), "user@example.com", []string{}, nil)
 	assert.NoError(t, err)
 
 	afterGeneration := time.Now()
@@ -184,7 +186,7 @@ func TestJWTManager_ValidateAccessToken_WithValidToken_ReturnsClaims(t *testing.
 	givenRoles := []string{"admin"}
 	manager := NewJWTManager("test-secret", 15*time.Minute, 24*time.Hour, "auth-service")
 
-	tokenString, err := manager.GenerateAccessToken(givenUserID, givenOrgID, givenEmail, givenRoles)
+	tokenString, err := manager.GenerateAccessToken(givenUserID, givenOrgID, givenEmail, givenRoles, nil)
 	assert.NoError(t, err)
 
 	// When
@@ -203,7 +205,7 @@ func TestJWTManager_ValidateAccessToken_WithExpiredToken_ReturnsError(t *testing
 	// Given
 	manager := NewJWTManager("test-secret", -1*time.Hour, 24*time.Hour, "auth-service") // Negative expiry = already expired
 
-	tokenString, err := manager.GenerateAccessToken(uuid.New(), uuid.New(), "user@example.com", []string{})
+	tokenString, err := manager.GenerateAccessToken(uuid.New(), uuid.New(), "user@example.com", []string{}, nil)
 	assert.NoError(t, err)
 
 	// When
@@ -220,7 +222,7 @@ func TestJWTManager_ValidateAccessToken_WithInvalidSignature_ReturnsError(t *tes
 	manager1 := NewJWTManager("secret-1", 15*time.Minute, 24*time.Hour, "auth-service")
 	manager2 := NewJWTManager("secret-2", 15*time.Minute, 24*time.Hour, "auth-service")
 
-	tokenString, err := manager1.GenerateAccessToken(uuid.New(), uuid.New(), "user@example.com", []string{})
+	tokenString, err := manager1.GenerateAccessToken(uuid.New(), uuid.New(), "user@example.com", []string{}, nil)
 	assert.NoError(t, err)
 
 	// When
@@ -366,11 +368,40 @@ func TestJWTManager_TokensAreUnique_WhenGeneratedMultipleTimes(t *testing.T) {
 	givenUserID := uuid.New()
 
 	// When
-	token1, err1 := manager.GenerateAccessToken(givenUserID, uuid.New(), "user@example.com", []string{})
-	token2, err2 := manager.GenerateAccessToken(givenUserID, uuid.New(), "user@example.com", []string{})
+	token1, err1 := manager.GenerateAccessToken(givenUserID, uuid.New(), "user@example.com", []string{}, nil)
+	token2, err2 := manager.GenerateAccessToken(givenUserID, uuid.New(), "user@example.com", []string{}, nil)
 
 	// Then
 	assert.NoError(t, err1)
 	assert.NoError(t, err2)
 	assert.NotEqual(t, token1, token2, "Tokens should be unique even for same user")
 }
+
+func TestJWTManager_GenerateImpersonationToken_TokenCarriesImpersonator(t *testing.T) {
+	// Given
+	givenUserID := uuid.New()
+	givenOrgID := uuid.New()
+	givenImpersonatorID := uuid.New()
+	givenSessionID := uuid.New()
+
+	manager := NewJWTManager("test-secret", 15*time.Minute, 24*time.Hour, "auth-service")
+
+	// When
+	tokenString, err := manager.GenerateImpersonationToken(givenUserID, givenOrgID, "user@example.com", nil, &providers.ImpersonationClaims{
+		ImpersonatorID:  givenImpersonatorID,
+		ImpersonationID: givenSessionID,
+		ReadOnly:        true,
+		Expiry:          5 * time.Minute,
+	})
+	assert.NoError(t, err)
+
+	claims, err := manager.ValidateAccessToken(tokenString)
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, givenUserID.String(), claims.UserID)
+	assert.Equal(t, givenImpersonatorID.String(), claims.Impersonator)
+	assert.Equal(t, givenSessionID.String(), claims.ImpersonationID)
+	assert.True(t, claims.ReadOnly)
+	assert.WithinDuration(t, time.Now().Add(5*time.Minute), claims.ExpiresAt.Time, 5*time.Second)
+}
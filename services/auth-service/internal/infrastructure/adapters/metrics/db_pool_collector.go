@@ -0,0 +1,62 @@
+package metrics
+
+import (
+	"database/sql"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	pkgDatabase "github.com/giia/giia-core-engine/pkg/database"
+)
+
+var (
+	dbPoolMaxOpenDesc = prometheus.NewDesc(
+		"db_pool_max_open_connections", "Maximum number of open connections allowed", []string{"pool"}, nil)
+	dbPoolOpenDesc = prometheus.NewDesc(
+		"db_pool_open_connections", "Number of established connections, in use and idle", []string{"pool"}, nil)
+	dbPoolInUseDesc = prometheus.NewDesc(
+		"db_pool_in_use_connections", "Number of connections currently in use", []string{"pool"}, nil)
+	dbPoolIdleDesc = prometheus.NewDesc(
+		"db_pool_idle_connections", "Number of idle connections", []string{"pool"}, nil)
+	dbPoolWaitsDesc = prometheus.NewDesc(
+		"db_pool_waits_total", "Total number of times a caller waited for a connection", []string{"pool"}, nil)
+	dbPoolWaitSecondsDesc = prometheus.NewDesc(
+		"db_pool_wait_seconds_total", "Total time spent waiting for a connection", []string{"pool"}, nil)
+	dbPoolClosedDesc = prometheus.NewDesc(
+		"db_pool_closed_connections_total", "Total connections closed by the pool", []string{"pool", "reason"}, nil)
+)
+
+type dbPoolCollector struct {
+	pools map[string]*sql.DB
+}
+
+// NewDBPoolCollector reports connection pool usage for the named pools,
+// reading the stats on every scrape.
+func NewDBPoolCollector(pools map[string]*sql.DB) prometheus.Collector {
+	return &dbPoolCollector{pools: pools}
+}
+
+func (c *dbPoolCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- dbPoolMaxOpenDesc
+	ch <- dbPoolOpenDesc
+	ch <- dbPoolInUseDesc
+	ch <- dbPoolIdleDesc
+	ch <- dbPoolWaitsDesc
+	ch <- dbPoolWaitSecondsDesc
+	ch <- dbPoolClosedDesc
+}
+
+func (c *dbPoolCollector) Collect(ch chan<- prometheus.Metric) {
+	for name, db := range c.pools {
+		stats := pkgDatabase.ReadPoolStats(db)
+
+		ch <- prometheus.MustNewConstMetric(dbPoolMaxOpenDesc, prometheus.GaugeValue, float64(stats.MaxOpen), name)
+		ch <- prometheus.MustNewConstMetric(dbPoolOpenDesc, prometheus.GaugeValue, float64(stats.Open), name)
+		ch <- prometheus.MustNewConstMetric(dbPoolInUseDesc, prometheus.GaugeValue, float64(stats.InUse), name)
+		ch <- prometheus.MustNewConstMetric(dbPoolIdleDesc, prometheus.GaugeValue, float64(stats.Idle), name)
+		ch <- prometheus.MustNewConstMetric(dbPoolWaitsDesc, prometheus.CounterValue, float64(stats.WaitCount), name)
+		ch <- prometheus.MustNewConstMetric(dbPoolWaitSecondsDesc, prometheus.CounterValue, stats.WaitDuration.Seconds(), name)
+		ch <- prometheus.MustNewConstMetric(dbPoolClosedDesc, prometheus.CounterValue, float64(stats.MaxIdleClosed), name, "max_idle")
+		ch <- prometheus.MustNewConstMetric(dbPoolClosedDesc, prometheus.CounterValue, float64(stats.MaxIdleTimeClosed), name, "max_idle_time")
+		ch <- prometheus.MustNewConstMetric(dbPoolClosedDesc, prometheus.CounterValue, float64(stats.MaxLifetimeClosed), name, "max_lifetime")
+	}
+}
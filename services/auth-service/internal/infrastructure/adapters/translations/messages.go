@@ -0,0 +1,128 @@
+// Package translations holds the auth-service message catalog. Keys are the
+// English messages used in errors, so untranslated messages are served as-is.
+package translations
+
+import (
+	"github.com/giia/giia-core-engine/pkg/i18n"
+)
+
+// Messages is the catalog used to localize user-facing error messages.
+var Messages = newCatalog()
+
+func newCatalog() *i18n.Catalog {
+	catalog := i18n.NewCatalog(i18n.English)
+
+	catalog.Add(i18n.Spanish, map[string]string{
+		"internal server error":                                "error interno del servidor",
+		"invalid request body":                                 "cuerpo de la solicitud inválido",
+		"user not found":                                       "usuario no encontrado",
+		"user not authenticated":                               "usuario no autenticado",
+		"role not found":                                       "rol no encontrado",
+		"organization not found":                               "organización no encontrada",
+		"account is not active":                                "la cuenta no está activa",
+		"invalid email or password":                            "correo electrónico o contraseña inválidos",
+		"insufficient permissions":                             "permisos insuficientes",
+		"invalid or expired token":                             "token inválido o expirado",
+		"invalid or expired refresh token":                     "token de actualización inválido o expirado",
+		"email is required":                                    "el correo electrónico es obligatorio",
+		"password is required":                                 "la contraseña es obligatoria",
+		"refresh token is required":                            "el token de actualización es obligatorio",
+		"activation token is required":                         "el token de activación es obligatorio",
+		"verification code is required":                        "el código de verificación es obligatorio",
+		"invalid or expired challenge":                         "desafío inválido o expirado",
+		"unsupported language":                                 "idioma no soportado",
+		"unsupported date format":                              "formato de fecha no soportado",
+		"reason is required":                                   "el motivo es obligatorio",
+		"target user not found":                                "usuario objetivo no encontrado",
+		"target user is not active":                            "el usuario objetivo no está activo",
+		"password must contain at least one uppercase letter":  "la contraseña debe contener al menos una letra mayúscula",
+		"password must contain at least one special character": "la contraseña debe contener al menos un carácter especial",
+		"invalid or expired sign-in link":                      "enlace de inicio de sesión inválido o expirado",
+		"magic link login is disabled for this organization":   "el inicio de sesión con enlace mágico está deshabilitado para esta organización",
+		"invalid or expired email change link":                 "enlace de cambio de correo inválido o expirado",
+		"email is already in use":                              "el correo electrónico ya está en uso",
+		"manager not found":                                    "gerente no encontrado",
+		"a user cannot be their own manager":                   "un usuario no puede ser su propio gerente",
+		"manager change would create a reporting cycle":        "el cambio de gerente crearía un ciclo en la línea de reporte",
+		"token has been revoked":                               "el token fue revocado",
+		"failed to revoke sessions":                            "no se pudieron revocar las sesiones",
+		"period must use the YYYY-MM format":                   "el período debe usar el formato AAAA-MM",
+		"failed to get usage":                                  "no se pudo obtener el consumo",
+		"invalid verification code":                            "el código de verificación no es válido",
+		"failed to enable two-factor authentication":           "no se pudo habilitar la autenticación de dos factores",
+		"failed to start two-factor enrollment":                "no se pudo iniciar la activación de la autenticación de dos factores",
+		"two-factor enrollment has not been started":           "no se inició la activación de la autenticación de dos factores",
+		"two-factor authentication is already enabled":         "la autenticación de dos factores ya está habilitada",
+		"new password is required":                             "la nueva contraseña es obligatoria",
+		"invalid or expired password reset token":              "token de restablecimiento de contraseña inválido o expirado",
+		"password reset token is required":                     "el token de restablecimiento de contraseña es obligatorio",
+		"too many verification attempts, please log in again":  "demasiados intentos de verificación, inicia sesión nuevamente",
+		"failed to disable two-factor authentication":          "no se pudo deshabilitar la autenticación de dos factores",
+		"two-factor authentication is not enabled":             "la autenticación de dos factores no está habilitada",
+		"password is incorrect":                                "la contraseña es incorrecta",
+		"failed to list audit logs":                            "no se pudo listar el registro de auditoría",
+		"from must be before to":                               "from debe ser anterior a to",
+		"limit cannot be negative":                             "el límite no puede ser negativo",
+		"limit must be a number":                               "el límite debe ser un número",
+		"invalid actor ID format":                              "formato de ID de actor inválido",
+		"from and to must be RFC 3339 timestamps":              "from y to deben ser marcas de tiempo RFC 3339",
+	})
+
+	catalog.Add(i18n.Portuguese, map[string]string{
+		"internal server error":                                "erro interno do servidor",
+		"invalid request body":                                 "corpo da requisição inválido",
+		"user not found":                                       "usuário não encontrado",
+		"user not authenticated":                               "usuário não autenticado",
+		"role not found":                                       "papel não encontrado",
+		"organization not found":                               "organização não encontrada",
+		"account is not active":                                "a conta não está ativa",
+		"invalid email or password":                            "e-mail ou senha inválidos",
+		"insufficient permissions":                             "permissões insuficientes",
+		"invalid or expired token":                             "token inválido ou expirado",
+		"invalid or expired refresh token":                     "token de atualização inválido ou expirado",
+		"email is required":                                    "o e-mail é obrigatório",
+		"password is required":                                 "a senha é obrigatória",
+		"refresh token is required":                            "o token de atualização é obrigatório",
+		"activation token is required":                         "o token de ativação é obrigatório",
+		"verification code is required":                        "o código de verificação é obrigatório",
+		"invalid or expired challenge":                         "desafio inválido ou expirado",
+		"unsupported language":                                 "idioma não suportado",
+		"unsupported date format":                              "formato de data não suportado",
+		"reason is required":                                   "o motivo é obrigatório",
+		"target user not found":                                "usuário alvo não encontrado",
+		"target user is not active":                            "o usuário alvo não está ativo",
+		"password must contain at least one uppercase letter":  "a senha deve conter pelo menos uma letra maiúscula",
+		"password must contain at least one special character": "a senha deve conter pelo menos um caractere especial",
+		"invalid or expired sign-in link":                      "link de acesso inválido ou expirado",
+		"magic link login is disabled for this organization":   "o login por link mágico está desativado para esta organização",
+		"invalid or expired email change link":                 "link de alteração de e-mail inválido ou expirado",
+		"email is already in use":                              "o e-mail já está em uso",
+		"manager not found":                                    "gestor não encontrado",
+		"a user cannot be their own manager":                   "um usuário não pode ser o próprio gestor",
+		"manager change would create a reporting cycle":        "a alteração de gestor criaria um ciclo na linha de reporte",
+		"token has been revoked":                               "o token foi revogado",
+		"failed to revoke sessions":                            "não foi possível revogar as sessões",
+		"period must use the YYYY-MM format":                   "o período deve usar o formato AAAA-MM",
+		"failed to get usage":                                  "não foi possível obter o consumo",
+		"invalid verification code":                            "o código de verificação é inválido",
+		"failed to enable two-factor authentication":           "não foi possível habilitar a autenticação de dois fatores",
+		"failed to start two-factor enrollment":                "não foi possível iniciar a ativação da autenticação de dois fatores",
+		"two-factor enrollment has not been started":           "a ativação da autenticação de dois fatores não foi iniciada",
+		"two-factor authentication is already enabled":         "a autenticação de dois fatores já está habilitada",
+		"new password is required":                             "a nova senha é obrigatória",
+		"invalid or expired password reset token":              "token de redefinição de senha inválido ou expirado",
+		"password reset token is required":                     "o token de redefinição de senha é obrigatório",
+		"too many verification attempts, please log in again":  "muitas tentativas de verificação, faça login novamente",
+		"failed to disable two-factor authentication":          "não foi possível desabilitar a autenticação de dois fatores",
+		"two-factor authentication is not enabled":             "a autenticação de dois fatores não está habilitada",
+		"password is incorrect":                                "a senha está incorreta",
+		"failed to list audit logs":                            "não foi possível listar o registro de auditoria",
+		"from must be before to":                               "from deve ser anterior a to",
+		"limit cannot be negative":                             "o limite não pode ser negativo",
+		"limit must be a number":                               "o limite deve ser um número",
+		"invalid actor ID format":                              "formato de ID de ator inválido",
+		"from and to must be RFC 3339 timestamps":              "from e to devem ser carimbos de data/hora RFC 3339",
+	})
+
+	return catalog
+}
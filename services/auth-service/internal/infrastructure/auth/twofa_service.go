@@ -15,6 +15,7 @@ import (
 
 type TwoFAService interface {
 	GenerateSecret(userEmail string) (*TwoFASetup, error)
+	GenerateEnrollment(accountName string) (secret, otpauthURL string, err error)
 	GenerateQRCode(secret, userEmail string) ([]byte, error)
 	ValidateCode(secret, code string) bool
 	GenerateBackupCodes(count int) ([]string, error)
@@ -60,6 +61,19 @@ func (t *twoFAService) GenerateSecret(userEmail string) (*TwoFASetup, error) {
 	}, nil
 }
 
+func (t *twoFAService) GenerateEnrollment(accountName string) (string, string, error) {
+	key, err := totp.Generate(totp.GenerateOpts{
+		Issuer:      t.issuer,
+		AccountName: accountName,
+		SecretSize:  32,
+	})
+	if err != nil {
+		return "", "", pkgErrors.NewInternalServerError("failed to generate 2FA secret")
+	}
+
+	return key.Secret(), key.URL(), nil
+}
+
 func (t *twoFAService) GenerateQRCode(secret, userEmail string) ([]byte, error) {
 	key, err := otp.NewKeyFromURL(fmt.Sprintf("otpauth://totp/%s:%s?secret=%s&issuer=%s",
 		t.issuer, userEmail, secret, t.issuer))
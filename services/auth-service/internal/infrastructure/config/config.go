@@ -5,6 +5,7 @@ import (
 	"log"
 	"os"
 	"strconv"
+	"strings"
 	"time"
 )
 
@@ -35,6 +36,10 @@ type DatabaseConfig struct {
 	SSLMode  string
 	PoolMode string
 	DSN      string
+	// SlowQueryThreshold logs queries slower than this; zero disables it
+	SlowQueryThreshold time.Duration
+	// ExplainSlowQueries stores plans of slow SELECTs in query_diagnostics
+	ExplainSlowQueries bool
 }
 
 type JWTConfig struct {
@@ -45,12 +50,21 @@ type JWTConfig struct {
 }
 
 type EmailConfig struct {
-	SMTPHost     string
-	SMTPPort     int
-	SMTPUser     string
-	SMTPPassword string
-	FromEmail    string
-	FromName     string
+	Provider           string
+	SMTPHost           string
+	SMTPPort           int
+	SMTPUser           string
+	SMTPPassword       string
+	SendGridAPIKey     string
+	SESRegion          string
+	SESAccessKeyID     string
+	SESSecretAccessKey string
+	FromEmail          string
+	FromName           string
+	AppBaseURL         string
+	WebhookToken       string
+	WebhookVerifySNS   bool
+	WebhookSNSTopics   []string
 }
 
 type SecurityConfig struct {
@@ -58,6 +72,7 @@ type SecurityConfig struct {
 	MaxLoginAttempts  int
 	LockoutDuration   time.Duration
 	RateLimitPerMin   int
+	GeoIPURL          string
 }
 
 type RedisConfig struct {
@@ -91,6 +106,9 @@ func Load() *Config {
 			DBName:   getEnv("DB_NAME", "postgres"),   // Cambiar a 'postgres' para Supabase
 			SSLMode:  getEnv("DB_SSLMODE", "require"), // Cambiar a 'require' para Supabase
 			PoolMode: getEnv("DB_POOL_MODE", ""),
+
+			SlowQueryThreshold: time.Duration(getEnvAsInt("DB_SLOW_QUERY_THRESHOLD_MS", 500)) * time.Millisecond,
+			ExplainSlowQueries: getEnv("DB_EXPLAIN_SLOW_QUERIES", "false") == "true",
 		},
 		JWT: JWTConfig{
 			SecretKey:     getEnv("JWT_SECRET", "financial_resume_secret_key_2024"),
@@ -99,18 +117,28 @@ func Load() *Config {
 			Issuer:        getEnv("JWT_ISSUER", "users-service"),
 		},
 		Email: EmailConfig{
-			SMTPHost:     getEnv("SMTP_HOST", "localhost"),
-			SMTPPort:     getEnvAsInt("SMTP_PORT", 587),
-			SMTPUser:     getEnv("SMTP_USER", ""),
-			SMTPPassword: getEnv("SMTP_PASSWORD", ""),
-			FromEmail:    getEnv("FROM_EMAIL", "noreply@example.com"),
-			FromName:     getEnv("FROM_NAME", "Financial Resume"),
+			Provider:           getEnv("EMAIL_PROVIDER", "smtp"),
+			SMTPHost:           getEnv("SMTP_HOST", "localhost"),
+			SMTPPort:           getEnvAsInt("SMTP_PORT", 587),
+			SMTPUser:           getEnv("SMTP_USER", ""),
+			SMTPPassword:       getEnv("SMTP_PASSWORD", ""),
+			SendGridAPIKey:     getEnv("SENDGRID_API_KEY", ""),
+			SESRegion:          getEnv("SES_REGION", "us-east-1"),
+			SESAccessKeyID:     getEnv("SES_ACCESS_KEY_ID", ""),
+			SESSecretAccessKey: getEnv("SES_SECRET_ACCESS_KEY", ""),
+			FromEmail:          getEnv("FROM_EMAIL", "noreply@example.com"),
+			FromName:           getEnv("FROM_NAME", "Financial Resume"),
+			AppBaseURL:         getEnv("APP_BASE_URL", "http://localhost:3000"),
+			WebhookToken:       getEnv("EMAIL_WEBHOOK_TOKEN", ""),
+			WebhookVerifySNS:   getEnv("EMAIL_WEBHOOK_VERIFY_SNS", "true") == "true",
+			WebhookSNSTopics:   getEnvAsList("EMAIL_WEBHOOK_SNS_TOPIC_ARNS"),
 		},
 		Security: SecurityConfig{
 			PasswordMinLength: getEnvAsInt("PASSWORD_MIN_LENGTH", 8),
 			MaxLoginAttempts:  getEnvAsInt("MAX_LOGIN_ATTEMPTS", 5),
 			LockoutDuration:   time.Duration(getEnvAsInt("LOCKOUT_DURATION_MINUTES", 15)) * time.Minute,
 			RateLimitPerMin:   getEnvAsInt("RATE_LIMIT_PER_MIN", 60),
+			GeoIPURL:          getEnv("GEOIP_URL", ""),
 		},
 		Redis: RedisConfig{
 			Host:     getEnv("REDIS_HOST", "localhost"),
@@ -168,3 +196,13 @@ func getEnvAsInt(key string, defaultValue int) int {
 	}
 	return defaultValue
 }
+
+func getEnvAsList(key string) []string {
+	var values []string
+	for _, value := range strings.Split(os.Getenv(key), ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			values = append(values, value)
+		}
+	}
+	return values
+}
@@ -0,0 +1,116 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	pkgErrors "github.com/giia/giia-core-engine/pkg/errors"
+	pkgLogger "github.com/giia/giia-core-engine/pkg/logger"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/domain"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/usecases/apikey"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/infrastructure/entrypoints/http/middleware"
+)
+
+type APIKeyHandler struct {
+	createUseCase *apikey.CreateAPIKeyUseCase
+	listUseCase   *apikey.ListAPIKeysUseCase
+	revokeUseCase *apikey.RevokeAPIKeyUseCase
+	logger        pkgLogger.Logger
+}
+
+func NewAPIKeyHandler(
+	createUseCase *apikey.CreateAPIKeyUseCase,
+	listUseCase *apikey.ListAPIKeysUseCase,
+	revokeUseCase *apikey.RevokeAPIKeyUseCase,
+	logger pkgLogger.Logger,
+) *APIKeyHandler {
+	return &APIKeyHandler{
+		createUseCase: createUseCase,
+		listUseCase:   listUseCase,
+		revokeUseCase: revokeUseCase,
+		logger:        logger,
+	}
+}
+
+// Create rejects impersonation tokens; the key would outlive the session.
+func (h *APIKeyHandler) Create(c *gin.Context) {
+	if rejectImpersonation(c) {
+		return
+	}
+
+	orgID, userID, ok := tenantIDs(c)
+	if !ok {
+		return
+	}
+
+	var req domain.CreateAPIKeyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		writeError(c, pkgErrors.NewBadRequest("invalid request body"))
+		return
+	}
+
+	response, err := h.createUseCase.Execute(c.Request.Context(), orgID, userID, &req)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, response)
+}
+
+func (h *APIKeyHandler) List(c *gin.Context) {
+	orgID, err := middleware.GetOrganizationID(c)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	keys, err := h.listUseCase.Execute(c.Request.Context(), orgID)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"api_keys": keys})
+}
+
+func (h *APIKeyHandler) Revoke(c *gin.Context) {
+	orgID, userID, ok := tenantIDs(c)
+	if !ok {
+		return
+	}
+
+	keyID, err := uuid.Parse(c.Param("apiKeyId"))
+	if err != nil {
+		writeError(c, pkgErrors.NewBadRequest("invalid API key ID format"))
+		return
+	}
+
+	key, err := h.revokeUseCase.Execute(c.Request.Context(), orgID, userID, keyID)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, key)
+}
+
+// tenantIDs reads the organization and user of the authenticated caller,
+// writing the error response when either is missing.
+func tenantIDs(c *gin.Context) (uuid.UUID, uuid.UUID, bool) {
+	orgID, err := middleware.GetOrganizationID(c)
+	if err != nil {
+		writeError(c, err)
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		writeError(c, err)
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	return orgID, userID, true
+}
@@ -0,0 +1,89 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	pkgErrors "github.com/giia/giia-core-engine/pkg/errors"
+	pkgLogger "github.com/giia/giia-core-engine/pkg/logger"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/domain"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/usecases/audit"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/infrastructure/entrypoints/http/middleware"
+)
+
+type AuditHandler struct {
+	listAuditLogsUseCase *audit.ListAuditLogsUseCase
+	logger               pkgLogger.Logger
+}
+
+func NewAuditHandler(
+	listAuditLogsUseCase *audit.ListAuditLogsUseCase,
+	logger pkgLogger.Logger,
+) *AuditHandler {
+	return &AuditHandler{
+		listAuditLogsUseCase: listAuditLogsUseCase,
+		logger:               logger,
+	}
+}
+
+// List returns the organization's audit log filtered by the optional
+// actor_id, action, from and to (RFC 3339) and limit query parameters.
+func (h *AuditHandler) List(c *gin.Context) {
+	orgID, err := middleware.GetOrganizationID(c)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	filter, err := auditLogFilter(c)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	entries, err := h.listAuditLogsUseCase.Execute(c.Request.Context(), orgID, filter)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"audit_logs": entries})
+}
+
+func auditLogFilter(c *gin.Context) (domain.AuditLogFilter, error) {
+	filter := domain.AuditLogFilter{Action: c.Query("action")}
+
+	if raw := c.Query("actor_id"); raw != "" {
+		actorID, err := uuid.Parse(raw)
+		if err != nil {
+			return filter, pkgErrors.NewBadRequest("invalid actor ID format")
+		}
+		filter.ActorID = &actorID
+	}
+
+	for param, target := range map[string]**time.Time{"from": &filter.From, "to": &filter.To} {
+		raw := c.Query(param)
+		if raw == "" {
+			continue
+		}
+		parsed, err := time.Parse(time.RFC3339, raw)
+		if err != nil {
+			return filter, pkgErrors.NewBadRequest("from and to must be RFC 3339 timestamps")
+		}
+		*target = &parsed
+	}
+
+	if raw := c.Query("limit"); raw != "" {
+		limit, err := strconv.Atoi(raw)
+		if err != nil {
+			return filter, pkgErrors.NewBadRequest("limit must be a number")
+		}
+		filter.Limit = limit
+	}
+
+	return filter, nil
+}
@@ -19,6 +19,8 @@ type AuthHandler struct {
 	refreshTokenUseCase    *auth.RefreshTokenUseCase
 	logoutUseCase          *auth.LogoutUseCase
 	activateAccountUseCase *auth.ActivateAccountUseCase
+	changePasswordUseCase  *auth.ChangePasswordUseCase
+	verifyChallengeUseCase *auth.VerifyLoginChallengeUseCase
 	logger                 pkgLogger.Logger
 }
 
@@ -28,6 +30,8 @@ func NewAuthHandler(
 	refreshTokenUseCase *auth.RefreshTokenUseCase,
 	logoutUseCase *auth.LogoutUseCase,
 	activateAccountUseCase *auth.ActivateAccountUseCase,
+	changePasswordUseCase *auth.ChangePasswordUseCase,
+	verifyChallengeUseCase *auth.VerifyLoginChallengeUseCase,
 	logger pkgLogger.Logger,
 ) *AuthHandler {
 	return &AuthHandler{
@@ -36,6 +40,8 @@ func NewAuthHandler(
 		refreshTokenUseCase:    refreshTokenUseCase,
 		logoutUseCase:          logoutUseCase,
 		activateAccountUseCase: activateAccountUseCase,
+		changePasswordUseCase:  changePasswordUseCase,
+		verifyChallengeUseCase: verifyChallengeUseCase,
 		logger:                 logger,
 	}
 }
@@ -49,6 +55,9 @@ func (h *AuthHandler) Login(c *gin.Context) {
 		return
 	}
 
+	req.IPAddress = c.ClientIP()
+	req.UserAgent = c.Request.UserAgent()
+
 	response, err := h.loginUseCase.Execute(c.Request.Context(), &req)
 	if err != nil {
 		if customErr, ok := err.(*pkgErrors.CustomError); ok {
@@ -61,6 +70,49 @@ func (h *AuthHandler) Login(c *gin.Context) {
 		return
 	}
 
+	writeLoginResponse(c, response)
+}
+
+func (h *AuthHandler) VerifyLoginChallenge(c *gin.Context) {
+	var req domain.VerifyLoginChallengeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, pkgErrors.ToHTTPResponse(
+			pkgErrors.NewBadRequest("invalid request body"),
+		))
+		return
+	}
+
+	response, err := h.verifyChallengeUseCase.Execute(c.Request.Context(), &req)
+	if err != nil {
+		if customErr, ok := err.(*pkgErrors.CustomError); ok {
+			c.JSON(customErr.HTTPStatus, pkgErrors.ToHTTPResponse(err))
+		} else {
+			c.JSON(http.StatusInternalServerError, pkgErrors.ToHTTPResponse(
+				pkgErrors.NewInternalServerError("internal server error"),
+			))
+		}
+		return
+	}
+
+	writeSession(c, response)
+}
+
+// writeLoginResponse answers a login attempt: 202 with the challenge when a
+// step-up is required, otherwise the session.
+func writeLoginResponse(c *gin.Context, response *domain.LoginResponse) {
+	if response.StepUpRequired {
+		c.JSON(http.StatusAccepted, gin.H{
+			"step_up_required": true,
+			"challenge_token":  response.ChallengeToken,
+			"reasons":          response.StepUpReasons,
+		})
+		return
+	}
+
+	writeSession(c, response)
+}
+
+func writeSession(c *gin.Context, response *domain.LoginResponse) {
 	c.SetCookie(
 		"refresh_token",
 		response.RefreshToken,
@@ -207,3 +259,37 @@ func (h *AuthHandler) Activate(c *gin.Context) {
 		"message": "Account activated successfully. You can now log in.",
 	})
 }
+
+func (h *AuthHandler) ChangePassword(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, pkgErrors.ToHTTPResponse(err))
+		return
+	}
+
+	var req domain.ChangePasswordRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, pkgErrors.ToHTTPResponse(
+			pkgErrors.NewBadRequest("invalid request body"),
+		))
+		return
+	}
+
+	req.IPAddress = c.ClientIP()
+	req.UserAgent = c.Request.UserAgent()
+
+	if err := h.changePasswordUseCase.Execute(c.Request.Context(), userID, &req); err != nil {
+		if customErr, ok := err.(*pkgErrors.CustomError); ok {
+			c.JSON(customErr.HTTPStatus, pkgErrors.ToHTTPResponse(err))
+		} else {
+			c.JSON(http.StatusInternalServerError, pkgErrors.ToHTTPResponse(
+				pkgErrors.NewInternalServerError("internal server error"),
+			))
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Password changed successfully. Please log in again on your other devices.",
+	})
+}
@@ -0,0 +1,77 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	pkgErrors "github.com/giia/giia-core-engine/pkg/errors"
+	pkgLogger "github.com/giia/giia-core-engine/pkg/logger"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/domain"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/usecases/auth"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/infrastructure/entrypoints/http/middleware"
+)
+
+type EmailChangeHandler struct {
+	startUseCase   *auth.StartEmailChangeUseCase
+	confirmUseCase *auth.ConfirmEmailChangeUseCase
+	logger         pkgLogger.Logger
+}
+
+func NewEmailChangeHandler(
+	startUseCase *auth.StartEmailChangeUseCase,
+	confirmUseCase *auth.ConfirmEmailChangeUseCase,
+	logger pkgLogger.Logger,
+) *EmailChangeHandler {
+	return &EmailChangeHandler{
+		startUseCase:   startUseCase,
+		confirmUseCase: confirmUseCase,
+		logger:         logger,
+	}
+}
+
+func (h *EmailChangeHandler) Start(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	var req domain.StartEmailChangeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		writeError(c, pkgErrors.NewBadRequest("invalid request body"))
+		return
+	}
+
+	req.IPAddress = c.ClientIP()
+	req.UserAgent = c.Request.UserAgent()
+
+	response, err := h.startUseCase.Execute(c.Request.Context(), userID, &req)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusAccepted, response)
+}
+
+// Confirm is public: each address confirms from its own email link, possibly
+// on a device where the user is not signed in.
+func (h *EmailChangeHandler) Confirm(c *gin.Context) {
+	var req domain.ConfirmEmailChangeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		writeError(c, pkgErrors.NewBadRequest("invalid request body"))
+		return
+	}
+
+	req.IPAddress = c.ClientIP()
+	req.UserAgent = c.Request.UserAgent()
+
+	response, err := h.confirmUseCase.Execute(c.Request.Context(), &req)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,38 @@
+package handlers
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/giia/giia-core-engine/pkg/mailer"
+)
+
+// EmailWebhookHandler exposes provider bounce and complaint callbacks that keep
+// the email send log up to date.
+type EmailWebhookHandler struct {
+	webhooks *mailer.WebhookHandler
+}
+
+// NewEmailWebhookHandler fails when SNS signatures are verified without a list
+// of allowed topics, so the service does not start with SES callbacks open to
+// any AWS account.
+func NewEmailWebhookHandler(store mailer.SendLogStore, token string, verifySNS bool, allowedTopicARNs []string) (*EmailWebhookHandler, error) {
+	webhooks := &mailer.WebhookHandler{
+		Store:              store,
+		Token:              token,
+		VerifySNSSignature: verifySNS,
+		AllowedTopicARNs:   allowedTopicARNs,
+	}
+	if err := webhooks.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &EmailWebhookHandler{webhooks: webhooks}, nil
+}
+
+func (h *EmailWebhookHandler) SendGrid(c *gin.Context) {
+	h.webhooks.SendGrid().ServeHTTP(c.Writer, c.Request)
+}
+
+func (h *EmailWebhookHandler) SES(c *gin.Context) {
+	h.webhooks.SES().ServeHTTP(c.Writer, c.Request)
+}
@@ -0,0 +1,93 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	pkgErrors "github.com/giia/giia-core-engine/pkg/errors"
+	pkgLogger "github.com/giia/giia-core-engine/pkg/logger"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/domain"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/usecases/hierarchy"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/infrastructure/entrypoints/http/middleware"
+)
+
+type HierarchyHandler struct {
+	setManagerUseCase         *hierarchy.SetManagerUseCase
+	getEscalationChainUseCase *hierarchy.GetEscalationChainUseCase
+	logger                    pkgLogger.Logger
+}
+
+func NewHierarchyHandler(
+	setManagerUseCase *hierarchy.SetManagerUseCase,
+	getEscalationChainUseCase *hierarchy.GetEscalationChainUseCase,
+	logger pkgLogger.Logger,
+) *HierarchyHandler {
+	return &HierarchyHandler{
+		setManagerUseCase:         setManagerUseCase,
+		getEscalationChainUseCase: getEscalationChainUseCase,
+		logger:                    logger,
+	}
+}
+
+func (h *HierarchyHandler) SetManager(c *gin.Context) {
+	orgID, actorID, ok := tenantIDs(c)
+	if !ok {
+		return
+	}
+
+	userID, err := uuid.Parse(c.Param("userId"))
+	if err != nil {
+		writeError(c, pkgErrors.NewBadRequest("invalid user ID format"))
+		return
+	}
+
+	var req domain.SetManagerRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		writeError(c, pkgErrors.NewBadRequest("invalid request body"))
+		return
+	}
+
+	user, err := h.setManagerUseCase.Execute(c.Request.Context(), orgID, actorID, userID, &req)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, user)
+}
+
+// GetEscalationChain lists who to escalate to, nearest first. The optional
+// levels query parameter caps the chain length.
+func (h *HierarchyHandler) GetEscalationChain(c *gin.Context) {
+	orgID, err := middleware.GetOrganizationID(c)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	userID, err := uuid.Parse(c.Param("userId"))
+	if err != nil {
+		writeError(c, pkgErrors.NewBadRequest("invalid user ID format"))
+		return
+	}
+
+	levels := 0
+	if raw := c.Query("levels"); raw != "" {
+		levels, err = strconv.Atoi(raw)
+		if err != nil {
+			writeError(c, pkgErrors.NewBadRequest("levels must be a number"))
+			return
+		}
+	}
+
+	response, err := h.getEscalationChainUseCase.Execute(c.Request.Context(), orgID, userID, levels)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,185 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	pkgErrors "github.com/giia/giia-core-engine/pkg/errors"
+	pkgLogger "github.com/giia/giia-core-engine/pkg/logger"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/domain"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/usecases/impersonation"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/infrastructure/adapters/translations"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/infrastructure/entrypoints/http/middleware"
+)
+
+type ImpersonationHandler struct {
+	requestUseCase *impersonation.RequestImpersonationUseCase
+	respondUseCase *impersonation.RespondImpersonationUseCase
+	startUseCase   *impersonation.StartImpersonationUseCase
+	endUseCase     *impersonation.EndImpersonationUseCase
+	logger         pkgLogger.Logger
+}
+
+func NewImpersonationHandler(
+	requestUseCase *impersonation.RequestImpersonationUseCase,
+	respondUseCase *impersonation.RespondImpersonationUseCase,
+	startUseCase *impersonation.StartImpersonationUseCase,
+	endUseCase *impersonation.EndImpersonationUseCase,
+	logger pkgLogger.Logger,
+) *ImpersonationHandler {
+	return &ImpersonationHandler{
+		requestUseCase: requestUseCase,
+		respondUseCase: respondUseCase,
+		startUseCase:   startUseCase,
+		endUseCase:     endUseCase,
+		logger:         logger,
+	}
+}
+
+func (h *ImpersonationHandler) Request(c *gin.Context) {
+	userID, ok := directUserID(c)
+	if !ok {
+		return
+	}
+
+	var req domain.RequestImpersonationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		writeError(c, pkgErrors.NewBadRequest("invalid request body"))
+		return
+	}
+
+	session, err := h.requestUseCase.Execute(c.Request.Context(), userID, &req)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, session)
+}
+
+func (h *ImpersonationHandler) Respond(c *gin.Context) {
+	userID, ok := directUserID(c)
+	if !ok {
+		return
+	}
+
+	sessionID, ok := impersonationIDParam(c)
+	if !ok {
+		return
+	}
+
+	var req domain.RespondImpersonationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		writeError(c, pkgErrors.NewBadRequest("invalid request body"))
+		return
+	}
+
+	session, err := h.respondUseCase.Execute(c.Request.Context(), userID, sessionID, req.Approve)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, session)
+}
+
+func (h *ImpersonationHandler) Start(c *gin.Context) {
+	userID, ok := directUserID(c)
+	if !ok {
+		return
+	}
+
+	sessionID, ok := impersonationIDParam(c)
+	if !ok {
+		return
+	}
+
+	response, err := h.startUseCase.Execute(c.Request.Context(), userID, sessionID)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// End may be called by the impersonated user or by the impersonator, either
+// with their own token or with the impersonation token itself.
+func (h *ImpersonationHandler) End(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	if impersonatorID, exists := c.Get(string(middleware.ImpersonatorIDKey)); exists {
+		if id, ok := impersonatorID.(uuid.UUID); ok {
+			userID = id
+		}
+	}
+
+	sessionID, ok := impersonationIDParam(c)
+	if !ok {
+		return
+	}
+
+	if err := h.endUseCase.Execute(c.Request.Context(), userID, sessionID); err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Impersonation session ended",
+	})
+}
+
+// directUserID returns the caller's user ID, rejecting impersonation tokens so
+// sessions cannot be chained or consented to on the customer's behalf.
+func directUserID(c *gin.Context) (uuid.UUID, bool) {
+	if rejectImpersonation(c) {
+		return uuid.Nil, false
+	}
+
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		writeError(c, err)
+		return uuid.Nil, false
+	}
+
+	return userID, true
+}
+
+// rejectImpersonation writes 403 for impersonation tokens. Endpoints that mint
+// credentials use it so a support session cannot leave with a token or key
+// that ending the impersonation does not revoke.
+func rejectImpersonation(c *gin.Context) bool {
+	if _, exists := c.Get(string(middleware.ImpersonationKey)); exists {
+		writeError(c, pkgErrors.NewForbidden("not allowed during impersonation"))
+		return true
+	}
+	return false
+}
+
+func impersonationIDParam(c *gin.Context) (uuid.UUID, bool) {
+	sessionID, err := uuid.Parse(c.Param("impersonationId"))
+	if err != nil {
+		writeError(c, pkgErrors.NewBadRequest("invalid impersonation ID format"))
+		return uuid.Nil, false
+	}
+	return sessionID, true
+}
+
+// writeError renders err as JSON, translating the message into the language
+// negotiated by LocaleMiddleware.
+func writeError(c *gin.Context, err error) {
+	if _, ok := err.(*pkgErrors.CustomError); !ok {
+		err = pkgErrors.NewInternalServerError("internal server error")
+	}
+
+	response := pkgErrors.ToHTTPResponse(err)
+	response.Message = translations.Messages.TContext(c.Request.Context(), response.Message, nil)
+
+	c.JSON(response.StatusCode, response)
+}
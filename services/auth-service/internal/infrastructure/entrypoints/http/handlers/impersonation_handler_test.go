@@ -0,0 +1,68 @@
+package handlers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/giia/giia-core-engine/services/auth-service/internal/infrastructure/entrypoints/http/middleware"
+)
+
+// impersonatedContext builds a request context as ExtractTenantContext leaves
+// it for an impersonation token.
+func impersonatedContext(method, body string) (*gin.Context, *httptest.ResponseRecorder) {
+	gin.SetMode(gin.TestMode)
+	recorder := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(recorder)
+	c.Request = httptest.NewRequest(method, "/", strings.NewReader(body))
+	c.Request.Header.Set("Content-Type", "application/json")
+	c.Set(string(middleware.UserIDKey), uuid.New())
+	c.Set(string(middleware.OrganizationIDKey), uuid.New())
+	c.Set(string(middleware.ImpersonatorIDKey), uuid.New())
+	c.Set(string(middleware.ImpersonationKey), uuid.New())
+	return c, recorder
+}
+
+func TestMembershipHandler_SwitchOrganization_WithImpersonationToken_ReturnsForbidden(t *testing.T) {
+	// Given
+	handler := &MembershipHandler{}
+	c, recorder := impersonatedContext(http.MethodPost, `{"organization_id":"`+uuid.New().String()+`"}`)
+
+	// When
+	handler.SwitchOrganization(c)
+
+	// Then
+	assert.Equal(t, http.StatusForbidden, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "not allowed during impersonation")
+}
+
+func TestAPIKeyHandler_Create_WithImpersonationToken_ReturnsForbidden(t *testing.T) {
+	// Given
+	handler := &APIKeyHandler{}
+	c, recorder := impersonatedContext(http.MethodPost, `{"name":"bi","scopes":["analytics:kpis:read"]}`)
+
+	// When
+	handler.Create(c)
+
+	// Then
+	assert.Equal(t, http.StatusForbidden, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "not allowed during impersonation")
+}
+
+func TestTwoFactorHandler_Enroll_WithImpersonationToken_ReturnsForbidden(t *testing.T) {
+	// Given
+	handler := &TwoFactorHandler{}
+	c, recorder := impersonatedContext(http.MethodPost, `{"password":"CurrentPassword1!"}`)
+
+	// When
+	handler.Enroll(c)
+
+	// Then
+	assert.Equal(t, http.StatusForbidden, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "not allowed during impersonation")
+}
@@ -0,0 +1,70 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	pkgErrors "github.com/giia/giia-core-engine/pkg/errors"
+	pkgLogger "github.com/giia/giia-core-engine/pkg/logger"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/domain"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/usecases/auth"
+)
+
+type MagicLinkHandler struct {
+	requestUseCase  *auth.RequestMagicLinkUseCase
+	exchangeUseCase *auth.ExchangeMagicLinkUseCase
+	logger          pkgLogger.Logger
+}
+
+func NewMagicLinkHandler(
+	requestUseCase *auth.RequestMagicLinkUseCase,
+	exchangeUseCase *auth.ExchangeMagicLinkUseCase,
+	logger pkgLogger.Logger,
+) *MagicLinkHandler {
+	return &MagicLinkHandler{
+		requestUseCase:  requestUseCase,
+		exchangeUseCase: exchangeUseCase,
+		logger:          logger,
+	}
+}
+
+// Request always answers 202 so callers cannot tell whether the email has
+// an account.
+func (h *MagicLinkHandler) Request(c *gin.Context) {
+	var req domain.RequestMagicLinkRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		writeError(c, pkgErrors.NewBadRequest("invalid request body"))
+		return
+	}
+
+	req.IPAddress = c.ClientIP()
+
+	if err := h.requestUseCase.Execute(c.Request.Context(), &req); err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusAccepted, gin.H{
+		"message": "if the email belongs to an account with magic link sign-in enabled, a link has been sent",
+	})
+}
+
+func (h *MagicLinkHandler) Exchange(c *gin.Context) {
+	var req domain.ExchangeMagicLinkRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		writeError(c, pkgErrors.NewBadRequest("invalid request body"))
+		return
+	}
+
+	req.IPAddress = c.ClientIP()
+	req.UserAgent = c.Request.UserAgent()
+
+	response, err := h.exchangeUseCase.Execute(c.Request.Context(), &req)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	writeLoginResponse(c, response)
+}
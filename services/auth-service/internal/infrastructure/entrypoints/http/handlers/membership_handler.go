@@ -0,0 +1,145 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	pkgErrors "github.com/giia/giia-core-engine/pkg/errors"
+	pkgLogger "github.com/giia/giia-core-engine/pkg/logger"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/domain"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/usecases/membership"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/infrastructure/entrypoints/http/middleware"
+)
+
+type MembershipHandler struct {
+	addUseCase               *membership.AddMemberUseCase
+	listUseCase              *membership.ListMembersUseCase
+	removeUseCase            *membership.RemoveMemberUseCase
+	listOrganizationsUseCase *membership.ListUserOrganizationsUseCase
+	switchUseCase            *membership.SwitchOrganizationUseCase
+	logger                   pkgLogger.Logger
+}
+
+func NewMembershipHandler(
+	addUseCase *membership.AddMemberUseCase,
+	listUseCase *membership.ListMembersUseCase,
+	removeUseCase *membership.RemoveMemberUseCase,
+	listOrganizationsUseCase *membership.ListUserOrganizationsUseCase,
+	switchUseCase *membership.SwitchOrganizationUseCase,
+	logger pkgLogger.Logger,
+) *MembershipHandler {
+	return &MembershipHandler{
+		addUseCase:               addUseCase,
+		listUseCase:              listUseCase,
+		removeUseCase:            removeUseCase,
+		listOrganizationsUseCase: listOrganizationsUseCase,
+		switchUseCase:            switchUseCase,
+		logger:                   logger,
+	}
+}
+
+func (h *MembershipHandler) AddMember(c *gin.Context) {
+	orgID, userID, ok := tenantIDs(c)
+	if !ok {
+		return
+	}
+
+	var req domain.AddMemberRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		writeError(c, pkgErrors.NewBadRequest("invalid request body"))
+		return
+	}
+
+	member, err := h.addUseCase.Execute(c.Request.Context(), orgID, userID, &req)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, member)
+}
+
+func (h *MembershipHandler) ListMembers(c *gin.Context) {
+	orgID, err := middleware.GetOrganizationID(c)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	members, err := h.listUseCase.Execute(c.Request.Context(), orgID)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"members": members})
+}
+
+func (h *MembershipHandler) RemoveMember(c *gin.Context) {
+	orgID, actorID, ok := tenantIDs(c)
+	if !ok {
+		return
+	}
+
+	memberID, err := uuid.Parse(c.Param("userId"))
+	if err != nil {
+		writeError(c, pkgErrors.NewBadRequest("invalid user ID format"))
+		return
+	}
+
+	if err := h.removeUseCase.Execute(c.Request.Context(), orgID, actorID, memberID); err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// ListOrganizations lists the organizations the caller can switch into.
+func (h *MembershipHandler) ListOrganizations(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	organizations, err := h.listOrganizationsUseCase.Execute(c.Request.Context(), userID)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"organizations": organizations})
+}
+
+// SwitchOrganization returns an access token scoped to the requested
+// organization. Impersonation tokens are rejected: the new token would carry
+// no impersonation claims and outlive the session.
+func (h *MembershipHandler) SwitchOrganization(c *gin.Context) {
+	userID, ok := directUserID(c)
+	if !ok {
+		return
+	}
+
+	var req domain.SwitchOrganizationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		writeError(c, pkgErrors.NewBadRequest("invalid request body"))
+		return
+	}
+
+	orgID, err := uuid.Parse(req.OrganizationID)
+	if err != nil {
+		writeError(c, pkgErrors.NewBadRequest("invalid organization ID format"))
+		return
+	}
+
+	response, err := h.switchUseCase.Execute(c.Request.Context(), userID, orgID)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
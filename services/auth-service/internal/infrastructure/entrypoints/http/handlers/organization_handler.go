@@ -0,0 +1,138 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	pkgErrors "github.com/giia/giia-core-engine/pkg/errors"
+	pkgLogger "github.com/giia/giia-core-engine/pkg/logger"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/domain"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/usecases/organization"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/infrastructure/entrypoints/http/middleware"
+)
+
+type OrganizationHandler struct {
+	getSettingsUseCase        *organization.GetSettingsUseCase
+	updateSettingsUseCase     *organization.UpdateSettingsUseCase
+	updateAuthSettingsUseCase *organization.UpdateAuthSettingsUseCase
+	getCalendarUseCase        *organization.GetCalendarUseCase
+	updateCalendarUseCase     *organization.UpdateCalendarUseCase
+	logger                    pkgLogger.Logger
+}
+
+func NewOrganizationHandler(
+	getSettingsUseCase *organization.GetSettingsUseCase,
+	updateSettingsUseCase *organization.UpdateSettingsUseCase,
+	updateAuthSettingsUseCase *organization.UpdateAuthSettingsUseCase,
+	getCalendarUseCase *organization.GetCalendarUseCase,
+	updateCalendarUseCase *organization.UpdateCalendarUseCase,
+	logger pkgLogger.Logger,
+) *OrganizationHandler {
+	return &OrganizationHandler{
+		getSettingsUseCase:        getSettingsUseCase,
+		updateSettingsUseCase:     updateSettingsUseCase,
+		updateAuthSettingsUseCase: updateAuthSettingsUseCase,
+		getCalendarUseCase:        getCalendarUseCase,
+		updateCalendarUseCase:     updateCalendarUseCase,
+		logger:                    logger,
+	}
+}
+
+func (h *OrganizationHandler) GetSettings(c *gin.Context) {
+	orgID, err := middleware.GetOrganizationID(c)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	settings, err := h.getSettingsUseCase.Execute(c.Request.Context(), orgID)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, settings)
+}
+
+func (h *OrganizationHandler) UpdateSettings(c *gin.Context) {
+	orgID, err := middleware.GetOrganizationID(c)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	var req domain.LocaleSettings
+	if err := c.ShouldBindJSON(&req); err != nil {
+		writeError(c, pkgErrors.NewBadRequest("invalid request body"))
+		return
+	}
+
+	settings, err := h.updateSettingsUseCase.Execute(c.Request.Context(), orgID, &req)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, settings)
+}
+
+func (h *OrganizationHandler) UpdateAuthSettings(c *gin.Context) {
+	orgID, err := middleware.GetOrganizationID(c)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	var req domain.AuthSettings
+	if err := c.ShouldBindJSON(&req); err != nil {
+		writeError(c, pkgErrors.NewBadRequest("invalid request body"))
+		return
+	}
+
+	settings, err := h.updateAuthSettingsUseCase.Execute(c.Request.Context(), orgID, &req)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, settings)
+}
+
+func (h *OrganizationHandler) GetCalendar(c *gin.Context) {
+	orgID, err := middleware.GetOrganizationID(c)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	settings, err := h.getCalendarUseCase.Execute(c.Request.Context(), orgID)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, settings)
+}
+
+func (h *OrganizationHandler) UpdateCalendar(c *gin.Context) {
+	orgID, err := middleware.GetOrganizationID(c)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	var req domain.CalendarSettings
+	if err := c.ShouldBindJSON(&req); err != nil {
+		writeError(c, pkgErrors.NewBadRequest("invalid request body"))
+		return
+	}
+
+	settings, err := h.updateCalendarUseCase.Execute(c.Request.Context(), orgID, &req)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, settings)
+}
@@ -0,0 +1,70 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	pkgErrors "github.com/giia/giia-core-engine/pkg/errors"
+	pkgLogger "github.com/giia/giia-core-engine/pkg/logger"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/domain"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/usecases/tenant"
+)
+
+type OrganizationTransferHandler struct {
+	exportUseCase *tenant.ExportOrganizationUseCase
+	importUseCase *tenant.ImportOrganizationUseCase
+	logger        pkgLogger.Logger
+}
+
+func NewOrganizationTransferHandler(
+	exportUseCase *tenant.ExportOrganizationUseCase,
+	importUseCase *tenant.ImportOrganizationUseCase,
+	logger pkgLogger.Logger,
+) *OrganizationTransferHandler {
+	return &OrganizationTransferHandler{
+		exportUseCase: exportUseCase,
+		importUseCase: importUseCase,
+		logger:        logger,
+	}
+}
+
+// Export downloads the organization archive as a JSON file.
+func (h *OrganizationTransferHandler) Export(c *gin.Context) {
+	orgID, userID, ok := tenantIDs(c)
+	if !ok {
+		return
+	}
+
+	archive, err := h.exportUseCase.Execute(c.Request.Context(), orgID, userID)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	filename := fmt.Sprintf("%s-%s.json", archive.Organization.Slug, archive.ExportedAt.Format("20060102-150405"))
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+	c.JSON(http.StatusOK, archive)
+}
+
+func (h *OrganizationTransferHandler) Import(c *gin.Context) {
+	orgID, userID, ok := tenantIDs(c)
+	if !ok {
+		return
+	}
+
+	var req domain.ImportOrganizationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		writeError(c, pkgErrors.NewBadRequest("invalid request body"))
+		return
+	}
+
+	result, err := h.importUseCase.Execute(c.Request.Context(), orgID, userID, &req)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
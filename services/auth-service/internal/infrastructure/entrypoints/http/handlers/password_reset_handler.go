@@ -0,0 +1,71 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	pkgErrors "github.com/giia/giia-core-engine/pkg/errors"
+	pkgLogger "github.com/giia/giia-core-engine/pkg/logger"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/domain"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/usecases/auth"
+)
+
+type PasswordResetHandler struct {
+	requestUseCase *auth.RequestPasswordResetUseCase
+	resetUseCase   *auth.ResetPasswordUseCase
+	logger         pkgLogger.Logger
+}
+
+func NewPasswordResetHandler(
+	requestUseCase *auth.RequestPasswordResetUseCase,
+	resetUseCase *auth.ResetPasswordUseCase,
+	logger pkgLogger.Logger,
+) *PasswordResetHandler {
+	return &PasswordResetHandler{
+		requestUseCase: requestUseCase,
+		resetUseCase:   resetUseCase,
+		logger:         logger,
+	}
+}
+
+// Request always answers 202 so callers cannot tell whether the email has
+// an account.
+func (h *PasswordResetHandler) Request(c *gin.Context) {
+	var req domain.ForgotPasswordRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		writeError(c, pkgErrors.NewBadRequest("invalid request body"))
+		return
+	}
+
+	req.IPAddress = c.ClientIP()
+
+	if err := h.requestUseCase.Execute(c.Request.Context(), &req); err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusAccepted, gin.H{
+		"message": "if the email belongs to an account, a password reset link has been sent",
+	})
+}
+
+func (h *PasswordResetHandler) Reset(c *gin.Context) {
+	var req domain.ResetPasswordRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		writeError(c, pkgErrors.NewBadRequest("invalid request body"))
+		return
+	}
+
+	req.IPAddress = c.ClientIP()
+	req.UserAgent = c.Request.UserAgent()
+
+	if err := h.resetUseCase.Execute(c.Request.Context(), &req); err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "password has been reset",
+	})
+}
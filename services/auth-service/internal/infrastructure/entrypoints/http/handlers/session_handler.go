@@ -0,0 +1,48 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	pkgErrors "github.com/giia/giia-core-engine/pkg/errors"
+	pkgLogger "github.com/giia/giia-core-engine/pkg/logger"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/usecases/auth"
+)
+
+type SessionHandler struct {
+	revokeUseCase *auth.RevokeUserSessionsUseCase
+	logger        pkgLogger.Logger
+}
+
+func NewSessionHandler(
+	revokeUseCase *auth.RevokeUserSessionsUseCase,
+	logger pkgLogger.Logger,
+) *SessionHandler {
+	return &SessionHandler{
+		revokeUseCase: revokeUseCase,
+		logger:        logger,
+	}
+}
+
+// RevokeAll signs the user out of every device.
+func (h *SessionHandler) RevokeAll(c *gin.Context) {
+	orgID, actorID, ok := tenantIDs(c)
+	if !ok {
+		return
+	}
+
+	userID, err := uuid.Parse(c.Param("userId"))
+	if err != nil {
+		writeError(c, pkgErrors.NewBadRequest("invalid user ID format"))
+		return
+	}
+
+	if err := h.revokeUseCase.Execute(c.Request.Context(), orgID, actorID, userID); err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,117 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	pkgErrors "github.com/giia/giia-core-engine/pkg/errors"
+	pkgLogger "github.com/giia/giia-core-engine/pkg/logger"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/domain"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/usecases/auth"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/infrastructure/entrypoints/http/middleware"
+)
+
+type TwoFactorHandler struct {
+	startEnrollmentUseCase   *auth.StartTwoFactorEnrollmentUseCase
+	confirmEnrollmentUseCase *auth.ConfirmTwoFactorEnrollmentUseCase
+	disableUseCase           *auth.DisableTwoFactorUseCase
+	logger                   pkgLogger.Logger
+}
+
+func NewTwoFactorHandler(
+	startEnrollmentUseCase *auth.StartTwoFactorEnrollmentUseCase,
+	confirmEnrollmentUseCase *auth.ConfirmTwoFactorEnrollmentUseCase,
+	disableUseCase *auth.DisableTwoFactorUseCase,
+	logger pkgLogger.Logger,
+) *TwoFactorHandler {
+	return &TwoFactorHandler{
+		startEnrollmentUseCase:   startEnrollmentUseCase,
+		confirmEnrollmentUseCase: confirmEnrollmentUseCase,
+		disableUseCase:           disableUseCase,
+		logger:                   logger,
+	}
+}
+
+// Enroll creates a pending TOTP secret for the caller after confirming their
+// password. Impersonators cannot enroll a device on the user's behalf.
+func (h *TwoFactorHandler) Enroll(c *gin.Context) {
+	if rejectImpersonation(c) {
+		return
+	}
+
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	var req domain.StartTwoFactorEnrollmentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		writeError(c, pkgErrors.NewBadRequest("invalid request body"))
+		return
+	}
+
+	response, err := h.startEnrollmentUseCase.Execute(c.Request.Context(), userID, &req)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// ConfirmEnrollment turns 2FA on once the caller submits a code from the
+// pending secret.
+func (h *TwoFactorHandler) ConfirmEnrollment(c *gin.Context) {
+	if rejectImpersonation(c) {
+		return
+	}
+
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	var req domain.ConfirmTwoFactorEnrollmentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		writeError(c, pkgErrors.NewBadRequest("invalid request body"))
+		return
+	}
+
+	req.IPAddress = c.ClientIP()
+	req.UserAgent = c.Request.UserAgent()
+
+	if err := h.confirmEnrollmentUseCase.Execute(c.Request.Context(), userID, &req); err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// Disable turns off 2FA for the caller after confirming their password.
+func (h *TwoFactorHandler) Disable(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	var req domain.DisableTwoFactorRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		writeError(c, pkgErrors.NewBadRequest("invalid request body"))
+		return
+	}
+
+	req.IPAddress = c.ClientIP()
+	req.UserAgent = c.Request.UserAgent()
+
+	if err := h.disableUseCase.Execute(c.Request.Context(), userID, &req); err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,44 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	pkgLogger "github.com/giia/giia-core-engine/pkg/logger"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/usecases/usage"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/infrastructure/entrypoints/http/middleware"
+)
+
+type UsageHandler struct {
+	getUsageUseCase *usage.GetUsageUseCase
+	logger          pkgLogger.Logger
+}
+
+func NewUsageHandler(
+	getUsageUseCase *usage.GetUsageUseCase,
+	logger pkgLogger.Logger,
+) *UsageHandler {
+	return &UsageHandler{
+		getUsageUseCase: getUsageUseCase,
+		logger:          logger,
+	}
+}
+
+// GetUsage returns the organization's billable usage for ?period=YYYY-MM,
+// defaulting to the current month.
+func (h *UsageHandler) GetUsage(c *gin.Context) {
+	orgID, err := middleware.GetOrganizationID(c)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	report, err := h.getUsageUseCase.Execute(c.Request.Context(), orgID, c.Query("period"))
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, report)
+}
@@ -0,0 +1,94 @@
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/giia/giia-core-engine/pkg/authz"
+	pkgErrors "github.com/giia/giia-core-engine/pkg/errors"
+	pkgLogger "github.com/giia/giia-core-engine/pkg/logger"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/providers"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/usecases/apikey"
+)
+
+const (
+	APIKeyHeader            = "X-API-Key"
+	APIKeyIDKey  contextKey = "api_key_id"
+)
+
+// APIKeyMiddleware authenticates machine clients (BI tools) by the X-API-Key
+// header. The caller is limited to the key's scopes and rate limit.
+type APIKeyMiddleware struct {
+	validateUseCase *apikey.ValidateAPIKeyUseCase
+	rateLimiter     providers.RateLimiter
+}
+
+func NewAPIKeyMiddleware(validateUseCase *apikey.ValidateAPIKeyUseCase, rateLimiter providers.RateLimiter) *APIKeyMiddleware {
+	return &APIKeyMiddleware{
+		validateUseCase: validateUseCase,
+		rateLimiter:     rateLimiter,
+	}
+}
+
+func (m *APIKeyMiddleware) Authenticate() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		plaintext := c.GetHeader(APIKeyHeader)
+		if plaintext == "" {
+			c.JSON(http.StatusUnauthorized, pkgErrors.ToHTTPResponse(
+				pkgErrors.NewUnauthorized("missing API key"),
+			))
+			c.Abort()
+			return
+		}
+
+		key, err := m.validateUseCase.Execute(c.Request.Context(), plaintext)
+		if err != nil {
+			c.JSON(http.StatusUnauthorized, pkgErrors.ToHTTPResponse(err))
+			c.Abort()
+			return
+		}
+
+		allowed, retryAfter, err := m.rateLimiter.CheckRateLimit(c.Request.Context(), "api_key:"+key.ID.String(), key.RateLimitPerMinute, time.Minute)
+		if err == nil && !allowed {
+			c.Header("Retry-After", fmt.Sprintf("%d", int(retryAfter.Seconds())))
+			c.JSON(http.StatusTooManyRequests, pkgErrors.ToHTTPResponse(
+				pkgErrors.NewBadRequest("API key rate limit exceeded, please try again later"),
+			))
+			c.Abort()
+			return
+		}
+
+		c.Set(string(OrganizationIDKey), key.OrganizationID)
+		c.Set(string(APIKeyIDKey), key.ID)
+
+		ctx := authz.WithPrincipal(c.Request.Context(), &authz.Principal{
+			UserID:         key.CreatedBy.String(),
+			OrganizationID: key.OrganizationID.String(),
+			Permissions:    key.Scopes,
+			APIKeyID:       key.ID.String(),
+		})
+		ctx = pkgLogger.WithOrganizationID(ctx, key.OrganizationID.String())
+		c.Request = c.Request.WithContext(ctx)
+
+		c.Next()
+	}
+}
+
+// RequireScope rejects API key callers whose key does not cover scope.
+func (m *APIKeyMiddleware) RequireScope(scope string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		principal, ok := authz.PrincipalFromContext(c.Request.Context())
+		if !ok || !authz.Match(principal.Permissions, scope) {
+			c.JSON(http.StatusForbidden, pkgErrors.ToHTTPResponse(
+				pkgErrors.NewPermissionDenied(scope),
+			))
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
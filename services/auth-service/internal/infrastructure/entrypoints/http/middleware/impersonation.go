@@ -0,0 +1,112 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	pkgErrors "github.com/giia/giia-core-engine/pkg/errors"
+	pkgLogger "github.com/giia/giia-core-engine/pkg/logger"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/domain"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/providers"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/usecases/impersonation"
+)
+
+// ImpersonationMiddleware guards requests made with impersonation tokens. It
+// must run after ExtractTenantContext; requests with regular tokens pass through.
+type ImpersonationMiddleware struct {
+	checkImpersonationUseCase *impersonation.CheckImpersonationUseCase
+	auditRepo                 providers.AuditLogRepository
+	logger                    pkgLogger.Logger
+}
+
+func NewImpersonationMiddleware(
+	checkImpersonationUseCase *impersonation.CheckImpersonationUseCase,
+	auditRepo providers.AuditLogRepository,
+	logger pkgLogger.Logger,
+) *ImpersonationMiddleware {
+	return &ImpersonationMiddleware{
+		checkImpersonationUseCase: checkImpersonationUseCase,
+		auditRepo:                 auditRepo,
+		logger:                    logger,
+	}
+}
+
+func (m *ImpersonationMiddleware) Guard() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		value, exists := c.Get(string(ImpersonationKey))
+		if !exists {
+			c.Next()
+			return
+		}
+
+		impersonationID, ok := value.(uuid.UUID)
+		if !ok {
+			c.JSON(http.StatusInternalServerError, pkgErrors.ToHTTPResponse(
+				pkgErrors.NewInternalServerError("invalid impersonation ID in context"),
+			))
+			c.Abort()
+			return
+		}
+
+		session, err := m.checkImpersonationUseCase.Execute(c.Request.Context(), impersonationID)
+		if err != nil {
+			c.JSON(http.StatusUnauthorized, pkgErrors.ToHTTPResponse(err))
+			c.Abort()
+			return
+		}
+
+		if session.ReadOnly && !isReadOnlyMethod(c.Request.Method) {
+			m.logger.Warn(c.Request.Context(), "Write attempted during read-only impersonation", pkgLogger.Tags{
+				"impersonation_id": session.ID.String(),
+				"method":           c.Request.Method,
+				"path":             c.Request.URL.Path,
+			})
+			m.record(c, session, http.StatusForbidden)
+			c.JSON(http.StatusForbidden, pkgErrors.ToHTTPResponse(
+				pkgErrors.NewForbidden("impersonation session is read-only"),
+			))
+			c.Abort()
+			return
+		}
+
+		c.Next()
+
+		m.record(c, session, c.Writer.Status())
+	}
+}
+
+// record tags the request in the audit log with the impersonator.
+func (m *ImpersonationMiddleware) record(c *gin.Context, session *domain.ImpersonationSession, status int) {
+	impersonatorID := session.ImpersonatorID
+	sessionID := session.ID
+
+	entry := &domain.AuditLog{
+		OrganizationID:  session.OrganizationID,
+		ActorID:         session.TargetUserID,
+		ImpersonatorID:  &impersonatorID,
+		ImpersonationID: &sessionID,
+		Action:          domain.AuditActionRequest,
+		Resource:        c.Request.URL.Path,
+		Method:          c.Request.Method,
+		StatusCode:      status,
+		IPAddress:       c.ClientIP(),
+	}
+
+	if err := m.auditRepo.Create(c.Request.Context(), entry); err != nil {
+		m.logger.Error(c.Request.Context(), err, "Failed to write impersonation audit log", pkgLogger.Tags{
+			"impersonation_id": session.ID.String(),
+			"path":             c.Request.URL.Path,
+		})
+	}
+}
+
+func isReadOnlyMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true
+	default:
+		return false
+	}
+}
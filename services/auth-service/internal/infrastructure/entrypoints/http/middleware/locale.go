@@ -0,0 +1,49 @@
+package middleware
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"github.com/giia/giia-core-engine/pkg/i18n"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/domain"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/providers"
+)
+
+// LocaleMiddleware negotiates the response language and stores it in the
+// request context. When it runs after ExtractTenantContext, an explicit
+// organization language takes precedence over the Accept-Language header.
+type LocaleMiddleware struct {
+	orgRepo providers.OrganizationRepository
+}
+
+func NewLocaleMiddleware(orgRepo providers.OrganizationRepository) *LocaleMiddleware {
+	return &LocaleMiddleware{
+		orgRepo: orgRepo,
+	}
+}
+
+func (m *LocaleMiddleware) Negotiate() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+
+		var preferences []string
+		if value, exists := c.Get(string(OrganizationIDKey)); exists {
+			if orgID, ok := value.(uuid.UUID); ok {
+				if org, err := m.orgRepo.GetByID(ctx, orgID); err == nil {
+					preferences = append(preferences, org.LocaleSettings().Language)
+				}
+			}
+		}
+
+		language := i18n.Negotiate(
+			domain.SupportedLanguages,
+			c.GetHeader("Accept-Language"),
+			domain.SystemLocale.Language,
+			preferences...,
+		)
+
+		c.Request = c.Request.WithContext(i18n.WithLanguage(ctx, language))
+		c.Header("Content-Language", language)
+		c.Next()
+	}
+}
@@ -46,7 +46,7 @@ func (m *PermissionMiddleware) RequirePermission(permission string) gin.HandlerF
 			return
 		}
 
-		allowed, err := m.checkPermissionUseCase.Execute(c.Request.Context(), userID, permission)
+		allowed, err := m.check(c, userID, permission)
 		if err != nil {
 			m.logger.Error(c.Request.Context(), err, "Permission check failed", pkgLogger.Tags{
 				"user_id":    userID.String(),
@@ -106,7 +106,7 @@ func (m *PermissionMiddleware) RequireAnyPermission(permissions ...string) gin.H
 
 		hasPermission := false
 		for _, permission := range permissions {
-			allowed, err := m.checkPermissionUseCase.Execute(c.Request.Context(), userID, permission)
+			allowed, err := m.check(c, userID, permission)
 			if err != nil {
 				m.logger.Error(c.Request.Context(), err, "Permission check failed", pkgLogger.Tags{
 					"user_id":    userID.String(),
@@ -138,3 +138,12 @@ func (m *PermissionMiddleware) RequireAnyPermission(permissions ...string) gin.H
 		c.Next()
 	}
 }
+
+// check evaluates the permission in the organization the caller's token is
+// scoped to, so a switched token only carries that organization's roles.
+func (m *PermissionMiddleware) check(c *gin.Context, userID uuid.UUID, permission string) (bool, error) {
+	if orgID, err := GetOrganizationID(c); err == nil {
+		return m.checkPermissionUseCase.ExecuteInOrganization(c.Request.Context(), userID, orgID, permission)
+	}
+	return m.checkPermissionUseCase.Execute(c.Request.Context(), userID, permission)
+}
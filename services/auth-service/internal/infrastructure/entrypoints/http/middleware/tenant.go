@@ -6,7 +6,10 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 
+	"github.com/giia/giia-core-engine/pkg/authz"
 	pkgErrors "github.com/giia/giia-core-engine/pkg/errors"
+	pkgLogger "github.com/giia/giia-core-engine/pkg/logger"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/usecases/auth"
 	"github.com/giia/giia-core-engine/services/auth-service/internal/infrastructure/adapters/jwt"
 )
 
@@ -15,15 +18,20 @@ type contextKey string
 const (
 	OrganizationIDKey contextKey = "organization_id"
 	UserIDKey         contextKey = "user_id"
+	ImpersonatorIDKey contextKey = "impersonator_id"
+	ImpersonationKey  contextKey = "impersonation_id"
+	ReadOnlyKey       contextKey = "read_only"
 )
 
 type TenantMiddleware struct {
 	jwtManager *jwt.JWTManager
+	revocation *auth.CheckTokenRevocationUseCase
 }
 
-func NewTenantMiddleware(jwtManager *jwt.JWTManager) *TenantMiddleware {
+func NewTenantMiddleware(jwtManager *jwt.JWTManager, revocation *auth.CheckTokenRevocationUseCase) *TenantMiddleware {
 	return &TenantMiddleware{
 		jwtManager: jwtManager,
+		revocation: revocation,
 	}
 }
 
@@ -52,6 +60,14 @@ func (m *TenantMiddleware) ExtractTenantContext() gin.HandlerFunc {
 			return
 		}
 
+		if m.revocation.Execute(c.Request.Context(), tokenString, claims) {
+			c.JSON(http.StatusUnauthorized, pkgErrors.ToHTTPResponse(
+				pkgErrors.NewUnauthorized("token has been revoked"),
+			))
+			c.Abort()
+			return
+		}
+
 		orgID, err := uuid.Parse(claims.OrganizationID)
 		if err != nil {
 			c.JSON(http.StatusUnauthorized, pkgErrors.ToHTTPResponse(
@@ -74,6 +90,39 @@ func (m *TenantMiddleware) ExtractTenantContext() gin.HandlerFunc {
 		c.Set(string(UserIDKey), userID)
 		c.Set("email", claims.Email)
 		c.Set("roles", claims.Roles)
+		ctx := authz.WithPrincipal(c.Request.Context(), &authz.Principal{
+			UserID:         claims.UserID,
+			OrganizationID: claims.OrganizationID,
+			Roles:          claims.Roles,
+			Permissions:    claims.Permissions,
+		})
+		ctx = pkgLogger.WithOrganizationID(ctx, claims.OrganizationID)
+		ctx = pkgLogger.WithUserID(ctx, claims.UserID)
+		c.Request = c.Request.WithContext(ctx)
+
+		if claims.ImpersonationID != "" {
+			impersonatorID, err := uuid.Parse(claims.Impersonator)
+			if err != nil {
+				c.JSON(http.StatusUnauthorized, pkgErrors.ToHTTPResponse(
+					pkgErrors.NewUnauthorized("invalid impersonator ID in token"),
+				))
+				c.Abort()
+				return
+			}
+
+			impersonationID, err := uuid.Parse(claims.ImpersonationID)
+			if err != nil {
+				c.JSON(http.StatusUnauthorized, pkgErrors.ToHTTPResponse(
+					pkgErrors.NewUnauthorized("invalid impersonation ID in token"),
+				))
+				c.Abort()
+				return
+			}
+
+			c.Set(string(ImpersonatorIDKey), impersonatorID)
+			c.Set(string(ImpersonationKey), impersonationID)
+			c.Set(string(ReadOnlyKey), claims.ReadOnly)
+		}
 
 		c.Next()
 	}
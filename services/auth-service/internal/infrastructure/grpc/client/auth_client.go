@@ -78,7 +78,9 @@ func (c *AuthClient) ValidateToken(ctx context.Context, token string, requestID
 	return c.client.ValidateToken(ctx, req)
 }
 
-func (c *AuthClient) CheckPermission(ctx context.Context, userID, permission, requestID string) (*authv1.CheckPermissionResponse, error) {
+// CheckPermission checks permission against the roles the user holds in
+// organizationID, or in their home organization when it is empty.
+func (c *AuthClient) CheckPermission(ctx context.Context, userID, organizationID, permission, requestID string) (*authv1.CheckPermissionResponse, error) {
 	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
 
@@ -88,8 +90,9 @@ func (c *AuthClient) CheckPermission(ctx context.Context, userID, permission, re
 	}
 
 	req := &authv1.CheckPermissionRequest{
-		UserId:     userID,
-		Permission: permission,
+		UserId:         userID,
+		OrganizationId: organizationID,
+		Permission:     permission,
 	}
 
 	return c.client.CheckPermission(ctx, req)
@@ -179,3 +182,19 @@ func (p *ConnectionPool) Close() error {
 	}
 	return lastErr
 }
+
+func (c *AuthClient) ValidateAPIKey(ctx context.Context, key, requestID string) (*authv1.ValidateAPIKeyResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	if requestID != "" {
+		md := metadata.Pairs("x-request-id", requestID)
+		ctx = metadata.NewOutgoingContext(ctx, md)
+	}
+
+	req := &authv1.ValidateAPIKeyRequest{
+		Key: key,
+	}
+
+	return c.client.ValidateAPIKey(ctx, req)
+}
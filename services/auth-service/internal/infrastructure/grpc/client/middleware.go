@@ -75,7 +75,7 @@ func (m *AuthMiddleware) RequirePermission(permission string) gin.HandlerFunc {
 			requestID = uuid.New().String()
 		}
 
-		resp, err := m.client.CheckPermission(c.Request.Context(), userID.(string), permission, requestID)
+		resp, err := m.client.CheckPermission(c.Request.Context(), userID.(string), c.GetString("organization_id"), permission, requestID)
 		if err != nil {
 			c.JSON(500, gin.H{"error": "failed to check permission"})
 			c.Abort()
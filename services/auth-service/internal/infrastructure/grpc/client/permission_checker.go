@@ -0,0 +1,25 @@
+package client
+
+import (
+	"context"
+
+	"github.com/giia/giia-core-engine/pkg/authz"
+)
+
+type permissionChecker struct {
+	client *AuthClient
+}
+
+// NewPermissionChecker adapts the CheckPermission RPC so other services can
+// back an authz.Authorizer with auth-service.
+func NewPermissionChecker(client *AuthClient) authz.PermissionChecker {
+	return &permissionChecker{client: client}
+}
+
+func (p *permissionChecker) CheckPermission(ctx context.Context, userID, orgID, permission string) (bool, error) {
+	resp, err := p.client.CheckPermission(ctx, userID, orgID, permission, "")
+	if err != nil {
+		return false, err
+	}
+	return resp.GetAllowed(), nil
+}
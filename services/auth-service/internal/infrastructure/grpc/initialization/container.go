@@ -6,7 +6,9 @@ import (
 	"github.com/redis/go-redis/v9"
 	"gorm.io/gorm"
 
+	"github.com/giia/giia-core-engine/pkg/health"
 	pkgLogger "github.com/giia/giia-core-engine/pkg/logger"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/usecases/apikey"
 	"github.com/giia/giia-core-engine/services/auth-service/internal/core/usecases/auth"
 	"github.com/giia/giia-core-engine/services/auth-service/internal/core/usecases/rbac"
 	"github.com/giia/giia-core-engine/services/auth-service/internal/infrastructure/adapters/cache"
@@ -28,7 +30,9 @@ type GRPCConfig struct {
 	JWTIssuer        string
 	DB               *gorm.DB
 	RedisClient      *redis.Client
-	Logger           pkgLogger.Logger
+	// Readiness backs the gRPC health service; main shares it with /ready.
+	Readiness *health.Checker
+	Logger    pkgLogger.Logger
 }
 
 func InitializeGRPCServer(cfg *GRPCConfig) (*GRPCContainer, error) {
@@ -40,6 +44,7 @@ func InitializeGRPCServer(cfg *GRPCConfig) (*GRPCContainer, error) {
 	)
 
 	userRepo := repositories.NewUserRepository(cfg.DB)
+	tokenRepo := repositories.NewTokenRepository(cfg.RedisClient, cfg.DB)
 	roleRepo := repositories.NewRoleRepository(cfg.DB)
 	permissionRepo := repositories.NewPermissionRepository(cfg.DB)
 
@@ -55,7 +60,8 @@ func InitializeGRPCServer(cfg *GRPCConfig) (*GRPCContainer, error) {
 	checkPermissionUC := rbac.NewCheckPermissionUseCase(getUserPermissionsUC, cfg.Logger)
 	batchCheckUC := rbac.NewBatchCheckPermissionsUseCase(checkPermissionUC, cfg.Logger)
 
-	validateTokenUC := auth.NewValidateTokenUseCase(userRepo, jwtManager, cfg.Logger)
+	validateTokenUC := auth.NewValidateTokenUseCase(userRepo, tokenRepo, jwtManager, cfg.Logger)
+	validateAPIKeyUC := apikey.NewValidateAPIKeyUseCase(repositories.NewAPIKeyRepository(cfg.DB), repositories.NewUsageRepository(cfg.RedisClient), cfg.Logger)
 
 	server, err := grpcServer.NewGRPCServer(
 		cfg.Port,
@@ -63,9 +69,9 @@ func InitializeGRPCServer(cfg *GRPCConfig) (*GRPCContainer, error) {
 		checkPermissionUC,
 		batchCheckUC,
 		getUserPermissionsUC,
+		validateAPIKeyUC,
 		userRepo,
-		cfg.DB,
-		cfg.RedisClient,
+		cfg.Readiness,
 		cfg.Logger,
 	)
 	if err != nil {
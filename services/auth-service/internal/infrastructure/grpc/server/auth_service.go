@@ -11,6 +11,7 @@ import (
 	pkgLogger "github.com/giia/giia-core-engine/pkg/logger"
 	authv1 "github.com/giia/giia-core-engine/services/auth-service/api/proto/gen/go/auth/v1"
 	"github.com/giia/giia-core-engine/services/auth-service/internal/core/providers"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/usecases/apikey"
 	"github.com/giia/giia-core-engine/services/auth-service/internal/core/usecases/auth"
 	"github.com/giia/giia-core-engine/services/auth-service/internal/core/usecases/rbac"
 )
@@ -21,6 +22,7 @@ type AuthServiceServer struct {
 	checkPermissionUC    *rbac.CheckPermissionUseCase
 	batchCheckUC         *rbac.BatchCheckPermissionsUseCase
 	getUserPermissionsUC *rbac.GetUserPermissionsUseCase
+	validateAPIKeyUC     *apikey.ValidateAPIKeyUseCase
 	userRepo             providers.UserRepository
 	logger               pkgLogger.Logger
 }
@@ -30,6 +32,7 @@ func NewAuthServiceServer(
 	checkPermissionUC *rbac.CheckPermissionUseCase,
 	batchCheckUC *rbac.BatchCheckPermissionsUseCase,
 	getUserPermissionsUC *rbac.GetUserPermissionsUseCase,
+	validateAPIKeyUC *apikey.ValidateAPIKeyUseCase,
 	userRepo providers.UserRepository,
 	logger pkgLogger.Logger,
 ) *AuthServiceServer {
@@ -38,6 +41,7 @@ func NewAuthServiceServer(
 		checkPermissionUC:    checkPermissionUC,
 		batchCheckUC:         batchCheckUC,
 		getUserPermissionsUC: getUserPermissionsUC,
+		validateAPIKeyUC:     validateAPIKeyUC,
 		userRepo:             userRepo,
 		logger:               logger,
 	}
@@ -86,7 +90,16 @@ func (s *AuthServiceServer) CheckPermission(ctx context.Context, req *authv1.Che
 		return nil, status.Error(codes.InvalidArgument, "invalid user_id format")
 	}
 
-	allowed, err := s.checkPermissionUC.Execute(ctx, userID, req.Permission)
+	var allowed bool
+	if req.OrganizationId != "" {
+		orgID, parseErr := uuid.Parse(req.OrganizationId)
+		if parseErr != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid organization_id format")
+		}
+		allowed, err = s.checkPermissionUC.ExecuteInOrganization(ctx, userID, orgID, req.Permission)
+	} else {
+		allowed, err = s.checkPermissionUC.Execute(ctx, userID, req.Permission)
+	}
 	if err != nil {
 		s.logger.Error(ctx, err, "Permission check failed", pkgLogger.Tags{
 			"user_id":    req.UserId,
@@ -179,6 +192,30 @@ func (s *AuthServiceServer) GetUser(ctx context.Context, req *authv1.GetUserRequ
 	}, nil
 }
 
+// ValidateAPIKey lets other services (e.g. an analytics export API)
+// authenticate API key callers and read their scopes and rate limit.
+func (s *AuthServiceServer) ValidateAPIKey(ctx context.Context, req *authv1.ValidateAPIKeyRequest) (*authv1.ValidateAPIKeyResponse, error) {
+	if req.Key == "" {
+		return nil, status.Error(codes.InvalidArgument, "key is required")
+	}
+
+	key, err := s.validateAPIKeyUC.Execute(ctx, req.Key)
+	if err != nil {
+		return &authv1.ValidateAPIKeyResponse{
+			Valid:  false,
+			Reason: "invalid, revoked or expired API key",
+		}, nil
+	}
+
+	return &authv1.ValidateAPIKeyResponse{
+		Valid:              true,
+		ApiKeyId:           key.ID.String(),
+		OrganizationId:     key.OrganizationID.String(),
+		Scopes:             key.Scopes,
+		RateLimitPerMinute: int32(key.RateLimitPerMinute),
+	}, nil
+}
+
 func translateError(err error) error {
 	if customErr, ok := err.(*pkgErrors.CustomError); ok {
 		switch customErr.ErrorCode {
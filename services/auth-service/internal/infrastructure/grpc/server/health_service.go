@@ -2,39 +2,61 @@ package server
 
 import (
 	"context"
+	"errors"
 
 	"github.com/redis/go-redis/v9"
 	"google.golang.org/grpc/health/grpc_health_v1"
 	"gorm.io/gorm"
 
+	"github.com/giia/giia-core-engine/pkg/health"
 	pkgLogger "github.com/giia/giia-core-engine/pkg/logger"
 )
 
 type HealthServiceServer struct {
 	grpc_health_v1.UnimplementedHealthServer
-	db          *gorm.DB
-	redisClient *redis.Client
-	logger      pkgLogger.Logger
+	checker *health.Checker
+	logger  pkgLogger.Logger
 }
 
-func NewHealthServiceServer(db *gorm.DB, redisClient *redis.Client, logger pkgLogger.Logger) *HealthServiceServer {
+func NewHealthServiceServer(checker *health.Checker, logger pkgLogger.Logger) *HealthServiceServer {
 	return &HealthServiceServer{
-		db:          db,
-		redisClient: redisClient,
-		logger:      logger,
+		checker: checker,
+		logger:  logger,
 	}
 }
 
+// NewReadinessChecker reports the auth-service dependencies. It backs both the
+// gRPC health service and the HTTP /ready endpoint.
+func NewReadinessChecker(db *gorm.DB, redisClient *redis.Client) *health.Checker {
+	return AddDependencyChecks(health.NewChecker("auth-service"), db, redisClient)
+}
+
+// AddDependencyChecks registers the postgres and redis checks on a checker
+// that is already serving, e.g. one gated by startup.Gate while main is still
+// retrying the connections.
+func AddDependencyChecks(checker *health.Checker, db *gorm.DB, redisClient *redis.Client) *health.Checker {
+	return checker.
+		AddCritical("postgres", func(ctx context.Context) error {
+			return db.WithContext(ctx).Exec("SELECT 1").Error
+		}).
+		AddCritical("redis", func(ctx context.Context) error {
+			return redisClient.Ping(ctx).Err()
+		})
+}
+
 func (s *HealthServiceServer) Check(ctx context.Context, req *grpc_health_v1.HealthCheckRequest) (*grpc_health_v1.HealthCheckResponse, error) {
-	if err := s.db.Exec("SELECT 1").Error; err != nil {
-		s.logger.Error(ctx, err, "Database health check failed", pkgLogger.Tags{})
-		return &grpc_health_v1.HealthCheckResponse{
-			Status: grpc_health_v1.HealthCheckResponse_NOT_SERVING,
-		}, nil
+	report := s.checker.Run(ctx)
+
+	for _, dep := range report.Dependencies {
+		if dep.Status == health.StatusDown {
+			s.logger.Error(ctx, errors.New(dep.Error), "Dependency health check failed", pkgLogger.Tags{
+				"dependency": dep.Name,
+				"latency_ms": dep.LatencyMs,
+			})
+		}
 	}
 
-	if err := s.redisClient.Ping(ctx).Err(); err != nil {
-		s.logger.Error(ctx, err, "Redis health check failed", pkgLogger.Tags{})
+	if report.Status == health.StatusDown {
 		return &grpc_health_v1.HealthCheckResponse{
 			Status: grpc_health_v1.HealthCheckResponse_NOT_SERVING,
 		}, nil
@@ -3,15 +3,15 @@ package server
 import (
 	"net"
 
-	"github.com/redis/go-redis/v9"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
-	"gorm.io/gorm"
 
+	"github.com/giia/giia-core-engine/pkg/health"
 	pkgLogger "github.com/giia/giia-core-engine/pkg/logger"
 	authv1 "github.com/giia/giia-core-engine/services/auth-service/api/proto/gen/go/auth/v1"
 	"github.com/giia/giia-core-engine/services/auth-service/internal/core/providers"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/usecases/apikey"
 	"github.com/giia/giia-core-engine/services/auth-service/internal/core/usecases/auth"
 	"github.com/giia/giia-core-engine/services/auth-service/internal/core/usecases/rbac"
 	"github.com/giia/giia-core-engine/services/auth-service/internal/infrastructure/grpc/interceptors"
@@ -31,9 +31,9 @@ func NewGRPCServer(
 	checkPermissionUC *rbac.CheckPermissionUseCase,
 	batchCheckUC *rbac.BatchCheckPermissionsUseCase,
 	getUserPermissionsUC *rbac.GetUserPermissionsUseCase,
+	validateAPIKeyUC *apikey.ValidateAPIKeyUseCase,
 	userRepo providers.UserRepository,
-	db *gorm.DB,
-	redisClient *redis.Client,
+	readiness *health.Checker,
 	logger pkgLogger.Logger,
 ) (*GRPCServer, error) {
 	listener, err := net.Listen("tcp", port)
@@ -54,11 +54,12 @@ func NewGRPCServer(
 		checkPermissionUC,
 		batchCheckUC,
 		getUserPermissionsUC,
+		validateAPIKeyUC,
 		userRepo,
 		logger,
 	)
 
-	healthService := NewHealthServiceServer(db, redisClient, logger)
+	healthService := NewHealthServiceServer(readiness, logger)
 
 	authv1.RegisterAuthServiceServer(server, authService)
 	grpc_health_v1.RegisterHealthServer(server, healthService)
@@ -0,0 +1,20 @@
+-- Create email_send_logs table
+CREATE TABLE IF NOT EXISTS email_send_logs (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    provider VARCHAR(20) NOT NULL,
+    provider_message_id VARCHAR(255),
+    recipient VARCHAR(255) NOT NULL,
+    subject VARCHAR(500) NOT NULL,
+    category VARCHAR(100),
+    status VARCHAR(20) NOT NULL,
+    error TEXT,
+    sent_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT chk_email_send_logs_status
+        CHECK (status IN ('sent', 'failed', 'delivered', 'bounced', 'complained', 'dropped'))
+);
+
+-- Create indexes
+CREATE INDEX IF NOT EXISTS idx_email_send_logs_provider_message_id ON email_send_logs(provider, provider_message_id);
+CREATE INDEX IF NOT EXISTS idx_email_send_logs_recipient ON email_send_logs(recipient);
+CREATE INDEX IF NOT EXISTS idx_email_send_logs_status ON email_send_logs(status) WHERE status IN ('bounced', 'complained');
@@ -0,0 +1,4 @@
+-- Track the device each refresh token was issued to so new-device logins can be detected
+ALTER TABLE refresh_tokens ADD COLUMN IF NOT EXISTS device_id VARCHAR(64);
+
+CREATE INDEX IF NOT EXISTS idx_refresh_tokens_user_device ON refresh_tokens(user_id, device_id);
@@ -0,0 +1,13 @@
+-- Record where each session was opened from
+ALTER TABLE refresh_tokens ADD COLUMN IF NOT EXISTS user_agent VARCHAR(500);
+ALTER TABLE refresh_tokens ADD COLUMN IF NOT EXISTS ip_address VARCHAR(45);
+ALTER TABLE refresh_tokens ADD COLUMN IF NOT EXISTS country VARCHAR(2);
+ALTER TABLE refresh_tokens ADD COLUMN IF NOT EXISTS city VARCHAR(100);
+ALTER TABLE refresh_tokens ADD COLUMN IF NOT EXISTS latitude DOUBLE PRECISION;
+ALTER TABLE refresh_tokens ADD COLUMN IF NOT EXISTS longitude DOUBLE PRECISION;
+
+CREATE INDEX IF NOT EXISTS idx_refresh_tokens_user_created_at ON refresh_tokens(user_id, created_at DESC);
+
+-- Second factor used for step-up verification on suspicious logins
+ALTER TABLE users ADD COLUMN IF NOT EXISTS two_factor_enabled BOOLEAN NOT NULL DEFAULT false;
+ALTER TABLE users ADD COLUMN IF NOT EXISTS two_factor_secret VARCHAR(64);
@@ -0,0 +1,52 @@
+-- Create impersonation_sessions table
+CREATE TABLE IF NOT EXISTS impersonation_sessions (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    organization_id UUID NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    impersonator_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    target_user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    reason TEXT NOT NULL,
+    read_only BOOLEAN NOT NULL DEFAULT true,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    consent_expires TIMESTAMP NOT NULL,
+    responded_at TIMESTAMP,
+    started_at TIMESTAMP,
+    expires_at TIMESTAMP,
+    ended_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT chk_impersonation_sessions_status
+        CHECK (status IN ('pending', 'approved', 'denied', 'active', 'ended')),
+    CONSTRAINT chk_impersonation_sessions_not_self
+        CHECK (impersonator_id <> target_user_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_impersonation_sessions_organization_id ON impersonation_sessions(organization_id);
+CREATE INDEX IF NOT EXISTS idx_impersonation_sessions_impersonator_id ON impersonation_sessions(impersonator_id);
+CREATE INDEX IF NOT EXISTS idx_impersonation_sessions_target_user_id ON impersonation_sessions(target_user_id);
+
+-- Create audit_logs table
+CREATE TABLE IF NOT EXISTS audit_logs (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    organization_id UUID NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    actor_id UUID NOT NULL,
+    impersonator_id UUID,
+    impersonation_id UUID REFERENCES impersonation_sessions(id) ON DELETE SET NULL,
+    action VARCHAR(100) NOT NULL,
+    resource VARCHAR(255),
+    method VARCHAR(10),
+    status_code INTEGER,
+    ip_address VARCHAR(45),
+    details TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_audit_logs_organization_id ON audit_logs(organization_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_audit_logs_actor_id ON audit_logs(actor_id);
+CREATE INDEX IF NOT EXISTS idx_audit_logs_impersonator_id ON audit_logs(impersonator_id) WHERE impersonator_id IS NOT NULL;
+
+-- Seed impersonation permission (granted to Admin through the wildcard)
+INSERT INTO permissions (id, code, description, service, resource, action) VALUES
+    ('00000000-0000-0000-0000-000000000002', 'auth:users:impersonate', 'Impersonate a user with their consent', 'auth', 'users', 'impersonate')
+ON CONFLICT (code) DO NOTHING;
+
+COMMENT ON TABLE audit_logs IS 'Append-only audit trail; impersonated actions carry impersonator_id';
@@ -0,0 +1,6 @@
+-- Seed permissions enforced inside DDMRP and execution use cases (see pkg/authz)
+INSERT INTO permissions (code, description, service, resource, action) VALUES
+    ('ddmrp:buffers:recalculate', 'Recalculate buffer zones', 'ddmrp', 'buffers', 'recalculate'),
+    ('ddmrp:fad:create', 'Create demand adjustment factors', 'ddmrp', 'fad', 'create'),
+    ('execution:po:confirm', 'Confirm purchase orders', 'execution', 'po', 'confirm')
+ON CONFLICT (code) DO NOTHING;
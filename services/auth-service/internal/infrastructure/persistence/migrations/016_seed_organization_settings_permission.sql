@@ -0,0 +1,4 @@
+-- Seed permission for managing organization-level settings (locale defaults)
+INSERT INTO permissions (code, description, service, resource, action) VALUES
+    ('auth:organizations:update', 'Update organization settings', 'auth', 'organizations', 'update')
+ON CONFLICT (code) DO NOTHING;
@@ -0,0 +1,13 @@
+-- Create query_diagnostics table (EXPLAIN plans of slow queries, see pkg/database SlowQueryPlugin)
+CREATE TABLE IF NOT EXISTS query_diagnostics (
+    id BIGSERIAL PRIMARY KEY,
+    query TEXT NOT NULL,
+    table_name VARCHAR(255),
+    duration_ms BIGINT NOT NULL,
+    plan JSONB NOT NULL,
+    captured_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Create indexes
+CREATE INDEX IF NOT EXISTS idx_query_diagnostics_captured_at ON query_diagnostics(captured_at DESC);
+CREATE INDEX IF NOT EXISTS idx_query_diagnostics_duration ON query_diagnostics(duration_ms DESC);
@@ -0,0 +1,25 @@
+-- Create api_keys table (read-only scoped keys for BI tools; only the SHA-256 hash is stored)
+CREATE TABLE IF NOT EXISTS api_keys (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    organization_id UUID NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+    prefix VARCHAR(20) NOT NULL,
+    key_hash VARCHAR(64) NOT NULL,
+    scopes JSONB NOT NULL DEFAULT '[]',
+    rate_limit_per_minute INT NOT NULL DEFAULT 60,
+    created_by UUID NOT NULL REFERENCES users(id),
+    expires_at TIMESTAMP,
+    last_used_at TIMESTAMP,
+    revoked_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Create indexes
+CREATE UNIQUE INDEX IF NOT EXISTS idx_api_keys_prefix ON api_keys(prefix);
+CREATE INDEX IF NOT EXISTS idx_api_keys_organization_id ON api_keys(organization_id);
+
+-- Seed permission for managing API keys
+INSERT INTO permissions (code, description, service, resource, action) VALUES
+    ('auth:api_keys:manage', 'Create, list and revoke organization API keys', 'auth', 'api_keys', 'manage')
+ON CONFLICT (code) DO NOTHING;
@@ -0,0 +1,5 @@
+-- Seed permissions for exporting and importing organization archives
+INSERT INTO permissions (code, description, service, resource, action) VALUES
+    ('auth:organizations:export', 'Export organization settings, roles and users', 'auth', 'organizations', 'export'),
+    ('auth:organizations:import', 'Import an organization archive into this organization', 'auth', 'organizations', 'import')
+ON CONFLICT (code) DO NOTHING;
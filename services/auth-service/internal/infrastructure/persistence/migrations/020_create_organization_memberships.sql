@@ -0,0 +1,29 @@
+-- Create organization_memberships table (guest access for users whose home organization is elsewhere)
+CREATE TABLE IF NOT EXISTS organization_memberships (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    organization_id UUID NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    status VARCHAR(20) NOT NULL DEFAULT 'active',
+    invited_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    revoked_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT unique_user_organization UNIQUE(user_id, organization_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_organization_memberships_organization_id ON organization_memberships(organization_id);
+
+-- Role assignments made inside a guest organization carry that organization.
+-- NULL keeps the existing meaning: the assignment applies in the user's home organization.
+ALTER TABLE user_roles ADD COLUMN IF NOT EXISTS organization_id UUID REFERENCES organizations(id) ON DELETE CASCADE;
+
+ALTER TABLE user_roles DROP CONSTRAINT IF EXISTS unique_user_role;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_user_roles_user_role_org
+    ON user_roles(user_id, role_id, COALESCE(organization_id, '00000000-0000-0000-0000-000000000000'));
+
+COMMENT ON COLUMN user_roles.organization_id IS 'Guest organization the assignment applies to; NULL for the home organization';
+
+-- Seed permission for managing organization memberships
+INSERT INTO permissions (code, description, service, resource, action) VALUES
+    ('auth:memberships:manage', 'Add, list and remove members from other organizations', 'auth', 'memberships', 'manage')
+ON CONFLICT (code) DO NOTHING;
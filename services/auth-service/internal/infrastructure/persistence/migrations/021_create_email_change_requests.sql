@@ -0,0 +1,28 @@
+-- Create email_change_requests table. A change completes only after both the
+-- current and the new address confirm their link.
+CREATE TABLE IF NOT EXISTS email_change_requests (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    organization_id UUID NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    old_email VARCHAR(255) NOT NULL,
+    new_email VARCHAR(255) NOT NULL,
+    old_token_hash VARCHAR(64) NOT NULL UNIQUE,
+    new_token_hash VARCHAR(64) NOT NULL UNIQUE,
+    old_confirmed_at TIMESTAMP,
+    new_confirmed_at TIMESTAMP,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    expires_at TIMESTAMP NOT NULL,
+    completed_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT chk_email_change_requests_status
+        CHECK (status IN ('pending', 'completed', 'cancelled'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_email_change_requests_user_id ON email_change_requests(user_id, status);
+
+-- The previous address stays attached to the account as a recovery contact
+ALTER TABLE users ADD COLUMN IF NOT EXISTS recovery_email VARCHAR(255);
+ALTER TABLE users ADD COLUMN IF NOT EXISTS recovery_email_expires_at TIMESTAMP;
+
+COMMENT ON COLUMN users.recovery_email IS 'Previous login email, usable for account recovery until recovery_email_expires_at';
@@ -0,0 +1,7 @@
+-- Reporting lines within an organization. Alert escalation walks manager_id
+-- up the chain when a critical notification is not acknowledged.
+ALTER TABLE users ADD COLUMN IF NOT EXISTS manager_id UUID REFERENCES users(id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS idx_users_manager_id ON users(manager_id);
+
+COMMENT ON COLUMN users.manager_id IS 'User this user reports to, in the same organization';
@@ -0,0 +1,4 @@
+-- Seed the purchase order approval permission enforced through pkg/authz
+INSERT INTO permissions (code, description, service, resource, action) VALUES
+    ('execution:po:approve', 'Approve purchase orders above the approval threshold', 'execution', 'po', 'approve')
+ON CONFLICT (code) DO NOTHING;
@@ -0,0 +1,4 @@
+-- Seed the permission for reading organization usage (billing metering)
+INSERT INTO permissions (code, description, service, resource, action) VALUES
+    ('auth:usage:read', 'Read billable usage of the organization', 'auth', 'usage', 'read')
+ON CONFLICT (code) DO NOTHING;
@@ -0,0 +1,17 @@
+-- Migration: Seed the built-in Auditor role for external audits
+-- Description: Read-only access to every service, the audit log and the organization export
+
+INSERT INTO roles (id, name, description, is_system, parent_role_id, organization_id) VALUES
+    ('00000000-0000-0000-0000-000000000050', 'Auditor', 'Read-only access to all services, the audit log and exports', true, NULL, NULL)
+ON CONFLICT (id) DO NOTHING;
+
+INSERT INTO permissions (code, description, service, resource, action) VALUES
+    ('*:*:read', 'Wildcard read permission - read access to every service', '*', '*', 'read'),
+    ('auth:audit:read', 'Read the organization audit log', 'auth', 'audit', 'read')
+ON CONFLICT (code) DO NOTHING;
+
+-- auth:organizations:export is seeded by 019
+INSERT INTO role_permissions (role_id, permission_id)
+SELECT '00000000-0000-0000-0000-000000000050', id FROM permissions
+WHERE code IN ('*:*:read', 'auth:audit:read', 'auth:organizations:export')
+ON CONFLICT (role_id, permission_id) DO NOTHING;
@@ -0,0 +1,49 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/domain"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/providers"
+)
+
+type emailChangeRepository struct {
+	db *gorm.DB
+}
+
+func NewEmailChangeRepository(db *gorm.DB) providers.EmailChangeRepository {
+	return &emailChangeRepository{db: db}
+}
+
+func (r *emailChangeRepository) Create(ctx context.Context, request *domain.EmailChangeRequest) error {
+	return r.db.WithContext(ctx).Create(request).Error
+}
+
+func (r *emailChangeRepository) GetByTokenHash(ctx context.Context, tokenHash string) (*domain.EmailChangeRequest, error) {
+	var request domain.EmailChangeRequest
+	err := r.db.WithContext(ctx).
+		Where("old_token_hash = ? OR new_token_hash = ?", tokenHash, tokenHash).
+		First(&request).Error
+	if err != nil {
+		return nil, err
+	}
+	return &request, nil
+}
+
+func (r *emailChangeRepository) Update(ctx context.Context, request *domain.EmailChangeRequest) error {
+	return r.db.WithContext(ctx).Save(request).Error
+}
+
+func (r *emailChangeRepository) CancelPending(ctx context.Context, userID uuid.UUID) error {
+	return r.db.WithContext(ctx).
+		Model(&domain.EmailChangeRequest{}).
+		Where("user_id = ? AND status = ?", userID, domain.EmailChangeStatusPending).
+		Updates(map[string]interface{}{
+			"status":     domain.EmailChangeStatusCancelled,
+			"updated_at": time.Now(),
+		}).Error
+}
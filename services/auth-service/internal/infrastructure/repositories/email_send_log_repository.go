@@ -0,0 +1,46 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/giia/giia-core-engine/pkg/mailer"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/domain"
+)
+
+type emailSendLogRepository struct {
+	db *gorm.DB
+}
+
+func NewEmailSendLogRepository(db *gorm.DB) mailer.SendLogStore {
+	return &emailSendLogRepository{db: db}
+}
+
+func (r *emailSendLogRepository) Record(ctx context.Context, entry *mailer.SendLogEntry) error {
+	log := &domain.EmailSendLog{
+		Provider:          entry.Provider,
+		ProviderMessageID: entry.ProviderMessageID,
+		Recipient:         entry.Recipient,
+		Subject:           entry.Subject,
+		Category:          entry.Category,
+		Status:            string(entry.Status),
+		Error:             entry.Error,
+		SentAt:            entry.SentAt,
+		UpdatedAt:         entry.SentAt,
+	}
+
+	return r.db.WithContext(ctx).Create(log).Error
+}
+
+func (r *emailSendLogRepository) UpdateStatus(ctx context.Context, provider, providerMessageID, recipient string, status mailer.SendStatus, reason string) error {
+	return r.db.WithContext(ctx).
+		Model(&domain.EmailSendLog{}).
+		Where("provider = ? AND provider_message_id = ? AND LOWER(recipient) = LOWER(?)", provider, providerMessageID, recipient).
+		Updates(map[string]interface{}{
+			"status":     string(status),
+			"error":      reason,
+			"updated_at": time.Now().UTC(),
+		}).Error
+}
@@ -0,0 +1,85 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/domain"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/providers"
+)
+
+type impersonationRepository struct {
+	db *gorm.DB
+}
+
+func NewImpersonationRepository(db *gorm.DB) providers.ImpersonationRepository {
+	return &impersonationRepository{db: db}
+}
+
+func (r *impersonationRepository) Create(ctx context.Context, session *domain.ImpersonationSession) error {
+	return r.db.WithContext(ctx).Create(session).Error
+}
+
+func (r *impersonationRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.ImpersonationSession, error) {
+	var session domain.ImpersonationSession
+	err := r.db.WithContext(ctx).Where("id = ?", id).First(&session).Error
+	if err != nil {
+		return nil, err
+	}
+	return &session, nil
+}
+
+func (r *impersonationRepository) Update(ctx context.Context, session *domain.ImpersonationSession) error {
+	return r.db.WithContext(ctx).Save(session).Error
+}
+
+func (r *impersonationRepository) StartApproved(ctx context.Context, session *domain.ImpersonationSession) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Model(&domain.ImpersonationSession{}).
+		Where("id = ? AND status = ? AND consent_expires > ?", session.ID, domain.ImpersonationStatusApproved, time.Now()).
+		Updates(map[string]interface{}{
+			"status":     session.Status,
+			"started_at": session.StartedAt,
+			"expires_at": session.ExpiresAt,
+		})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected == 1, nil
+}
+
+type auditLogRepository struct {
+	db *gorm.DB
+}
+
+func NewAuditLogRepository(db *gorm.DB) providers.AuditLogRepository {
+	return &auditLogRepository{db: db}
+}
+
+func (r *auditLogRepository) Create(ctx context.Context, entry *domain.AuditLog) error {
+	return r.db.WithContext(ctx).Create(entry).Error
+}
+
+func (r *auditLogRepository) ListByOrganization(ctx context.Context, orgID uuid.UUID, filter domain.AuditLogFilter) ([]*domain.AuditLog, error) {
+	query := r.db.WithContext(ctx).Where("organization_id = ?", orgID)
+
+	if filter.ActorID != nil {
+		query = query.Where("actor_id = ?", *filter.ActorID)
+	}
+	if filter.Action != "" {
+		query = query.Where("action = ?", filter.Action)
+	}
+	if filter.From != nil {
+		query = query.Where("created_at >= ?", *filter.From)
+	}
+	if filter.To != nil {
+		query = query.Where("created_at < ?", *filter.To)
+	}
+
+	var entries []*domain.AuditLog
+	err := query.Order("created_at DESC").Limit(filter.Limit).Find(&entries).Error
+	return entries, err
+}
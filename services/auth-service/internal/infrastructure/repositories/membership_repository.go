@@ -0,0 +1,63 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/domain"
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/providers"
+)
+
+type membershipRepository struct {
+	db *gorm.DB
+}
+
+func NewMembershipRepository(db *gorm.DB) providers.MembershipRepository {
+	return &membershipRepository{db: db}
+}
+
+func (r *membershipRepository) Create(ctx context.Context, membership *domain.OrganizationMembership) error {
+	return r.db.WithContext(ctx).Create(membership).Error
+}
+
+func (r *membershipRepository) Get(ctx context.Context, userID, orgID uuid.UUID) (*domain.OrganizationMembership, error) {
+	var membership domain.OrganizationMembership
+	err := r.db.WithContext(ctx).
+		Where("user_id = ? AND organization_id = ?", userID, orgID).
+		First(&membership).Error
+	if err != nil {
+		return nil, err
+	}
+	return &membership, nil
+}
+
+func (r *membershipRepository) Update(ctx context.Context, membership *domain.OrganizationMembership) error {
+	return r.db.WithContext(ctx).Save(membership).Error
+}
+
+func (r *membershipRepository) ListByOrganization(ctx context.Context, orgID uuid.UUID) ([]*domain.OrganizationMembership, error) {
+	var memberships []*domain.OrganizationMembership
+	err := r.db.WithContext(ctx).
+		Preload("User").
+		Where("organization_id = ? AND status = ?", orgID, domain.MembershipStatusActive).
+		Order("created_at ASC").
+		Find(&memberships).Error
+	if err != nil {
+		return nil, err
+	}
+	return memberships, nil
+}
+
+func (r *membershipRepository) ListByUser(ctx context.Context, userID uuid.UUID) ([]*domain.OrganizationMembership, error) {
+	var memberships []*domain.OrganizationMembership
+	err := r.db.WithContext(ctx).
+		Where("user_id = ? AND status = ?", userID, domain.MembershipStatusActive).
+		Order("created_at ASC").
+		Find(&memberships).Error
+	if err != nil {
+		return nil, err
+	}
+	return memberships, nil
+}
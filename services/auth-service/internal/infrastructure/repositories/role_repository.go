@@ -92,7 +92,7 @@ func (r *roleRepository) GetUserRoles(ctx context.Context, userID uuid.UUID) ([]
 	err := r.db.WithContext(ctx).
 		Table("roles").
 		Joins("INNER JOIN user_roles ON user_roles.role_id = roles.id").
-		Where("user_roles.user_id = ?", userID).
+		Where("user_roles.user_id = ? AND user_roles.organization_id IS NULL", userID).
 		Preload("Permissions").
 		Find(&roles).Error
 	if err != nil {
@@ -112,7 +112,7 @@ func (r *roleRepository) AssignRoleToUser(ctx context.Context, userID, roleID, a
 
 func (r *roleRepository) RemoveRoleFromUser(ctx context.Context, userID, roleID uuid.UUID) error {
 	return r.db.WithContext(ctx).
-		Where("user_id = ? AND role_id = ?", userID, roleID).
+		Where("user_id = ? AND role_id = ? AND organization_id IS NULL", userID, roleID).
 		Delete(&domain.UserRole{}).Error
 }
 
@@ -127,3 +127,38 @@ func (r *roleRepository) GetUsersWithRole(ctx context.Context, roleID uuid.UUID)
 	}
 	return userIDs, nil
 }
+
+// GetUserRolesInOrganization returns the roles a user holds in orgID: guest
+// assignments made in that organization, or home assignments when orgID is
+// the user's home organization.
+func (r *roleRepository) GetUserRolesInOrganization(ctx context.Context, userID, orgID uuid.UUID) ([]*domain.Role, error) {
+	var roles []*domain.Role
+	err := r.db.WithContext(ctx).
+		Table("roles").
+		Joins("INNER JOIN user_roles ON user_roles.role_id = roles.id").
+		Joins("INNER JOIN users ON users.id = user_roles.user_id").
+		Where("user_roles.user_id = ?", userID).
+		Where("user_roles.organization_id = ? OR (user_roles.organization_id IS NULL AND users.organization_id = ?)", orgID, orgID).
+		Preload("Permissions").
+		Find(&roles).Error
+	if err != nil {
+		return nil, err
+	}
+	return roles, nil
+}
+
+func (r *roleRepository) AssignRoleToUserInOrganization(ctx context.Context, userID, roleID, orgID, assignedBy uuid.UUID) error {
+	userRole := &domain.UserRole{
+		UserID:         userID,
+		RoleID:         roleID,
+		AssignedBy:     &assignedBy,
+		OrganizationID: &orgID,
+	}
+	return r.db.WithContext(ctx).Create(userRole).Error
+}
+
+func (r *roleRepository) RemoveUserRolesInOrganization(ctx context.Context, userID, orgID uuid.UUID) error {
+	return r.db.WithContext(ctx).
+		Where("user_id = ? AND organization_id = ?", userID, orgID).
+		Delete(&domain.UserRole{}).Error
+}
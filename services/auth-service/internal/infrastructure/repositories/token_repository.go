@@ -62,6 +62,30 @@ func (r *tokenRepository) RevokeAllUserTokens(ctx context.Context, userID uuid.U
 		Update("revoked", true).Error
 }
 
+func (r *tokenRepository) HasDeviceLogin(ctx context.Context, userID uuid.UUID, deviceID string) (bool, error) {
+	var count int64
+	err := r.db.WithContext(ctx).
+		Model(&domain.RefreshToken{}).
+		Where("user_id = ? AND device_id = ?", userID, deviceID).
+		Count(&count).Error
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+func (r *tokenRepository) GetLatestRefreshToken(ctx context.Context, userID uuid.UUID) (*domain.RefreshToken, error) {
+	var token domain.RefreshToken
+	err := r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Order("created_at DESC").
+		First(&token).Error
+	if err != nil {
+		return nil, err
+	}
+	return &token, nil
+}
+
 func (r *tokenRepository) StorePasswordResetToken(ctx context.Context, token *domain.PasswordResetToken) error {
 	return r.db.WithContext(ctx).Create(token).Error
 }
@@ -120,6 +144,106 @@ func (r *tokenRepository) IsTokenBlacklisted(ctx context.Context, token string)
 	return result > 0, nil
 }
 
+func (r *tokenRepository) RevokeUserSessions(ctx context.Context, userID uuid.UUID, revokedAt time.Time, ttl time.Duration) error {
+	key := fmt.Sprintf("sessions_revoked:%s", userID.String())
+	return r.redis.Set(ctx, key, revokedAt.Unix(), ttl).Err()
+}
+
+func (r *tokenRepository) GetUserSessionsRevokedAt(ctx context.Context, userID uuid.UUID) (*time.Time, error) {
+	key := fmt.Sprintf("sessions_revoked:%s", userID.String())
+	seconds, err := r.redis.Get(ctx, key).Int64()
+	if err == redis.Nil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	revokedAt := time.Unix(seconds, 0).UTC()
+	return &revokedAt, nil
+}
+
+func (r *tokenRepository) StoreLoginChallenge(ctx context.Context, tokenHash string, challenge *domain.LoginChallenge, ttl time.Duration) error {
+	data, err := json.Marshal(challenge)
+	if err != nil {
+		return err
+	}
+
+	key := fmt.Sprintf("login_challenge:%s", tokenHash)
+	return r.redis.Set(ctx, key, data, ttl).Err()
+}
+
+func (r *tokenRepository) GetLoginChallenge(ctx context.Context, tokenHash string) (*domain.LoginChallenge, error) {
+	key := fmt.Sprintf("login_challenge:%s", tokenHash)
+	data, err := r.redis.Get(ctx, key).Bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	var challenge domain.LoginChallenge
+	if err := json.Unmarshal(data, &challenge); err != nil {
+		return nil, err
+	}
+	return &challenge, nil
+}
+
+func (r *tokenRepository) RecordLoginChallengeAttempt(ctx context.Context, tokenHash string, ttl time.Duration) (int64, error) {
+	key := fmt.Sprintf("login_challenge_attempts:%s", tokenHash)
+
+	pipe := r.redis.TxPipeline()
+	incr := pipe.Incr(ctx, key)
+	pipe.Expire(ctx, key, ttl)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return 0, err
+	}
+	return incr.Val(), nil
+}
+
+func (r *tokenRepository) ConsumeLoginChallenge(ctx context.Context, tokenHash string) (*domain.LoginChallenge, error) {
+	key := fmt.Sprintf("login_challenge:%s", tokenHash)
+	data, err := r.redis.GetDel(ctx, key).Bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	var challenge domain.LoginChallenge
+	if err := json.Unmarshal(data, &challenge); err != nil {
+		return nil, err
+	}
+	return &challenge, nil
+}
+
+func (r *tokenRepository) DeleteLoginChallenge(ctx context.Context, tokenHash string) error {
+	return r.redis.Del(ctx,
+		fmt.Sprintf("login_challenge:%s", tokenHash),
+		fmt.Sprintf("login_challenge_attempts:%s", tokenHash),
+	).Err()
+}
+
+func (r *tokenRepository) StoreMagicLink(ctx context.Context, tokenHash string, link *domain.MagicLink, ttl time.Duration) error {
+	data, err := json.Marshal(link)
+	if err != nil {
+		return err
+	}
+
+	key := fmt.Sprintf("magic_link:%s", tokenHash)
+	return r.redis.Set(ctx, key, data, ttl).Err()
+}
+
+func (r *tokenRepository) ConsumeMagicLink(ctx context.Context, tokenHash string) (*domain.MagicLink, error) {
+	key := fmt.Sprintf("magic_link:%s", tokenHash)
+	data, err := r.redis.GetDel(ctx, key).Bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	var link domain.MagicLink
+	if err := json.Unmarshal(data, &link); err != nil {
+		return nil, err
+	}
+	return &link, nil
+}
+
 type RefreshTokenData struct {
 	UserID    uuid.UUID `json:"user_id"`
 	ExpiresAt time.Time `json:"expires_at"`
@@ -0,0 +1,43 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+
+	"github.com/giia/giia-core-engine/services/auth-service/internal/core/providers"
+)
+
+// usageRetention keeps monthly counters long enough for late invoicing and
+// year-over-year comparisons.
+const usageRetention = 400 * 24 * time.Hour
+
+type usageRepository struct {
+	redis *redis.Client
+}
+
+func NewUsageRepository(redis *redis.Client) providers.UsageRepository {
+	return &usageRepository{redis: redis}
+}
+
+func (r *usageRepository) IncrementAPICalls(ctx context.Context, orgID uuid.UUID, period string) error {
+	key := fmt.Sprintf("usage:api_calls:%s:%s", orgID.String(), period)
+
+	pipe := r.redis.TxPipeline()
+	pipe.Incr(ctx, key)
+	pipe.Expire(ctx, key, usageRetention)
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+func (r *usageRepository) GetAPICalls(ctx context.Context, orgID uuid.UUID, period string) (int64, error) {
+	key := fmt.Sprintf("usage:api_calls:%s:%s", orgID.String(), period)
+	count, err := r.redis.Get(ctx, key).Int64()
+	if err == redis.Nil {
+		return 0, nil
+	}
+	return count, err
+}
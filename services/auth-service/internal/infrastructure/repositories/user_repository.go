@@ -126,6 +126,33 @@ func (r *userRepository) List(ctx context.Context, offset, limit int) ([]*domain
 	return users, nil
 }
 
+func (r *userRepository) ListByOrganization(ctx context.Context, orgID uuid.UUID, offset, limit int) ([]*domain.User, error) {
+	var users []*domain.User
+	err := r.db.WithContext(ctx).
+		Scopes(TenantScope(orgID)).
+		Offset(offset).
+		Limit(limit).
+		Order("created_at ASC, id ASC").
+		Find(&users).Error
+	if err != nil {
+		return nil, err
+	}
+	return users, nil
+}
+
+func (r *userRepository) CountActiveByOrganization(ctx context.Context, orgID uuid.UUID) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).
+		Model(&domain.User{}).
+		Scopes(TenantScope(orgID)).
+		Where("status = ?", domain.UserStatusActive).
+		Count(&count).Error
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
 func getOrgIDFromContext(ctx context.Context) uuid.UUID {
 	if orgID, ok := ctx.Value("organization_id").(uuid.UUID); ok {
 		return orgID
@@ -56,6 +56,7 @@ func getSystemPermissions() []*domain.Permission {
 		{"auth:roles:delete", "Delete roles"},
 		{"auth:permissions:read", "View permissions"},
 		{"auth:permissions:write", "Create and update permissions"},
+		{"auth:audit:read", "Read the organization audit log"},
 	}
 
 	catalogPermissions := [][]string{
@@ -157,11 +158,12 @@ func assignDefaultPermissions(ctx context.Context, roleRepo providers.RoleReposi
 	managerPerms := []uuid.UUID{}
 
 	for _, perm := range allPermissions {
-		if perm.Code == "*:*:*" {
+		// Wildcards belong to Admin and Auditor only (migrations 010 and 025)
+		if perm.Service == "*" {
 			continue
 		}
 
-		if perm.Action == "read" {
+		if perm.Action == "read" && perm.Resource != "audit" {
 			viewerPerms = append(viewerPerms, perm.ID)
 		}
 